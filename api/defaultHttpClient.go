@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"time"
 )
@@ -19,7 +20,12 @@ func NewDefaultHttpClient(requestTimeoutSec uint64) *httpClient {
 	}
 }
 
-// Get will return an resp *http.Response from the provided url
-func (hc *httpClient) Get(url string) (resp *http.Response, err error) {
-	return hc.client.Get(url)
+// Get will return an resp *http.Response from the provided url. The request is aborted once the provided context is done
+func (hc *httpClient) Get(ctx context.Context, url string) (resp *http.Response, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return hc.client.Do(req)
 }
@@ -1,6 +1,9 @@
 package api
 
 import (
+	"encoding/json"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-covalent-go/hyperBlock"
 )
 
@@ -16,6 +19,14 @@ const ReturnCodeInternalError ReturnCode = "internal_issue"
 // ReturnCodeRequestError defines a request which hasn't been executed successfully due to a bad request received
 const ReturnCodeRequestError ReturnCode = "bad_request"
 
+// ReturnCodeRetryLater defines a request for hyper blocks which are not final yet, in final only mode. The same request
+// should be retried later
+const ReturnCodeRetryLater ReturnCode = "retry_later"
+
+// ReturnCodePartialSuccess defines a hyper blocks request which has been executed successfully only for some of the
+// requested hyper blocks
+const ReturnCodePartialSuccess ReturnCode = "partial_success"
+
 // MultiversxHyperBlockApiResponse is the expected hyper block dto response from Multiversx proxy
 type MultiversxHyperBlockApiResponse struct {
 	Data  MultiversxHyperBlockApiResponsePayload `json:"data"`
@@ -28,6 +39,36 @@ type MultiversxHyperBlockApiResponsePayload struct {
 	HyperBlock hyperBlock.HyperBlock `json:"hyperblock"`
 }
 
+// MultiversxNetworkStatusApiResponse is the expected network status dto response from Multiversx proxy
+type MultiversxNetworkStatusApiResponse struct {
+	Data  MultiversxNetworkStatusApiResponsePayload `json:"data"`
+	Error string                                    `json:"error"`
+	Code  ReturnCode                                `json:"code"`
+}
+
+// MultiversxNetworkStatusApiResponsePayload wraps a network status
+type MultiversxNetworkStatusApiResponsePayload struct {
+	Status NetworkStatus `json:"status"`
+}
+
+// NetworkStatus holds the network status metrics of a shard, as provided by Multiversx proxy
+type NetworkStatus struct {
+	Nonce             uint64 `json:"erd_nonce"`
+	HighestFinalNonce uint64 `json:"erd_highest_final_nonce"`
+}
+
+// MultiversxTransactionApiResponse is the expected transaction dto response from Multiversx proxy
+type MultiversxTransactionApiResponse struct {
+	Data  MultiversxTransactionApiResponsePayload `json:"data"`
+	Error string                                  `json:"error"`
+	Code  ReturnCode                              `json:"code"`
+}
+
+// MultiversxTransactionApiResponsePayload wraps a transaction
+type MultiversxTransactionApiResponsePayload struct {
+	Transaction transaction.ApiTransactionResult `json:"transaction"`
+}
+
 // CovalentHyperBlockApiResponse is the hyper block dto response for Covalent
 type CovalentHyperBlockApiResponse struct {
 	Data  []byte     `json:"data"`
@@ -41,3 +82,72 @@ type CovalentHyperBlocksApiResponse struct {
 	Error string     `json:"error"`
 	Code  ReturnCode `json:"code"`
 }
+
+// CovalentHyperBlockJsonApiResponse is the hyper block dto response for Covalent, holding the hyper block in one of the
+// json encodings(EncodingAvroJson or EncodingJson)
+type CovalentHyperBlockJsonApiResponse struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  ReturnCode      `json:"code"`
+}
+
+// CovalentHyperBlocksJsonApiResponse is the hyper blocks dto response for Covalent, holding the hyper blocks in one of
+// the json encodings(EncodingAvroJson or EncodingJson)
+type CovalentHyperBlocksJsonApiResponse struct {
+	Data  []json.RawMessage `json:"data"`
+	Error string            `json:"error"`
+	Code  ReturnCode        `json:"code"`
+}
+
+// CovalentPartialHyperBlocksApiResponse is the hyper blocks dto response for Covalent in partial results mode, holding
+// the outcome of each requested hyper block
+type CovalentPartialHyperBlocksApiResponse struct {
+	Data  []CovalentHyperBlockResult `json:"data"`
+	Error string                     `json:"error"`
+	Code  ReturnCode                 `json:"code"`
+}
+
+// CovalentHyperBlockResult is the outcome of fetching the hyper block with the provided nonce, in partial results mode.
+// Failed hyper blocks have no data and hold the error message
+type CovalentHyperBlockResult struct {
+	Nonce uint64     `json:"nonce"`
+	Data  []byte     `json:"data"`
+	Error string     `json:"error"`
+	Code  ReturnCode `json:"code"`
+}
+
+// EpochNoncesInterval holds the nonces of the first and last hyper blocks of an epoch. The epoch is not complete if it
+// is still ongoing, in which case the last nonce is the latest one known by Multiversx proxy
+type EpochNoncesInterval struct {
+	Epoch      uint32 `json:"epoch"`
+	StartNonce uint64 `json:"startNonce"`
+	EndNonce   uint64 `json:"endNonce"`
+	IsComplete bool   `json:"isComplete"`
+}
+
+// CovalentEpochNoncesIntervalApiResponse is the epoch nonces interval dto response for Covalent
+type CovalentEpochNoncesIntervalApiResponse struct {
+	Data  *EpochNoncesInterval `json:"data"`
+	Error string               `json:"error"`
+	Code  ReturnCode           `json:"code"`
+}
+
+// ReorgEvent is a rollback notification: the hyper block served at the provided nonce, having the old hash, was
+// replaced by the hyper block having the new hash. Ids are only unique within the instance which published the event
+type ReorgEvent struct {
+	Id         uint64 `json:"id"`
+	InstanceId string `json:"instanceId"`
+	Nonce      uint64 `json:"nonce"`
+	OldHash    string `json:"oldHash"`
+	NewHash    string `json:"newHash"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// CovalentReorgsApiResponse is the reorgs dto response for Covalent. A different instance id than the one of a
+// previous response means the event ids were reset
+type CovalentReorgsApiResponse struct {
+	Data       []ReorgEvent `json:"data"`
+	InstanceId string       `json:"instanceId"`
+	Error      string       `json:"error"`
+	Code       ReturnCode   `json:"code"`
+}
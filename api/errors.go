@@ -4,6 +4,16 @@ import "errors"
 
 var errNilHttpServer = errors.New("nil http server provided")
 
+// ErrNonRetryableResponse signals a deterministic error response of the Multiversx proxy(e.g. 400, 404), which would
+// be the same if the request is retried
+var ErrNonRetryableResponse = errors.New("non retryable multiversx proxy response")
+
+var errNilUpstreamsHandler = errors.New("nil upstreams handler provided")
+
+var errNilUpstreamsMetricsHandler = errors.New("nil upstreams metrics handler provided")
+
+var errNoUpstreamAvailable = errors.New("no upstream available")
+
 var errNilHyperBlockFacade = errors.New("nil hyper block facade provided")
 
 var errInvalidBlockNonce = errors.New("invalid block nonce")
@@ -13,3 +23,55 @@ var errInvalidBlockHash = errors.New("invalid block hash")
 var errInvalidHyperBlocksBatchSize = errors.New("invalid hyper blocks batch size")
 
 var errMissingQueryParameter = errors.New("missing query parameter")
+
+var errInvalidStreamPollingInterval = errors.New("invalid stream polling interval")
+
+var errInvalidFromNonceParameter = errors.New("invalid fromNonce parameter")
+
+var errInvalidFormat = errors.New("invalid format")
+
+var errInvalidCodec = errors.New("invalid codec")
+
+var errInvalidEncoding = errors.New("invalid encoding")
+
+var errNilHyperBlockJsonConverter = errors.New("nil hyper block json converter provided")
+
+var errInvalidPartialParameter = errors.New("invalid partial parameter")
+
+var errInvalidFinalOnlyParameter = errors.New("invalid finalOnly parameter")
+
+var errInvalidWithLogsParameter = errors.New("invalid withLogs parameter")
+
+var errInvalidWithAlteredAccountsParameter = errors.New("invalid withAlteredAccounts parameter")
+
+var errInvalidNotarizedAtSourceParameter = errors.New("invalid notarizedAtSource parameter")
+
+var errQueryOptionOverrideNotAllowed = errors.New("query option override not allowed")
+
+var errInvalidQueryOptionOverride = errors.New("invalid query option override")
+
+var errTransactionsFilterNotAllowed = errors.New("transactions filter parameter not allowed")
+
+// ErrHyperBlockNotFinal signals that a requested hyper block is not final yet, in final only mode
+var ErrHyperBlockNotFinal = errors.New("hyper block is not final yet")
+
+// ErrHyperBlockNotFound signals that no hyper block matches a lookup, e.g. a timestamp before the genesis or an epoch
+// which has not started yet
+var ErrHyperBlockNotFound = errors.New("hyper block not found")
+
+var errInvalidBlockTimestamp = errors.New("invalid block timestamp")
+
+var errInvalidEpoch = errors.New("invalid epoch")
+
+var errInvalidAddress = errors.New("invalid address")
+
+// ErrTransactionNotFound signals that the Multiversx proxy does not know the requested transaction
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+var errNilTransactionFacade = errors.New("nil transaction facade provided")
+
+var errInvalidTransactionHash = errors.New("invalid transaction hash")
+
+var errNilReorgsHandler = errors.New("nil reorgs handler provided")
+
+var errInvalidFromIdParameter = errors.New("invalid fromId parameter")
@@ -12,6 +12,56 @@ var ErrInvalidHyperBlocksBatchSize = errInvalidHyperBlocksBatchSize
 
 var ErrMissingQueryParameter = errMissingQueryParameter
 
+var ErrInvalidStreamPollingInterval = errInvalidStreamPollingInterval
+
+var ErrInvalidFormat = errInvalidFormat
+
+var ErrInvalidCodec = errInvalidCodec
+
+var ErrInvalidPartialParameter = errInvalidPartialParameter
+
+var ErrInvalidFinalOnlyParameter = errInvalidFinalOnlyParameter
+
+// ErrInvalidWithLogsParameter -
+var ErrInvalidWithLogsParameter = errInvalidWithLogsParameter
+
+// ErrQueryOptionOverrideNotAllowed -
+var ErrQueryOptionOverrideNotAllowed = errQueryOptionOverrideNotAllowed
+
+// ErrInvalidQueryOptionOverride -
+var ErrInvalidQueryOptionOverride = errInvalidQueryOptionOverride
+
+// ErrTransactionsFilterNotAllowed -
+var ErrTransactionsFilterNotAllowed = errTransactionsFilterNotAllowed
+
+var ErrNilReorgsHandler = errNilReorgsHandler
+
+var ErrInvalidFromIdParameter = errInvalidFromIdParameter
+
 func GetNonceFromRequest(c *gin.Context) (uint64, error) {
 	return getNonceFromRequest(c)
 }
+
+// ErrInvalidEncoding -
+var ErrInvalidEncoding = errInvalidEncoding
+
+// ErrNilHyperBlockJsonConverter -
+var ErrNilHyperBlockJsonConverter = errNilHyperBlockJsonConverter
+
+// ErrInvalidBlockTimestamp -
+var ErrInvalidBlockTimestamp = errInvalidBlockTimestamp
+
+// ErrInvalidEpoch -
+var ErrInvalidEpoch = errInvalidEpoch
+
+// ErrNilTransactionFacade -
+var ErrNilTransactionFacade = errNilTransactionFacade
+
+// ErrInvalidTransactionHash -
+var ErrInvalidTransactionHash = errInvalidTransactionHash
+
+// ErrInvalidAddress -
+var ErrInvalidAddress = errInvalidAddress
+
+// ErrInvalidFromNonceParameter -
+var ErrInvalidFromNonceParameter = errInvalidFromNonceParameter
@@ -2,22 +2,35 @@ package api
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
 )
 
 const (
-	startNonce = "startNonce"
-	endNonce   = "endNonce"
+	startNonce               = "startNonce"
+	endNonce                 = "endNonce"
+	avroContainerContentType = "application/avro"
 )
 
+var mediaTypesEncodings = map[string]string{
+	MediaTypeAvro:     EncodingAvro,
+	MediaTypeAvroJson: EncodingAvroJson,
+	MediaTypeJson:     EncodingJson,
+}
+
 type hyperBlockProxy struct {
 	hyperBlockFacade HyperBlockFacadeHandler
-	options          config.HyperBlockQueryOptions
+	jsonConverter    HyperBlockJsonConverter
+	queryOptions     *queryOptionsHandler
 	batchSize        uint32
 }
 
@@ -25,18 +38,27 @@ type hyperBlockProxy struct {
 // from Multiversx and return them in covalent format
 func NewHyperBlockProxy(
 	hyperBlockFacade HyperBlockFacadeHandler,
+	jsonConverter HyperBlockJsonConverter,
 	cfg config.Config,
 ) (*hyperBlockProxy, error) {
 	if hyperBlockFacade == nil {
 		return nil, errNilHyperBlockFacade
 	}
+	if jsonConverter == nil {
+		return nil, errNilHyperBlockJsonConverter
+	}
 	if cfg.HyperBlocksBatchSize == 0 {
 		return nil, fmt.Errorf("%w; expected non zero value", errInvalidHyperBlocksBatchSize)
 	}
+	queryOptions, err := newQueryOptionsHandler(cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	return &hyperBlockProxy{
 		hyperBlockFacade: hyperBlockFacade,
-		options:          cfg.HyperBlockQueryOptions,
+		jsonConverter:    jsonConverter,
+		queryOptions:     queryOptions,
 		batchSize:        cfg.HyperBlocksBatchSize,
 	}, nil
 }
@@ -49,13 +71,25 @@ func (hbp *hyperBlockProxy) GetHyperBlockByNonce(c *gin.Context) {
 		return
 	}
 
-	hyperBlockApiResponse, err := hbp.hyperBlockFacade.GetHyperBlockByNonce(nonce, hbp.options)
+	options, err := hbp.queryOptions.getQueryOptionsFromRequest(c)
 	if err != nil {
-		respondWithInternalError(c, err)
+		respondWithBadRequest(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, hyperBlockApiResponse)
+	encoding, err := getEncodingFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	hyperBlockApiResponse, err := hbp.hyperBlockFacade.GetHyperBlockByNonce(c.Request.Context(), nonce, options)
+	if err != nil {
+		respondWithFacadeError(c, err)
+		return
+	}
+
+	hbp.respondWithHyperBlock(c, hyperBlockApiResponse, encoding)
 }
 
 func getNonceFromRequest(c *gin.Context) (uint64, error) {
@@ -75,17 +109,282 @@ func (hbp *hyperBlockProxy) GetHyperBlocksByInterval(c *gin.Context) {
 		return
 	}
 
+	queryOptions, err := hbp.queryOptions.getQueryOptionsFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
 	options := config.HyperBlocksQueryOptions{
-		QueryOptions: hbp.options,
+		QueryOptions: queryOptions,
 		BatchSize:    hbp.batchSize,
 	}
-	hyperBlockApiResponse, err := hbp.hyperBlockFacade.GetHyperBlocksByInterval(noncesInterval, options)
+
+	partial, err := getPartialFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	format := c.Request.URL.Query().Get(UrlParameterFormat)
+	if partial && format != "" {
+		respondWithBadRequest(c, fmt.Errorf("%w: %s is not supported in partial results mode", errInvalidFormat, format))
+		return
+	}
+
+	encoding, err := getEncodingFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+	if encoding != EncodingAvro && (partial || format != "") {
+		respondWithBadRequest(c, fmt.Errorf("%w: %s is only supported for the default format", errInvalidEncoding, encoding))
+		return
+	}
+
+	switch {
+	case partial:
+		hbp.getPartialHyperBlocksByInterval(c, noncesInterval, options)
+	case format == "":
+		hbp.getHyperBlocksByInterval(c, noncesInterval, options, encoding)
+	case format == FormatObjectContainerFile:
+		hbp.getHyperBlocksContainerByInterval(c, noncesInterval, options)
+	default:
+		respondWithBadRequest(c, fmt.Errorf("%w: %s", errInvalidFormat, format))
+	}
+}
+
+func (hbp *hyperBlockProxy) getHyperBlocksByInterval(
+	c *gin.Context,
+	noncesInterval *Interval,
+	options config.HyperBlocksQueryOptions,
+	encoding string,
+) {
+	hyperBlocksApiResponse, err := hbp.hyperBlockFacade.GetHyperBlocksByInterval(c.Request.Context(), noncesInterval, options)
+	if err != nil {
+		respondWithFacadeError(c, err)
+		return
+	}
+	if encoding == EncodingAvro {
+		c.JSON(http.StatusOK, hyperBlocksApiResponse)
+		return
+	}
+
+	convertedHyperBlocks := make([]json.RawMessage, 0, len(hyperBlocksApiResponse.Data))
+	for _, encodedHyperBlock := range hyperBlocksApiResponse.Data {
+		convertedHyperBlock, errConvert := convertHyperBlock(hbp.jsonConverter, encodedHyperBlock, encoding)
+		if errConvert != nil {
+			respondWithInternalError(c, errConvert)
+			return
+		}
+
+		convertedHyperBlocks = append(convertedHyperBlocks, convertedHyperBlock)
+	}
+
+	c.JSON(http.StatusOK, CovalentHyperBlocksJsonApiResponse{
+		Data:  convertedHyperBlocks,
+		Error: hyperBlocksApiResponse.Error,
+		Code:  hyperBlocksApiResponse.Code,
+	})
+}
+
+// respondWithHyperBlock responds with the avro encoded hyper block, converted to the provided encoding
+func (hbp *hyperBlockProxy) respondWithHyperBlock(c *gin.Context, hyperBlockApiResponse *CovalentHyperBlockApiResponse, encoding string) {
+	response, err := createEncodedHyperBlockResponse(hbp.jsonConverter, hyperBlockApiResponse, encoding)
 	if err != nil {
 		respondWithInternalError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, hyperBlockApiResponse)
+	c.JSON(http.StatusOK, response)
+}
+
+// createEncodedHyperBlockResponse returns the response holding the avro encoded hyper block, converted to the
+// provided encoding
+func createEncodedHyperBlockResponse(
+	jsonConverter HyperBlockJsonConverter,
+	hyperBlockApiResponse *CovalentHyperBlockApiResponse,
+	encoding string,
+) (interface{}, error) {
+	if encoding == EncodingAvro {
+		return hyperBlockApiResponse, nil
+	}
+
+	convertedHyperBlock, err := convertHyperBlock(jsonConverter, hyperBlockApiResponse.Data, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	return CovalentHyperBlockJsonApiResponse{
+		Data:  convertedHyperBlock,
+		Error: hyperBlockApiResponse.Error,
+		Code:  hyperBlockApiResponse.Code,
+	}, nil
+}
+
+func convertHyperBlock(jsonConverter HyperBlockJsonConverter, encodedHyperBlock []byte, encoding string) (json.RawMessage, error) {
+	if encoding == EncodingAvroJson {
+		return jsonConverter.ToAvroJson(encodedHyperBlock)
+	}
+
+	return jsonConverter.ToReadableJson(encodedHyperBlock)
+}
+
+// getEncodingFromRequest returns the hyper blocks encoding requested by the encoding URL parameter or, if missing, the
+// first supported media type of the Accept header. Binary avro is the default encoding
+func getEncodingFromRequest(c *gin.Context) (string, error) {
+	encoding := c.Request.URL.Query().Get(UrlParameterEncoding)
+	switch encoding {
+	case EncodingAvro, EncodingAvroJson, EncodingJson:
+		return encoding, nil
+	case "":
+	default:
+		return "", fmt.Errorf("%w: %s", errInvalidEncoding, encoding)
+	}
+
+	for _, acceptedMediaType := range strings.Split(c.GetHeader("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(acceptedMediaType))
+		if err != nil {
+			continue
+		}
+
+		mediaTypeEncoding, found := mediaTypesEncodings[mediaType]
+		if found {
+			return mediaTypeEncoding, nil
+		}
+	}
+
+	return EncodingAvro, nil
+}
+
+func (hbp *hyperBlockProxy) getPartialHyperBlocksByInterval(c *gin.Context, noncesInterval *Interval, options config.HyperBlocksQueryOptions) {
+	hyperBlocksApiResponse, err := hbp.hyperBlockFacade.GetPartialHyperBlocksByInterval(c.Request.Context(), noncesInterval, options)
+	if err != nil {
+		respondWithFacadeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, hyperBlocksApiResponse)
+}
+
+func getPartialFromRequest(c *gin.Context) (bool, error) {
+	return getBoolUrlParam(c, UrlParameterPartial, false, errInvalidPartialParameter)
+}
+
+// getTransactionsFilterFromRequest returns the provided transactions filter, having each criterion narrowed by its
+// URL parameter, if provided. Requests can only narrow the configured criteria, never remove them: an empty URL
+// parameter keeps the configured criterion and values which are not accepted by a configured criterion are refused
+func getTransactionsFilterFromRequest(c *gin.Context, filter config.TransactionsFilter) (config.TransactionsFilter, error) {
+	var err error
+	narrowedFilter := config.TransactionsFilter{}
+	narrowedFilter.Senders, err = narrowFilterCriterion(c, UrlParameterSender, filter.Senders)
+	if err != nil {
+		return config.TransactionsFilter{}, err
+	}
+	narrowedFilter.Receivers, err = narrowFilterCriterion(c, UrlParameterReceiver, filter.Receivers)
+	if err != nil {
+		return config.TransactionsFilter{}, err
+	}
+	narrowedFilter.Functions, err = narrowFilterCriterion(c, UrlParameterFunction, filter.Functions)
+	if err != nil {
+		return config.TransactionsFilter{}, err
+	}
+	narrowedFilter.Tokens, err = narrowFilterCriterion(c, UrlParameterToken, filter.Tokens)
+	if err != nil {
+		return config.TransactionsFilter{}, err
+	}
+	narrowedFilter.Statuses, err = narrowFilterCriterion(c, UrlParameterStatus, filter.Statuses)
+	if err != nil {
+		return config.TransactionsFilter{}, err
+	}
+	narrowedFilter.MiniBlockTypes, err = narrowFilterCriterion(c, UrlParameterMiniBlockType, filter.MiniBlockTypes)
+	if err != nil {
+		return config.TransactionsFilter{}, err
+	}
+
+	return narrowedFilter, nil
+}
+
+// narrowFilterCriterion returns the values of the provided URL parameter, if any, as long as all of them are accepted
+// by the configured criterion. Otherwise, the configured criterion is kept
+func narrowFilterCriterion(c *gin.Context, name string, configuredValues []string) ([]string, error) {
+	values := getListUrlParam(c, name)
+	if len(values) == 0 {
+		return configuredValues, nil
+	}
+	if len(configuredValues) == 0 {
+		return values, nil
+	}
+
+	acceptedValues := make(map[string]struct{}, len(configuredValues))
+	for _, value := range configuredValues {
+		acceptedValues[value] = struct{}{}
+	}
+	for _, value := range values {
+		_, isAccepted := acceptedValues[value]
+		if !isAccepted {
+			return nil, fmt.Errorf("%w: %s=%s is not accepted by the configured filter", errTransactionsFilterNotAllowed, name, value)
+		}
+	}
+
+	return values, nil
+}
+
+// getListUrlParam returns the comma separated values of all the occurrences of the provided URL parameter
+func getListUrlParam(c *gin.Context, name string) []string {
+	values := make([]string, 0)
+	for _, param := range c.Request.URL.Query()[name] {
+		for _, value := range strings.Split(param, ",") {
+			value = strings.TrimSpace(value)
+			if value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+
+	return values
+}
+
+func getBoolUrlParam(c *gin.Context, name string, defaultValue bool, errInvalidParam error) (bool, error) {
+	param := c.Request.URL.Query().Get(name)
+	if param == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseBool(param)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", errInvalidParam, param)
+	}
+
+	return value, nil
+}
+
+func (hbp *hyperBlockProxy) getHyperBlocksContainerByInterval(c *gin.Context, noncesInterval *Interval, options config.HyperBlocksQueryOptions) {
+	codec, err := getCodecFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	container, err := hbp.hyperBlockFacade.GetHyperBlocksContainerByInterval(c.Request.Context(), noncesInterval, options, codec)
+	if err != nil {
+		respondWithFacadeError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, avroContainerContentType, container)
+}
+
+func getCodecFromRequest(c *gin.Context) (string, error) {
+	codec := c.Request.URL.Query().Get(UrlParameterCodec)
+	if codec == "" {
+		return utility.CodecNull, nil
+	}
+	if !utility.IsContainerCodecSupported(codec) {
+		return "", fmt.Errorf("%w: %s", errInvalidCodec, codec)
+	}
+
+	return codec, nil
 }
 
 func getIntervalFromRequest(c *gin.Context) (*Interval, error) {
@@ -122,13 +421,25 @@ func (hbp *hyperBlockProxy) GetHyperBlockByHash(c *gin.Context) {
 		return
 	}
 
-	hyperBlockApiResponse, err := hbp.hyperBlockFacade.GetHyperBlockByHash(hash, hbp.options)
+	options, err := hbp.queryOptions.getQueryOptionsFromRequest(c)
 	if err != nil {
-		respondWithInternalError(c, err)
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	encoding, err := getEncodingFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	hyperBlockApiResponse, err := hbp.hyperBlockFacade.GetHyperBlockByHash(c.Request.Context(), hash, options)
+	if err != nil {
+		respondWithFacadeError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, hyperBlockApiResponse)
+	hbp.respondWithHyperBlock(c, hyperBlockApiResponse, encoding)
 }
 
 func getHashFromRequest(c *gin.Context) (string, error) {
@@ -141,6 +452,152 @@ func getHashFromRequest(c *gin.Context) (string, error) {
 	return hash, nil
 }
 
+// GetHyperBlockByTimestamp will fetch the latest hyper block having its timestamp at or before the requested one
+func (hbp *hyperBlockProxy) GetHyperBlockByTimestamp(c *gin.Context) {
+	timestamp, err := getTimestampFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	options, err := hbp.queryOptions.getQueryOptionsFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	encoding, err := getEncodingFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	nonce, err := hbp.hyperBlockFacade.GetHyperBlockNonceByTimestamp(c.Request.Context(), timestamp)
+	if err != nil {
+		respondWithFacadeError(c, err)
+		return
+	}
+
+	hyperBlockApiResponse, err := hbp.hyperBlockFacade.GetHyperBlockByNonce(c.Request.Context(), nonce, options)
+	if err != nil {
+		respondWithFacadeError(c, err)
+		return
+	}
+
+	hbp.respondWithHyperBlock(c, hyperBlockApiResponse, encoding)
+}
+
+func getTimestampFromRequest(c *gin.Context) (int64, error) {
+	timestamp, err := strconv.ParseInt(c.Param("timestamp"), 10, 64)
+	if err != nil || timestamp < 0 {
+		return 0, fmt.Errorf("%w: %s", errInvalidBlockTimestamp, c.Param("timestamp"))
+	}
+
+	return timestamp, nil
+}
+
+// GetEpochNoncesInterval will fetch the nonces of the first and last hyper blocks of the requested epoch
+func (hbp *hyperBlockProxy) GetEpochNoncesInterval(c *gin.Context) {
+	epoch, err := getEpochFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	epochNoncesInterval, err := hbp.hyperBlockFacade.GetEpochNoncesInterval(c.Request.Context(), epoch)
+	if err != nil {
+		respondWithFacadeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, CovalentEpochNoncesIntervalApiResponse{
+		Data:  epochNoncesInterval,
+		Error: "",
+		Code:  ReturnCodeSuccess,
+	})
+}
+
+func getEpochFromRequest(c *gin.Context) (uint32, error) {
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errInvalidEpoch, c.Param("epoch"))
+	}
+
+	return uint32(epoch), nil
+}
+
+// GetAccountBalanceUpdates will fetch the balance updates of the requested account, from start to end nonce
+func (hbp *hyperBlockProxy) GetAccountBalanceUpdates(c *gin.Context) {
+	address, err := getAddressFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	noncesInterval, err := getIntervalFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	queryOptions, err := hbp.queryOptions.getQueryOptionsFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	options := config.HyperBlocksQueryOptions{
+		QueryOptions: queryOptions,
+		BatchSize:    hbp.batchSize,
+	}
+
+	balanceUpdatesApiResponse, err := hbp.hyperBlockFacade.GetAccountBalanceUpdates(c.Request.Context(), address, noncesInterval, options)
+	if err != nil {
+		respondWithFacadeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, balanceUpdatesApiResponse)
+}
+
+func getAddressFromRequest(c *gin.Context) (string, error) {
+	address := strings.TrimSpace(c.Param("address"))
+	if len(address) == 0 {
+		return "", fmt.Errorf("%w: %s", errInvalidAddress, c.Param("address"))
+	}
+
+	return address, nil
+}
+
+// respondWithFacadeError responds with the retry later code for hyper blocks which are not final yet, with a not found
+// status for lookups which match no hyper block, or with an internal error otherwise
+func respondWithFacadeError(c *gin.Context, err error) {
+	if errors.Is(err, ErrHyperBlockNotFound) || errors.Is(err, ErrTransactionNotFound) {
+		c.JSON(
+			http.StatusNotFound,
+			CovalentHyperBlockApiResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if errors.Is(err, ErrHyperBlockNotFinal) {
+		c.JSON(
+			http.StatusServiceUnavailable,
+			CovalentHyperBlockApiResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  ReturnCodeRetryLater,
+			},
+		)
+		return
+	}
+
+	respondWithInternalError(c, err)
+}
+
 func respondWithInternalError(c *gin.Context, err error) {
 	c.JSON(
 		http.StatusInternalServerError,
@@ -2,6 +2,7 @@ package api_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +14,8 @@ import (
 
 	"github.com/multiversx/mx-chain-covalent-go/api"
 	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
 	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock/apiMocks"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -25,6 +28,7 @@ func init() {
 const (
 	hyperBlockPath  = "/hyperblock"
 	hyperBlocksPath = "/hyperblocks"
+	accountsPath    = "/accounts"
 )
 
 func startProxyServer(proxy api.HyperBlockProxy) *gin.Engine {
@@ -33,8 +37,11 @@ func startProxyServer(proxy api.HyperBlockProxy) *gin.Engine {
 	routes := ws.Group(hyperBlockPath)
 	routes.GET("/by-nonce/:nonce", proxy.GetHyperBlockByNonce)
 	routes.GET("/by-hash/:hash", proxy.GetHyperBlockByHash)
+	routes.GET("/by-timestamp/:timestamp", proxy.GetHyperBlockByTimestamp)
 
 	ws.Group(hyperBlocksPath).GET("", proxy.GetHyperBlocksByInterval)
+	ws.Group(hyperBlocksPath).GET("/by-epoch/:epoch", proxy.GetEpochNoncesInterval)
+	ws.Group(accountsPath).GET("/:address/balance-updates", proxy.GetAccountBalanceUpdates)
 
 	return ws
 }
@@ -86,7 +93,7 @@ func TestNewHyperBlockProxy(t *testing.T) {
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
-		proxy, err := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, getConfig())
+		proxy, err := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		require.Nil(t, err)
 		require.NotNil(t, proxy)
 	})
@@ -94,18 +101,37 @@ func TestNewHyperBlockProxy(t *testing.T) {
 	t.Run("nil facade, should return error", func(t *testing.T) {
 		t.Parallel()
 
-		proxy, err := api.NewHyperBlockProxy(nil, getConfig())
+		proxy, err := api.NewHyperBlockProxy(nil, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		require.Nil(t, proxy)
 		require.Equal(t, api.ErrNilHyperBlockFacade, err)
 	})
 
+	t.Run("nil json converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, err := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, nil, getConfig())
+		require.Nil(t, proxy)
+		require.Equal(t, api.ErrNilHyperBlockJsonConverter, err)
+	})
+
 	t.Run("invalid hyper blocks batch size, should return error", func(t *testing.T) {
 		t.Parallel()
 
-		proxy, err := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, config.Config{})
+		proxy, err := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, config.Config{})
 		require.Nil(t, proxy)
 		require.ErrorIs(t, err, api.ErrInvalidHyperBlocksBatchSize)
 	})
+
+	t.Run("invalid query option override, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := getConfig()
+		cfg.QueryOptionsOverrides = []string{api.UrlParameterWithLogs, api.UrlParameterFinalOnly}
+		proxy, err := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, cfg)
+		require.Nil(t, proxy)
+		require.ErrorIs(t, err, api.ErrInvalidQueryOptionOverride)
+		require.Contains(t, err.Error(), api.UrlParameterFinalOnly)
+	})
 }
 
 func TestGetNonceFromRequest_MissingNonce_ShouldReturnError(t *testing.T) {
@@ -138,12 +164,12 @@ func TestHyperBlockProxy_GetHyperBlockByNonce(t *testing.T) {
 			Code:  "success",
 		}
 		facade := &apiMocks.HyperBlockFacadeStub{
-			GetHyperBlockByNonceCalled: func(nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
 				require.Equal(t, requestedNonce, nonce)
 				return blockResponse, nil
 			},
 		}
-		proxy, _ := api.NewHyperBlockProxy(facade, getConfig())
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		ws := startProxyServer(proxy)
 		requestPath := fmt.Sprintf("%s/by-nonce/%d", hyperBlockPath, requestedNonce)
 		apiResp := sendRequest(t, ws, requestPath, http.StatusOK)
@@ -155,12 +181,12 @@ func TestHyperBlockProxy_GetHyperBlockByNonce(t *testing.T) {
 
 		getHyperBlockFromFacadeCalled := false
 		facade := &apiMocks.HyperBlockFacadeStub{
-			GetHyperBlockByNonceCalled: func(nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
 				getHyperBlockFromFacadeCalled = true
 				return nil, nil
 			},
 		}
-		proxy, _ := api.NewHyperBlockProxy(facade, getConfig())
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		ws := startProxyServer(proxy)
 
 		requestPath := fmt.Sprintf("%s/by-nonce/abc", hyperBlockPath)
@@ -176,11 +202,11 @@ func TestHyperBlockProxy_GetHyperBlockByNonce(t *testing.T) {
 
 		errFacade := errors.New("error getting hyper block from facade")
 		facade := &apiMocks.HyperBlockFacadeStub{
-			GetHyperBlockByNonceCalled: func(nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
 				return nil, errFacade
 			},
 		}
-		proxy, _ := api.NewHyperBlockProxy(facade, getConfig())
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		ws := startProxyServer(proxy)
 
 		requestPath := fmt.Sprintf("%s/by-nonce/4", hyperBlockPath)
@@ -207,7 +233,7 @@ func TestHyperBlockProxy_GetHyperBlocksByInterval(t *testing.T) {
 			Code:  "success",
 		}
 		facade := &apiMocks.HyperBlockFacadeStub{
-			GetHyperBlocksByIntervalCalled: func(noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
 				require.Equal(t, &api.Interval{
 					Start: startNonce,
 					End:   endNonce,
@@ -215,7 +241,7 @@ func TestHyperBlockProxy_GetHyperBlocksByInterval(t *testing.T) {
 				return blockResponse, nil
 			},
 		}
-		proxy, _ := api.NewHyperBlockProxy(facade, getConfig())
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
 
 		ws := startProxyServer(proxy)
 		requestPath := fmt.Sprintf("%s?startNonce=%d&endNonce=%d", hyperBlocksPath, startNonce, endNonce)
@@ -229,12 +255,12 @@ func TestHyperBlockProxy_GetHyperBlocksByInterval(t *testing.T) {
 
 		getHyperBlockFromFacadeCalled := false
 		facade := &apiMocks.HyperBlockFacadeStub{
-			GetHyperBlocksByIntervalCalled: func(noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
 				getHyperBlockFromFacadeCalled = true
 				return nil, nil
 			},
 		}
-		proxy, _ := api.NewHyperBlockProxy(facade, getConfig())
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		ws := startProxyServer(proxy)
 
 		requestPath := fmt.Sprintf("%s?startNonce=abc&endNonce=8", hyperBlocksPath)
@@ -250,12 +276,12 @@ func TestHyperBlockProxy_GetHyperBlocksByInterval(t *testing.T) {
 
 		getHyperBlockFromFacadeCalled := false
 		facade := &apiMocks.HyperBlockFacadeStub{
-			GetHyperBlocksByIntervalCalled: func(noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
 				getHyperBlockFromFacadeCalled = true
 				return nil, nil
 			},
 		}
-		proxy, _ := api.NewHyperBlockProxy(facade, getConfig())
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		ws := startProxyServer(proxy)
 
 		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=cde", hyperBlocksPath)
@@ -271,12 +297,12 @@ func TestHyperBlockProxy_GetHyperBlocksByInterval(t *testing.T) {
 
 		getHyperBlockFromFacadeCalled := false
 		facade := &apiMocks.HyperBlockFacadeStub{
-			GetHyperBlocksByIntervalCalled: func(noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
 				getHyperBlockFromFacadeCalled = true
 				return nil, nil
 			},
 		}
-		proxy, _ := api.NewHyperBlockProxy(facade, getConfig())
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		ws := startProxyServer(proxy)
 
 		requestPath := fmt.Sprintf("%s?startNonce=4", hyperBlocksPath)
@@ -293,11 +319,11 @@ func TestHyperBlockProxy_GetHyperBlocksByInterval(t *testing.T) {
 
 		errFacade := errors.New("error getting hyper block from facade")
 		facade := &apiMocks.HyperBlockFacadeStub{
-			GetHyperBlocksByIntervalCalled: func(noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
 				return nil, errFacade
 			},
 		}
-		proxy, _ := api.NewHyperBlockProxy(facade, getConfig())
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		ws := startProxyServer(proxy)
 
 		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=8", hyperBlocksPath)
@@ -310,6 +336,474 @@ func TestHyperBlockProxy_GetHyperBlocksByInterval(t *testing.T) {
 	})
 }
 
+func TestHyperBlockProxy_GetHyperBlocksByInterval_ObjectContainerFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work with default codec", func(t *testing.T) {
+		t.Parallel()
+
+		container := []byte("container")
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlocksContainerByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions, codec string) ([]byte, error) {
+				require.Equal(t, &api.Interval{
+					Start: 4,
+					End:   8,
+				}, noncesInterval)
+				require.Equal(t, utility.CodecNull, codec)
+				return container, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=8&format=ocf", hyperBlocksPath)
+		body := serveHTTPRequest(t, ws, requestPath, http.StatusOK)
+		require.Equal(t, container, body.Bytes())
+	})
+
+	t.Run("should work with requested codec", func(t *testing.T) {
+		t.Parallel()
+
+		container := []byte("container")
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlocksContainerByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions, codec string) ([]byte, error) {
+				require.Equal(t, utility.CodecSnappy, codec)
+				return container, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=8&format=ocf&codec=snappy", hyperBlocksPath)
+		body := serveHTTPRequest(t, ws, requestPath, http.StatusOK)
+		require.Equal(t, container, body.Bytes())
+	})
+
+	t.Run("invalid format, should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=8&format=parquet", hyperBlocksPath)
+		apiResp := sendHyperBlocksRequest(t, ws, requestPath, http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidFormat.Error()))
+	})
+
+	t.Run("invalid codec, should error", func(t *testing.T) {
+		t.Parallel()
+
+		getContainerFromFacadeCalled := false
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlocksContainerByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions, codec string) ([]byte, error) {
+				getContainerFromFacadeCalled = true
+				return nil, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=8&format=ocf&codec=zstandard", hyperBlocksPath)
+		apiResp := sendHyperBlocksRequest(t, ws, requestPath, http.StatusBadRequest)
+		require.False(t, getContainerFromFacadeCalled)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidCodec.Error()))
+	})
+
+	t.Run("could not get container from facade, should error", func(t *testing.T) {
+		t.Parallel()
+
+		errFacade := errors.New("error getting container from facade")
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlocksContainerByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions, codec string) ([]byte, error) {
+				return nil, errFacade
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=8&format=ocf", hyperBlocksPath)
+		apiResp := sendHyperBlocksRequest(t, ws, requestPath, http.StatusInternalServerError)
+		require.Equal(t, &api.CovalentHyperBlocksApiResponse{
+			Data:  nil,
+			Error: errFacade.Error(),
+			Code:  api.ReturnCodeInternalError,
+		}, apiResp)
+	})
+}
+
+func TestHyperBlockProxy_GetHyperBlocksByInterval_PartialResults(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		blocksResponse := &api.CovalentPartialHyperBlocksApiResponse{
+			Data: []api.CovalentHyperBlockResult{
+				{Nonce: 4, Data: []byte("first"), Code: api.ReturnCodeSuccess},
+				{Nonce: 5, Error: "error", Code: api.ReturnCodeInternalError},
+			},
+			Error: "could not get all hyper blocks: 1 out of 2",
+			Code:  api.ReturnCodePartialSuccess,
+		}
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetPartialHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentPartialHyperBlocksApiResponse, error) {
+				require.Equal(t, &api.Interval{Start: 4, End: 5}, noncesInterval)
+				return blocksResponse, nil
+			},
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+				require.Fail(t, "should not get all hyper blocks in partial results mode")
+				return nil, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=5&partial=true", hyperBlocksPath)
+		body := serveHTTPRequest(t, ws, requestPath, http.StatusOK)
+		apiResp := &api.CovalentPartialHyperBlocksApiResponse{}
+		loadResponse(t, body, apiResp)
+		require.Equal(t, blocksResponse, apiResp)
+	})
+
+	t.Run("partial set to false, should get all hyper blocks", func(t *testing.T) {
+		t.Parallel()
+
+		getHyperBlocksCalled := false
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+				getHyperBlocksCalled = true
+				return &api.CovalentHyperBlocksApiResponse{}, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=5&partial=false", hyperBlocksPath)
+		_ = sendHyperBlocksRequest(t, ws, requestPath, http.StatusOK)
+		require.True(t, getHyperBlocksCalled)
+	})
+
+	t.Run("invalid partial parameter, should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=5&partial=maybe", hyperBlocksPath)
+		apiResp := sendHyperBlocksRequest(t, ws, requestPath, http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidPartialParameter.Error()))
+		require.True(t, strings.Contains(apiResp.Error, "maybe"))
+	})
+
+	t.Run("partial results with object container file format, should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=5&partial=true&format=ocf", hyperBlocksPath)
+		apiResp := sendHyperBlocksRequest(t, ws, requestPath, http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidFormat.Error()))
+	})
+
+	t.Run("could not get hyper blocks from facade, should error", func(t *testing.T) {
+		t.Parallel()
+
+		errFacade := errors.New("error getting hyper blocks from facade")
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetPartialHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentPartialHyperBlocksApiResponse, error) {
+				return nil, errFacade
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=5&partial=true", hyperBlocksPath)
+		apiResp := sendHyperBlocksRequest(t, ws, requestPath, http.StatusInternalServerError)
+		require.Equal(t, api.ReturnCodeInternalError, apiResp.Code)
+		require.Equal(t, errFacade.Error(), apiResp.Error)
+	})
+}
+
+func TestHyperBlockProxy_FinalOnly(t *testing.T) {
+	t.Parallel()
+
+	errNotFinal := fmt.Errorf("%w: nonce: 8, highest final nonce: 6", api.ErrHyperBlockNotFinal)
+
+	t.Run("final only query parameter, should tighten configured option", func(t *testing.T) {
+		t.Parallel()
+
+		expectedFinalOnly := false
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.Equal(t, expectedFinalOnly, options.FinalOnly)
+				return &api.CovalentHyperBlockApiResponse{}, nil
+			},
+			GetHyperBlockByHashCalled: func(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.Equal(t, expectedFinalOnly, options.FinalOnly)
+				return &api.CovalentHyperBlockApiResponse{}, nil
+			},
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+				require.Equal(t, expectedFinalOnly, options.QueryOptions.FinalOnly)
+				return &api.CovalentHyperBlocksApiResponse{}, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		_ = sendRequest(t, ws, fmt.Sprintf("%s/by-nonce/4", hyperBlockPath), http.StatusOK)
+		_ = sendRequest(t, ws, fmt.Sprintf("%s/by-hash/aa?finalOnly=false", hyperBlockPath), http.StatusOK)
+		_ = sendHyperBlocksRequest(t, ws, fmt.Sprintf("%s?startNonce=4&endNonce=8", hyperBlocksPath), http.StatusOK)
+
+		expectedFinalOnly = true
+		_ = sendRequest(t, ws, fmt.Sprintf("%s/by-nonce/4?finalOnly=true", hyperBlockPath), http.StatusOK)
+		_ = sendRequest(t, ws, fmt.Sprintf("%s/by-hash/aa?finalOnly=true", hyperBlockPath), http.StatusOK)
+		_ = sendHyperBlocksRequest(t, ws, fmt.Sprintf("%s?startNonce=4&endNonce=8&finalOnly=true", hyperBlocksPath), http.StatusOK)
+	})
+
+	t.Run("final only configured, should not be loosened by query parameter", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.True(t, options.FinalOnly)
+				return &api.CovalentHyperBlockApiResponse{}, nil
+			},
+		}
+		cfg := getConfig()
+		cfg.HyperBlockQueryOptions.FinalOnly = true
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, cfg)
+		ws := startProxyServer(proxy)
+
+		_ = sendRequest(t, ws, fmt.Sprintf("%s/by-nonce/4", hyperBlockPath), http.StatusOK)
+		_ = sendRequest(t, ws, fmt.Sprintf("%s/by-nonce/4?finalOnly=true", hyperBlockPath), http.StatusOK)
+
+		requestPaths := []string{
+			fmt.Sprintf("%s/by-nonce/4?finalOnly=false", hyperBlockPath),
+			fmt.Sprintf("%s/by-hash/aa?finalOnly=false", hyperBlockPath),
+			fmt.Sprintf("%s?startNonce=4&endNonce=8&finalOnly=false", hyperBlocksPath),
+		}
+		for _, requestPath := range requestPaths {
+			apiResp := sendRequest(t, ws, requestPath, http.StatusBadRequest)
+			require.Equal(t, api.ReturnCodeRequestError, apiResp.Code, requestPath)
+			require.Contains(t, apiResp.Error, api.ErrQueryOptionOverrideNotAllowed.Error(), requestPath)
+		}
+	})
+
+	t.Run("invalid final only query parameter, should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		apiResp := sendRequest(t, ws, fmt.Sprintf("%s/by-nonce/4?finalOnly=abc", hyperBlockPath), http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidFinalOnlyParameter.Error()))
+
+		apiResp = sendRequest(t, ws, fmt.Sprintf("%s/by-hash/aa?finalOnly=abc", hyperBlockPath), http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+
+		apiResp = sendRequest(t, ws, fmt.Sprintf("%s?startNonce=4&endNonce=8&finalOnly=abc", hyperBlocksPath), http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+	})
+
+	t.Run("hyper blocks not final, should respond with retry later code", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				return nil, errNotFinal
+			},
+			GetHyperBlockByHashCalled: func(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				return nil, errNotFinal
+			},
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+				return nil, errNotFinal
+			},
+			GetPartialHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentPartialHyperBlocksApiResponse, error) {
+				return nil, errNotFinal
+			},
+			GetHyperBlocksContainerByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions, codec string) ([]byte, error) {
+				return nil, errNotFinal
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		expectedResponse := &api.CovalentHyperBlockApiResponse{
+			Data:  nil,
+			Error: errNotFinal.Error(),
+			Code:  api.ReturnCodeRetryLater,
+		}
+		requestPaths := []string{
+			fmt.Sprintf("%s/by-nonce/8?finalOnly=true", hyperBlockPath),
+			fmt.Sprintf("%s/by-hash/aa?finalOnly=true", hyperBlockPath),
+			fmt.Sprintf("%s?startNonce=4&endNonce=8&finalOnly=true", hyperBlocksPath),
+			fmt.Sprintf("%s?startNonce=4&endNonce=8&finalOnly=true&partial=true", hyperBlocksPath),
+			fmt.Sprintf("%s?startNonce=4&endNonce=8&finalOnly=true&format=ocf", hyperBlocksPath),
+		}
+		for _, requestPath := range requestPaths {
+			apiResp := sendRequest(t, ws, requestPath, http.StatusServiceUnavailable)
+			require.Equal(t, expectedResponse, apiResp, requestPath)
+		}
+	})
+}
+
+func TestHyperBlockProxy_QueryOptionsOverrides(t *testing.T) {
+	t.Parallel()
+
+	configuredOptions := config.HyperBlockQueryOptions{
+		WithLogs:            true,
+		WithAlteredAccounts: true,
+		NotarizedAtSource:   true,
+		Tokens:              "all",
+	}
+
+	t.Run("allowed overrides, should override configured options", func(t *testing.T) {
+		t.Parallel()
+
+		var expectedOptions config.HyperBlockQueryOptions
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.Equal(t, expectedOptions, options)
+				return &api.CovalentHyperBlockApiResponse{}, nil
+			},
+			GetHyperBlockByHashCalled: func(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.Equal(t, expectedOptions, options)
+				return &api.CovalentHyperBlockApiResponse{}, nil
+			},
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+				require.Equal(t, expectedOptions, options.QueryOptions)
+				return &api.CovalentHyperBlocksApiResponse{}, nil
+			},
+		}
+		cfg := getConfig()
+		cfg.HyperBlockQueryOptions = configuredOptions
+		cfg.QueryOptionsOverrides = []string{
+			api.UrlParameterWithLogs,
+			api.UrlParameterWithAlteredAccounts,
+			api.UrlParameterNotarizedAtSource,
+			api.UrlParameterTokens,
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, cfg)
+		ws := startProxyServer(proxy)
+
+		expectedOptions = configuredOptions
+		_ = sendRequest(t, ws, fmt.Sprintf("%s/by-nonce/4", hyperBlockPath), http.StatusOK)
+		_ = sendRequest(t, ws, fmt.Sprintf("%s/by-hash/aa", hyperBlockPath), http.StatusOK)
+		_ = sendHyperBlocksRequest(t, ws, fmt.Sprintf("%s?startNonce=4&endNonce=8", hyperBlocksPath), http.StatusOK)
+
+		expectedOptions = config.HyperBlockQueryOptions{
+			WithLogs:            false,
+			WithAlteredAccounts: false,
+			NotarizedAtSource:   true,
+			Tokens:              "",
+		}
+		query := "withLogs=false&withAlteredAccounts=false&tokens="
+		_ = sendRequest(t, ws, fmt.Sprintf("%s/by-nonce/4?%s", hyperBlockPath, query), http.StatusOK)
+		_ = sendRequest(t, ws, fmt.Sprintf("%s/by-hash/aa?%s", hyperBlockPath, query), http.StatusOK)
+		_ = sendHyperBlocksRequest(t, ws, fmt.Sprintf("%s?startNonce=4&endNonce=8&%s", hyperBlocksPath, query), http.StatusOK)
+
+		expectedOptions = configuredOptions
+		expectedOptions.Tokens = "WEGLD-abcdef,MEX-abcdef"
+		_ = sendRequest(t, ws, fmt.Sprintf("%s/by-nonce/4?tokens=WEGLD-abcdef,MEX-abcdef", hyperBlockPath), http.StatusOK)
+	})
+
+	t.Run("override not allowed, should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := getConfig()
+		cfg.HyperBlockQueryOptions = configuredOptions
+		cfg.QueryOptionsOverrides = []string{api.UrlParameterWithLogs}
+		proxy, _ := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, cfg)
+		ws := startProxyServer(proxy)
+
+		requestPaths := []string{
+			fmt.Sprintf("%s/by-nonce/4?withLogs=false&withAlteredAccounts=false", hyperBlockPath),
+			fmt.Sprintf("%s/by-hash/aa?tokens=", hyperBlockPath),
+			fmt.Sprintf("%s?startNonce=4&endNonce=8&notarizedAtSource=false", hyperBlocksPath),
+		}
+		for _, requestPath := range requestPaths {
+			apiResp := sendRequest(t, ws, requestPath, http.StatusBadRequest)
+			require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+			require.Contains(t, apiResp.Error, api.ErrQueryOptionOverrideNotAllowed.Error(), requestPath)
+		}
+	})
+
+	t.Run("invalid override, should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := getConfig()
+		cfg.QueryOptionsOverrides = []string{api.UrlParameterWithLogs}
+		proxy, _ := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, cfg)
+		ws := startProxyServer(proxy)
+
+		apiResp := sendRequest(t, ws, fmt.Sprintf("%s/by-nonce/4?withLogs=abc", hyperBlockPath), http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.Contains(t, apiResp.Error, api.ErrInvalidWithLogsParameter.Error())
+	})
+}
+
+func TestHyperBlockProxy_TransactionsFilter(t *testing.T) {
+	t.Parallel()
+
+	var expectedFilter config.TransactionsFilter
+	facade := &apiMocks.HyperBlockFacadeStub{
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			require.Equal(t, expectedFilter, options.TransactionsFilter)
+			return &api.CovalentHyperBlockApiResponse{}, nil
+		},
+		GetHyperBlockByHashCalled: func(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			require.Equal(t, expectedFilter, options.TransactionsFilter)
+			return &api.CovalentHyperBlockApiResponse{}, nil
+		},
+		GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+			require.Equal(t, expectedFilter, options.QueryOptions.TransactionsFilter)
+			return &api.CovalentHyperBlocksApiResponse{}, nil
+		},
+	}
+	cfg := getConfig()
+	cfg.HyperBlockQueryOptions.TransactionsFilter = config.TransactionsFilter{
+		Senders:  []string{"erd1a"},
+		Statuses: []string{"success"},
+	}
+	proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, cfg)
+	ws := startProxyServer(proxy)
+
+	expectedFilter = cfg.HyperBlockQueryOptions.TransactionsFilter
+	_ = sendRequest(t, ws, fmt.Sprintf("%s/by-nonce/4", hyperBlockPath), http.StatusOK)
+	_ = sendRequest(t, ws, fmt.Sprintf("%s/by-hash/aa", hyperBlockPath), http.StatusOK)
+	_ = sendHyperBlocksRequest(t, ws, fmt.Sprintf("%s?startNonce=4&endNonce=8", hyperBlocksPath), http.StatusOK)
+
+	expectedFilter = config.TransactionsFilter{
+		Senders:        []string{"erd1a"},
+		Receivers:      []string{"erd1b", "erd1c", "erd1d"},
+		Functions:      []string{"claim"},
+		Tokens:         []string{"WEGLD-abcdef"},
+		Statuses:       []string{"success"},
+		MiniBlockTypes: []string{"TxBlock"},
+	}
+	query := "sender=erd1a&receiver=erd1b,%20erd1c&receiver=erd1d&function=claim&token=WEGLD-abcdef&status=&miniBlockType=TxBlock"
+	_ = sendRequest(t, ws, fmt.Sprintf("%s/by-nonce/4?%s", hyperBlockPath, query), http.StatusOK)
+	_ = sendRequest(t, ws, fmt.Sprintf("%s/by-hash/aa?%s", hyperBlockPath, query), http.StatusOK)
+	_ = sendHyperBlocksRequest(t, ws, fmt.Sprintf("%s?startNonce=4&endNonce=8&%s", hyperBlocksPath, query), http.StatusOK)
+
+	requestPaths := []string{
+		fmt.Sprintf("%s/by-nonce/4?sender=erd1a,erd1b", hyperBlockPath),
+		fmt.Sprintf("%s/by-hash/aa?status=fail", hyperBlockPath),
+		fmt.Sprintf("%s?startNonce=4&endNonce=8&sender=erd1b", hyperBlocksPath),
+	}
+	for _, requestPath := range requestPaths {
+		apiResp := sendRequest(t, ws, requestPath, http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code, requestPath)
+		require.Contains(t, apiResp.Error, api.ErrTransactionsFilterNotAllowed.Error(), requestPath)
+	}
+}
+
 func TestHyperBlockProxy_GetHyperBlockByHash(t *testing.T) {
 	t.Parallel()
 
@@ -323,12 +817,12 @@ func TestHyperBlockProxy_GetHyperBlockByHash(t *testing.T) {
 			Code:  "success",
 		}
 		facade := &apiMocks.HyperBlockFacadeStub{
-			GetHyperBlockByHashCalled: func(hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			GetHyperBlockByHashCalled: func(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
 				require.Equal(t, requestedHash, hash)
 				return blockResponse, nil
 			},
 		}
-		proxy, _ := api.NewHyperBlockProxy(facade, getConfig())
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		ws := startProxyServer(proxy)
 		requestPath := fmt.Sprintf("%s/by-hash/%s", hyperBlockPath, requestedHash)
 		apiResp := sendRequest(t, ws, requestPath, http.StatusOK)
@@ -340,12 +834,12 @@ func TestHyperBlockProxy_GetHyperBlockByHash(t *testing.T) {
 
 		getHyperBlockFromFacadeCalled := false
 		facade := &apiMocks.HyperBlockFacadeStub{
-			GetHyperBlockByHashCalled: func(hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			GetHyperBlockByHashCalled: func(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
 				getHyperBlockFromFacadeCalled = true
 				return nil, nil
 			},
 		}
-		proxy, _ := api.NewHyperBlockProxy(facade, getConfig())
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		ws := startProxyServer(proxy)
 
 		requestPath := fmt.Sprintf("%s/by-hash/zx", hyperBlockPath)
@@ -361,11 +855,11 @@ func TestHyperBlockProxy_GetHyperBlockByHash(t *testing.T) {
 
 		errFacade := errors.New("error getting hyper block from facade")
 		facade := &apiMocks.HyperBlockFacadeStub{
-			GetHyperBlockByHashCalled: func(hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			GetHyperBlockByHashCalled: func(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
 				return nil, errFacade
 			},
 		}
-		proxy, _ := api.NewHyperBlockProxy(facade, getConfig())
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
 		ws := startProxyServer(proxy)
 
 		requestPath := fmt.Sprintf("%s/by-hash/ff", hyperBlockPath)
@@ -377,3 +871,442 @@ func TestHyperBlockProxy_GetHyperBlockByHash(t *testing.T) {
 		}, apiResp)
 	})
 }
+
+func TestHyperBlockProxy_GetHyperBlockByTimestamp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		blockResponse := &api.CovalentHyperBlockApiResponse{
+			Data:  []byte("abc"),
+			Error: "",
+			Code:  api.ReturnCodeSuccess,
+		}
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlockNonceByTimestampCalled: func(ctx context.Context, timestamp int64) (uint64, error) {
+				require.Equal(t, int64(1650000000), timestamp)
+				return 17, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.Equal(t, uint64(17), nonce)
+				require.True(t, options.FinalOnly)
+				return blockResponse, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s/by-timestamp/1650000000?finalOnly=true", hyperBlockPath)
+		apiResp := sendRequest(t, ws, requestPath, http.StatusOK)
+		require.Equal(t, blockResponse, apiResp)
+	})
+
+	t.Run("invalid timestamp, should error", func(t *testing.T) {
+		t.Parallel()
+
+		getNonceFromFacadeCalled := false
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlockNonceByTimestampCalled: func(ctx context.Context, timestamp int64) (uint64, error) {
+				getNonceFromFacadeCalled = true
+				return 0, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		for _, timestamp := range []string{"abc", "-4"} {
+			requestPath := fmt.Sprintf("%s/by-timestamp/%s", hyperBlockPath, timestamp)
+			apiResp := sendRequest(t, ws, requestPath, http.StatusBadRequest)
+			require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+			require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidBlockTimestamp.Error()))
+		}
+		require.False(t, getNonceFromFacadeCalled)
+	})
+
+	t.Run("no hyper block at or before timestamp, should respond with not found", func(t *testing.T) {
+		t.Parallel()
+
+		errNotFound := fmt.Errorf("%w: timestamp 4 is before the genesis", api.ErrHyperBlockNotFound)
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlockNonceByTimestampCalled: func(ctx context.Context, timestamp int64) (uint64, error) {
+				return 0, errNotFound
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		apiResp := sendRequest(t, ws, fmt.Sprintf("%s/by-timestamp/4", hyperBlockPath), http.StatusNotFound)
+		require.Equal(t, &api.CovalentHyperBlockApiResponse{
+			Data:  nil,
+			Error: errNotFound.Error(),
+			Code:  api.ReturnCodeRequestError,
+		}, apiResp)
+	})
+
+	t.Run("could not get hyper block from facade, should error", func(t *testing.T) {
+		t.Parallel()
+
+		errFacade := errors.New("error getting hyper block from facade")
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				return nil, errFacade
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		apiResp := sendRequest(t, ws, fmt.Sprintf("%s/by-timestamp/4", hyperBlockPath), http.StatusInternalServerError)
+		require.Equal(t, api.ReturnCodeInternalError, apiResp.Code)
+		require.Equal(t, errFacade.Error(), apiResp.Error)
+	})
+}
+
+func TestHyperBlockProxy_GetEpochNoncesInterval(t *testing.T) {
+	t.Parallel()
+
+	sendEpochRequest := func(t *testing.T, ws *gin.Engine, epoch string, expectedStatus int) *api.CovalentEpochNoncesIntervalApiResponse {
+		body := serveHTTPRequest(t, ws, fmt.Sprintf("%s/by-epoch/%s", hyperBlocksPath, epoch), expectedStatus)
+
+		apiResp := &api.CovalentEpochNoncesIntervalApiResponse{}
+		loadResponse(t, body, apiResp)
+
+		return apiResp
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		epochNoncesInterval := &api.EpochNoncesInterval{
+			Epoch:      4,
+			StartNonce: 40,
+			EndNonce:   49,
+			IsComplete: true,
+		}
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetEpochNoncesIntervalCalled: func(ctx context.Context, epoch uint32) (*api.EpochNoncesInterval, error) {
+				require.Equal(t, uint32(4), epoch)
+				return epochNoncesInterval, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		apiResp := sendEpochRequest(t, ws, "4", http.StatusOK)
+		require.Equal(t, &api.CovalentEpochNoncesIntervalApiResponse{
+			Data:  epochNoncesInterval,
+			Error: "",
+			Code:  api.ReturnCodeSuccess,
+		}, apiResp)
+	})
+
+	t.Run("invalid epoch, should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		for _, epoch := range []string{"abc", "-4", "4294967296"} {
+			apiResp := sendEpochRequest(t, ws, epoch, http.StatusBadRequest)
+			require.Nil(t, apiResp.Data)
+			require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+			require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidEpoch.Error()))
+		}
+	})
+
+	t.Run("epoch not started yet, should respond with not found", func(t *testing.T) {
+		t.Parallel()
+
+		errNotFound := fmt.Errorf("%w: epoch 5 has not started yet, current epoch: 4", api.ErrHyperBlockNotFound)
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetEpochNoncesIntervalCalled: func(ctx context.Context, epoch uint32) (*api.EpochNoncesInterval, error) {
+				return nil, errNotFound
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		apiResp := sendEpochRequest(t, ws, "5", http.StatusNotFound)
+		require.Nil(t, apiResp.Data)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.Equal(t, errNotFound.Error(), apiResp.Error)
+	})
+}
+
+func TestHyperBlockProxy_GetAccountBalanceUpdates(t *testing.T) {
+	t.Parallel()
+
+	sendBalanceUpdatesRequest := func(t *testing.T, ws *gin.Engine, path string, expectedStatus int) *api.CovalentHyperBlockApiResponse {
+		body := serveHTTPRequest(t, ws, accountsPath+path, expectedStatus)
+
+		apiResp := &api.CovalentHyperBlockApiResponse{}
+		loadResponse(t, body, apiResp)
+
+		return apiResp
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResponse := &api.CovalentHyperBlockApiResponse{
+			Data:  []byte("balanceUpdates"),
+			Error: "",
+			Code:  api.ReturnCodeSuccess,
+		}
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetAccountBalanceUpdatesCalled: func(ctx context.Context, address string, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.Equal(t, "erd1a", address)
+				require.Equal(t, &api.Interval{Start: 4, End: 8}, noncesInterval)
+				require.Equal(t, config.HyperBlocksQueryOptions{
+					QueryOptions: config.HyperBlockQueryOptions{
+						Tokens:             "TKN-abcdef",
+						TransactionsFilter: config.TransactionsFilter{},
+					},
+					BatchSize: 10,
+				}, options)
+				return expectedResponse, nil
+			},
+		}
+		cfg := getConfig()
+		cfg.QueryOptionsOverrides = []string{api.UrlParameterTokens}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, cfg)
+		ws := startProxyServer(proxy)
+
+		apiResp := sendBalanceUpdatesRequest(t, ws, "/erd1a/balance-updates?startNonce=4&endNonce=8&tokens=TKN-abcdef", http.StatusOK)
+		require.Equal(t, expectedResponse, apiResp)
+	})
+
+	t.Run("invalid request, should respond with bad request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetAccountBalanceUpdatesCalled: func(ctx context.Context, address string, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.Fail(t, "should not call facade")
+				return nil, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		apiResp := sendBalanceUpdatesRequest(t, ws, "/%20/balance-updates?startNonce=4&endNonce=8", http.StatusBadRequest)
+		require.Nil(t, apiResp.Data)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidAddress.Error()))
+
+		apiResp = sendBalanceUpdatesRequest(t, ws, "/erd1a/balance-updates?startNonce=4", http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+
+		apiResp = sendBalanceUpdatesRequest(t, ws, "/erd1a/balance-updates?startNonce=4&endNonce=8&withLogs=true", http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrQueryOptionOverrideNotAllowed.Error()))
+	})
+
+	t.Run("facade error, should respond with internal error", func(t *testing.T) {
+		t.Parallel()
+
+		errFacade := errors.New("error getting balance updates")
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetAccountBalanceUpdatesCalled: func(ctx context.Context, address string, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				return nil, errFacade
+			},
+		}
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+		ws := startProxyServer(proxy)
+
+		apiResp := sendBalanceUpdatesRequest(t, ws, "/erd1a/balance-updates?startNonce=4&endNonce=8", http.StatusInternalServerError)
+		require.Equal(t, &api.CovalentHyperBlockApiResponse{
+			Data:  nil,
+			Error: errFacade.Error(),
+			Code:  api.ReturnCodeInternalError,
+		}, apiResp)
+	})
+}
+
+func TestHyperBlockProxy_ShouldPassRequestContextToFacade(t *testing.T) {
+	t.Parallel()
+
+	type ctxKey struct{}
+	numCalls := 0
+	checkContext := func(ctx context.Context) {
+		require.Equal(t, "value", ctx.Value(ctxKey{}))
+		numCalls++
+	}
+	facade := &apiMocks.HyperBlockFacadeStub{
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			checkContext(ctx)
+			return &api.CovalentHyperBlockApiResponse{}, nil
+		},
+		GetHyperBlockByHashCalled: func(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			checkContext(ctx)
+			return &api.CovalentHyperBlockApiResponse{}, nil
+		},
+		GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+			checkContext(ctx)
+			return &api.CovalentHyperBlocksApiResponse{}, nil
+		},
+		GetHyperBlocksContainerByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions, codec string) ([]byte, error) {
+			checkContext(ctx)
+			return []byte("container"), nil
+		},
+	}
+	proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{}, getConfig())
+	ws := startProxyServer(proxy)
+
+	paths := []string{
+		fmt.Sprintf("%s/by-nonce/4", hyperBlockPath),
+		fmt.Sprintf("%s/by-hash/abcd", hyperBlockPath),
+		fmt.Sprintf("%s?startNonce=1&endNonce=2", hyperBlocksPath),
+		fmt.Sprintf("%s?startNonce=1&endNonce=2&format=ocf", hyperBlocksPath),
+	}
+	for _, path := range paths {
+		ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+		require.Nil(t, err)
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+	}
+	require.Equal(t, len(paths), numCalls)
+}
+
+func serveHTTPRequestWithAccept(t *testing.T, ws *gin.Engine, path string, accept string, expectedStatus int) *bytes.Buffer {
+	req, err := http.NewRequest("GET", path, nil)
+	require.Nil(t, err)
+	req.Header.Set("Accept", accept)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, expectedStatus, resp.Code)
+
+	return resp.Body
+}
+
+func TestHyperBlockProxy_Encodings(t *testing.T) {
+	t.Parallel()
+
+	encodedHyperBlock := []byte("encoded")
+	facade := &apiMocks.HyperBlockFacadeStub{
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			return &api.CovalentHyperBlockApiResponse{Data: encodedHyperBlock, Code: api.ReturnCodeSuccess}, nil
+		},
+		GetHyperBlockByHashCalled: func(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			return &api.CovalentHyperBlockApiResponse{Data: encodedHyperBlock, Code: api.ReturnCodeSuccess}, nil
+		},
+		GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+			return &api.CovalentHyperBlocksApiResponse{Data: [][]byte{encodedHyperBlock, encodedHyperBlock}, Code: api.ReturnCodeSuccess}, nil
+		},
+	}
+	jsonConverter := &mock.HyperBlockJsonConverterStub{
+		ToAvroJsonCalled: func(hyperBlock []byte) (json.RawMessage, error) {
+			require.Equal(t, encodedHyperBlock, hyperBlock)
+			return json.RawMessage(`{"encoding":"avro-json"}`), nil
+		},
+		ToReadableJsonCalled: func(hyperBlock []byte) (json.RawMessage, error) {
+			require.Equal(t, encodedHyperBlock, hyperBlock)
+			return json.RawMessage(`{"encoding":"json"}`), nil
+		},
+	}
+
+	t.Run("no encoding requested, should respond with binary avro", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockProxy(facade, jsonConverter, getConfig())
+		ws := startProxyServer(proxy)
+
+		body := serveHTTPRequestWithAccept(t, ws, hyperBlockPath+"/by-nonce/4", "application/json, */*;q=0.8", http.StatusOK)
+		apiResp := &api.CovalentHyperBlockApiResponse{}
+		loadResponse(t, body, apiResp)
+		require.Equal(t, encodedHyperBlock, apiResp.Data)
+	})
+
+	t.Run("encoding url parameter, should respond with requested encoding", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockProxy(facade, jsonConverter, getConfig())
+		ws := startProxyServer(proxy)
+
+		body := serveHTTPRequest(t, ws, hyperBlockPath+"/by-nonce/4?encoding=json", http.StatusOK)
+		apiResp := &api.CovalentHyperBlockJsonApiResponse{}
+		loadResponse(t, body, apiResp)
+		require.Equal(t, `{"encoding":"json"}`, string(apiResp.Data))
+		require.Equal(t, api.ReturnCodeSuccess, apiResp.Code)
+
+		body = serveHTTPRequestWithAccept(t, ws, hyperBlockPath+"/by-hash/abcd?encoding=avro", api.MediaTypeJson, http.StatusOK)
+		avroApiResp := &api.CovalentHyperBlockApiResponse{}
+		loadResponse(t, body, avroApiResp)
+		require.Equal(t, encodedHyperBlock, avroApiResp.Data)
+	})
+
+	t.Run("accept header, should respond with first supported media type", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockProxy(facade, jsonConverter, getConfig())
+		ws := startProxyServer(proxy)
+
+		accept := fmt.Sprintf("text/html, %s;q=0.9, %s", api.MediaTypeAvroJson, api.MediaTypeJson)
+		body := serveHTTPRequestWithAccept(t, ws, hyperBlockPath+"/by-hash/abcd", accept, http.StatusOK)
+		apiResp := &api.CovalentHyperBlockJsonApiResponse{}
+		loadResponse(t, body, apiResp)
+		require.Equal(t, `{"encoding":"avro-json"}`, string(apiResp.Data))
+	})
+
+	t.Run("hyper blocks interval, should convert each hyper block", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockProxy(facade, jsonConverter, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=5&encoding=avro-json", hyperBlocksPath)
+		body := serveHTTPRequest(t, ws, requestPath, http.StatusOK)
+		apiResp := &api.CovalentHyperBlocksJsonApiResponse{}
+		loadResponse(t, body, apiResp)
+		require.Len(t, apiResp.Data, 2)
+		for _, hyperBlock := range apiResp.Data {
+			require.Equal(t, `{"encoding":"avro-json"}`, string(hyperBlock))
+		}
+	})
+
+	t.Run("invalid encoding, should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockProxy(&apiMocks.HyperBlockFacadeStub{
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}, jsonConverter, getConfig())
+		ws := startProxyServer(proxy)
+
+		apiResp := sendRequest(t, ws, hyperBlockPath+"/by-nonce/4?encoding=xml", http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidEncoding.Error()))
+	})
+
+	t.Run("json encoding with object container file format, should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockProxy(facade, jsonConverter, getConfig())
+		ws := startProxyServer(proxy)
+
+		requestPath := fmt.Sprintf("%s?startNonce=4&endNonce=5&format=ocf&encoding=json", hyperBlocksPath)
+		apiResp := sendHyperBlocksRequest(t, ws, requestPath, http.StatusBadRequest)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidEncoding.Error()))
+	})
+
+	t.Run("could not convert hyper block, should error", func(t *testing.T) {
+		t.Parallel()
+
+		errConvert := errors.New("convert error")
+		proxy, _ := api.NewHyperBlockProxy(facade, &mock.HyperBlockJsonConverterStub{
+			ToReadableJsonCalled: func(hyperBlock []byte) (json.RawMessage, error) {
+				return nil, errConvert
+			},
+		}, getConfig())
+		ws := startProxyServer(proxy)
+
+		apiResp := sendRequest(t, ws, hyperBlockPath+"/by-nonce/4?encoding=json", http.StatusInternalServerError)
+		require.Equal(t, api.ReturnCodeInternalError, apiResp.Code)
+		require.Equal(t, errConvert.Error(), apiResp.Error)
+	})
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+)
+
+// overridableQueryOptions holds the URL parameters of the hyper block query options which can be overridden per
+// request, if allowed by the config
+var overridableQueryOptions = []string{
+	UrlParameterWithLogs,
+	UrlParameterWithAlteredAccounts,
+	UrlParameterNotarizedAtSource,
+	UrlParameterTokens,
+}
+
+// queryOptionsHandler resolves the hyper block query options of a request, as the configured ones overridden by the
+// URL parameters of the request
+type queryOptionsHandler struct {
+	options          config.HyperBlockQueryOptions
+	allowedOverrides map[string]struct{}
+}
+
+func newQueryOptionsHandler(cfg config.Config) (*queryOptionsHandler, error) {
+	allowedOverrides, err := getAllowedOverrides(cfg.QueryOptionsOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryOptionsHandler{
+		options:          cfg.HyperBlockQueryOptions,
+		allowedOverrides: allowedOverrides,
+	}, nil
+}
+
+func getAllowedOverrides(queryOptionsOverrides []string) (map[string]struct{}, error) {
+	allowedOverrides := make(map[string]struct{}, len(queryOptionsOverrides))
+	for _, queryOption := range queryOptionsOverrides {
+		if !isQueryOptionOverridable(queryOption) {
+			return nil, fmt.Errorf("%w: %s; expected one of %s",
+				errInvalidQueryOptionOverride, queryOption, strings.Join(overridableQueryOptions, ", "))
+		}
+
+		allowedOverrides[queryOption] = struct{}{}
+	}
+
+	return allowedOverrides, nil
+}
+
+func isQueryOptionOverridable(queryOption string) bool {
+	for _, overridableQueryOption := range overridableQueryOptions {
+		if queryOption == overridableQueryOption {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getQueryOptionsFromRequest returns the configured hyper block query options, overridden by the ones provided in
+// the request. The finalOnly URL parameter can only tighten the configured option: if final hyper blocks are
+// configured to be served only, a request can not ask for non final ones
+func (qoh *queryOptionsHandler) getQueryOptionsFromRequest(c *gin.Context) (config.HyperBlockQueryOptions, error) {
+	options, err := qoh.getUpstreamQueryOptionsFromRequest(c)
+	if err != nil {
+		return config.HyperBlockQueryOptions{}, err
+	}
+
+	finalOnly, err := getBoolUrlParam(c, UrlParameterFinalOnly, options.FinalOnly, errInvalidFinalOnlyParameter)
+	if err != nil {
+		return config.HyperBlockQueryOptions{}, err
+	}
+	if options.FinalOnly && !finalOnly {
+		return config.HyperBlockQueryOptions{}, fmt.Errorf("%w: %s=false, since only final hyper blocks are served",
+			errQueryOptionOverrideNotAllowed, UrlParameterFinalOnly)
+	}
+	options.FinalOnly = finalOnly
+	options.TransactionsFilter, err = getTransactionsFilterFromRequest(c, options.TransactionsFilter)
+	if err != nil {
+		return config.HyperBlockQueryOptions{}, err
+	}
+
+	return options, nil
+}
+
+// getUpstreamQueryOptionsFromRequest returns the configured hyper block query options, having the ones sent to
+// Multiversx proxy overridden by the URL parameters of the request. Only the overrides allowed by the config are
+// accepted
+func (qoh *queryOptionsHandler) getUpstreamQueryOptionsFromRequest(c *gin.Context) (config.HyperBlockQueryOptions, error) {
+	options := qoh.options
+	query := c.Request.URL.Query()
+	for _, queryOption := range overridableQueryOptions {
+		_, found := query[queryOption]
+		if !found {
+			continue
+		}
+		_, isAllowed := qoh.allowedOverrides[queryOption]
+		if !isAllowed {
+			return config.HyperBlockQueryOptions{}, fmt.Errorf("%w: %s", errQueryOptionOverrideNotAllowed, queryOption)
+		}
+	}
+
+	var err error
+	options.WithLogs, err = getBoolUrlParam(c, UrlParameterWithLogs, options.WithLogs, errInvalidWithLogsParameter)
+	if err != nil {
+		return config.HyperBlockQueryOptions{}, err
+	}
+	options.WithAlteredAccounts, err = getBoolUrlParam(c, UrlParameterWithAlteredAccounts, options.WithAlteredAccounts, errInvalidWithAlteredAccountsParameter)
+	if err != nil {
+		return config.HyperBlockQueryOptions{}, err
+	}
+	options.NotarizedAtSource, err = getBoolUrlParam(c, UrlParameterNotarizedAtSource, options.NotarizedAtSource, errInvalidNotarizedAtSourceParameter)
+	if err != nil {
+		return config.HyperBlockQueryOptions{}, err
+	}
+	tokens, found := query[UrlParameterTokens]
+	if found {
+		options.Tokens = strings.Join(tokens, ",")
+	}
+
+	return options, nil
+}
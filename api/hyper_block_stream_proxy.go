@@ -0,0 +1,289 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+)
+
+const (
+	fromNonce       = "fromNonce"
+	headerOrigin    = "Origin"
+	writeTimeout    = 10 * time.Second
+	readBufferSize  = 1024
+	writeBufferSize = 1024
+)
+
+// endNonceGetter returns the nonce up to which hyper blocks can be pushed and whether it is the last one of the stream
+type endNonceGetter func(ctx context.Context) (endNonce uint64, isLast bool, err error)
+
+type hyperBlockStreamProxy struct {
+	hyperBlockFacade HyperBlockFacadeHandler
+	jsonConverter    HyperBlockJsonConverter
+	queryOptions     *queryOptionsHandler
+	pollingInterval  time.Duration
+	upgrader         websocket.Upgrader
+}
+
+// NewHyperBlockStreamProxy will create a covalent stream proxy, able to push hyper blocks from Multiversx
+// over websocket connections in covalent format, as soon as they are available
+func NewHyperBlockStreamProxy(
+	hyperBlockFacade HyperBlockFacadeHandler,
+	jsonConverter HyperBlockJsonConverter,
+	cfg config.Config,
+) (*hyperBlockStreamProxy, error) {
+	if hyperBlockFacade == nil {
+		return nil, errNilHyperBlockFacade
+	}
+	if jsonConverter == nil {
+		return nil, errNilHyperBlockJsonConverter
+	}
+	if cfg.StreamPollingIntervalMs == 0 {
+		return nil, fmt.Errorf("%w; expected non zero value", errInvalidStreamPollingInterval)
+	}
+	queryOptions, err := newQueryOptionsHandler(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hyperBlockStreamProxy{
+		hyperBlockFacade: hyperBlockFacade,
+		jsonConverter:    jsonConverter,
+		queryOptions:     queryOptions,
+		pollingInterval:  time.Duration(cfg.StreamPollingIntervalMs) * time.Millisecond,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  readBufferSize,
+			WriteBufferSize: writeBufferSize,
+			CheckOrigin:     newOriginChecker(cfg.StreamAllowedOrigins),
+		},
+	}, nil
+}
+
+// newOriginChecker returns a websocket origin check accepting the same origin and the provided origins. If no origin
+// is provided, nil is returned, such that the default same origin check of the upgrader is used
+func newOriginChecker(allowedOrigins []string) func(r *http.Request) bool {
+	if len(allowedOrigins) == 0 {
+		return nil
+	}
+
+	allowedOriginsSet := make(map[string]struct{}, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowedOriginsSet[strings.ToLower(origin)] = struct{}{}
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get(headerOrigin)
+		if origin == "" {
+			return true
+		}
+		_, isAllowed := allowedOriginsSet[strings.ToLower(origin)]
+		if isAllowed {
+			return true
+		}
+
+		originUrl, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+
+		return strings.EqualFold(originUrl.Host, r.Host)
+	}
+}
+
+// StreamHyperBlocks will upgrade the request to a websocket connection and push every hyper block, starting from
+// the requested nonce. If no nonce is requested, it will start from the latest hyper block known by Multiversx proxy.
+// The pushed hyper blocks are queried and encoded as requested by the same URL parameters as the hyper block endpoints
+func (hsp *hyperBlockStreamProxy) StreamHyperBlocks(c *gin.Context) {
+	nonce, found, err := getFromNonceFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+	if !found {
+		nonce, err = hsp.hyperBlockFacade.GetLatestHyperBlockNonce(c.Request.Context())
+		if err != nil {
+			respondWithFacadeError(c, err)
+			return
+		}
+	}
+
+	hsp.stream(c, nonce, hsp.getLatestNonce)
+}
+
+// StreamEpochHyperBlocks will upgrade the request to a websocket connection and push every hyper block of the
+// requested epoch. Once the last hyper block of the epoch is pushed, the connection is closed
+func (hsp *hyperBlockStreamProxy) StreamEpochHyperBlocks(c *gin.Context) {
+	epoch, err := getEpochFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	epochNoncesInterval, err := hsp.hyperBlockFacade.GetEpochNoncesInterval(c.Request.Context(), epoch)
+	if err != nil {
+		respondWithFacadeError(c, err)
+		return
+	}
+
+	hsp.stream(c, epochNoncesInterval.StartNonce, func(ctx context.Context) (uint64, bool, error) {
+		if epochNoncesInterval.IsComplete {
+			return epochNoncesInterval.EndNonce, true, nil
+		}
+
+		currentNoncesInterval, errInterval := hsp.hyperBlockFacade.GetEpochNoncesInterval(ctx, epoch)
+		if errInterval != nil {
+			return 0, false, errInterval
+		}
+
+		epochNoncesInterval = currentNoncesInterval
+		return epochNoncesInterval.EndNonce, epochNoncesInterval.IsComplete, nil
+	})
+}
+
+func (hsp *hyperBlockStreamProxy) stream(c *gin.Context, nonce uint64, getEndNonce endNonceGetter) {
+	options, err := hsp.queryOptions.getQueryOptionsFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	encoding, err := getEncodingFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	conn, err := hsp.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("could not upgrade connection", "error", err)
+		return
+	}
+
+	defer func() {
+		errNotCritical := conn.Close()
+		if errNotCritical != nil {
+			log.Debug("close websocket connection", "error", errNotCritical.Error())
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	go readUntilClosed(conn, cancel)
+	hsp.streamHyperBlocks(ctx, conn, nonce, options, encoding, getEndNonce)
+}
+
+// getFromNonceFromRequest returns the requested start nonce of the stream and whether it was provided
+func getFromNonceFromRequest(c *gin.Context) (uint64, bool, error) {
+	nonceStr := c.Request.URL.Query().Get(fromNonce)
+	if nonceStr == "" {
+		return 0, false, nil
+	}
+
+	nonce, err := strconv.ParseUint(nonceStr, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: %s", errInvalidFromNonceParameter, nonceStr)
+	}
+
+	return nonce, true, nil
+}
+
+func (hsp *hyperBlockStreamProxy) getLatestNonce(ctx context.Context) (uint64, bool, error) {
+	latestNonce, err := hsp.hyperBlockFacade.GetLatestHyperBlockNonce(ctx)
+	return latestNonce, false, err
+}
+
+// streamHyperBlocks pushes hyper blocks starting from the provided nonce, up to the end nonce, converted to the
+// provided encoding. Once the last end nonce is pushed, the stream is closed
+func (hsp *hyperBlockStreamProxy) streamHyperBlocks(
+	ctx context.Context,
+	conn *websocket.Conn,
+	nonce uint64,
+	options config.HyperBlockQueryOptions,
+	encoding string,
+	getEndNonce endNonceGetter,
+) {
+	for {
+		endNonce, isLast, err := getEndNonce(ctx)
+		if err != nil {
+			log.Warn("could not get stream end nonce; retrying...", "error", err)
+		}
+
+		for err == nil && nonce <= endNonce && ctx.Err() == nil {
+			var hyperBlockApiResponse *CovalentHyperBlockApiResponse
+			hyperBlockApiResponse, err = hsp.hyperBlockFacade.GetHyperBlockByNonce(ctx, nonce, options)
+			if errors.Is(err, ErrHyperBlockNotFinal) {
+				log.Trace("hyper block is not final yet; waiting...", "nonce", nonce)
+				break
+			}
+			if err != nil {
+				log.Warn("could not get hyper block; retrying...", "nonce", nonce, "error", err)
+				break
+			}
+
+			response, errEncode := createEncodedHyperBlockResponse(hsp.jsonConverter, hyperBlockApiResponse, encoding)
+			if errEncode != nil {
+				log.Warn("could not convert hyper block, closing stream", "nonce", nonce, "encoding", encoding, "error", errEncode)
+				return
+			}
+
+			errWrite := writeJSON(conn, response)
+			if errWrite != nil {
+				log.Debug("could not push hyper block, closing stream", "nonce", nonce, "error", errWrite)
+				return
+			}
+
+			nonce++
+		}
+
+		if err == nil && isLast && nonce > endNonce {
+			closeStream(conn)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(hsp.pollingInterval):
+		}
+	}
+}
+
+func closeStream(conn *websocket.Conn) {
+	closeMessage := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "end of stream")
+	err := conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(writeTimeout))
+	if err != nil {
+		log.Debug("could not send close message", "error", err)
+	}
+}
+
+func writeJSON(conn *websocket.Conn, response interface{}) error {
+	err := conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err != nil {
+		return err
+	}
+
+	return conn.WriteJSON(response)
+}
+
+// readUntilClosed will consume (and discard) client messages, such that control frames are handled, until
+// the connection is closed by the client
+func readUntilClosed(conn *websocket.Conn, onClose func()) {
+	defer onClose()
+
+	for {
+		_, _, err := conn.NextReader()
+		if err != nil {
+			return
+		}
+	}
+}
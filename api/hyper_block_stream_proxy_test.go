@@ -0,0 +1,570 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock/apiMocks"
+	"github.com/stretchr/testify/require"
+)
+
+const hyperBlocksStreamPath = "/hyperblocks/stream"
+
+const hyperBlocksEpochStreamPath = "/hyperblocks/by-epoch/:epoch/stream"
+
+func getStreamConfig() config.Config {
+	return config.Config{
+		StreamPollingIntervalMs: 10,
+	}
+}
+
+func startStreamServer(t *testing.T, proxy api.HyperBlockStreamProxy) *httptest.Server {
+	ws := gin.New()
+	ws.GET(hyperBlocksStreamPath, proxy.StreamHyperBlocks)
+	ws.GET(hyperBlocksEpochStreamPath, proxy.StreamEpochHyperBlocks)
+
+	server := httptest.NewServer(ws)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func dialStream(t *testing.T, server *httptest.Server, query string) *websocket.Conn {
+	url := fmt.Sprintf("ws%s%s%s", strings.TrimPrefix(server.URL, "http"), hyperBlocksStreamPath, query)
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func readStreamResponse(t *testing.T, conn *websocket.Conn) *api.CovalentHyperBlockApiResponse {
+	err := conn.SetReadDeadline(time.Now().Add(time.Second))
+	require.Nil(t, err)
+
+	apiResp := &api.CovalentHyperBlockApiResponse{}
+	err = conn.ReadJSON(apiResp)
+	require.Nil(t, err)
+
+	return apiResp
+}
+
+func encodedBlock(nonce uint64) []byte {
+	return []byte(fmt.Sprintf("encodedBlock%d", nonce))
+}
+
+func TestNewHyperBlockStreamProxy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, err := api.NewHyperBlockStreamProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		require.Nil(t, err)
+		require.NotNil(t, proxy)
+	})
+
+	t.Run("nil facade, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, err := api.NewHyperBlockStreamProxy(nil, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		require.Nil(t, proxy)
+		require.Equal(t, api.ErrNilHyperBlockFacade, err)
+	})
+
+	t.Run("nil json converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, err := api.NewHyperBlockStreamProxy(&apiMocks.HyperBlockFacadeStub{}, nil, getStreamConfig())
+		require.Nil(t, proxy)
+		require.Equal(t, api.ErrNilHyperBlockJsonConverter, err)
+	})
+
+	t.Run("invalid query option override, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := getStreamConfig()
+		cfg.QueryOptionsOverrides = []string{"fromNonce"}
+		proxy, err := api.NewHyperBlockStreamProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, cfg)
+		require.Nil(t, proxy)
+		require.ErrorIs(t, err, api.ErrInvalidQueryOptionOverride)
+	})
+
+	t.Run("invalid stream polling interval, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, err := api.NewHyperBlockStreamProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, config.Config{})
+		require.Nil(t, proxy)
+		require.ErrorIs(t, err, api.ErrInvalidStreamPollingInterval)
+	})
+}
+
+func TestHyperBlockStreamProxy_StreamHyperBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should push hyper blocks from requested nonce and follow the chain tip", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(5)
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return atomic.LoadUint64(&latestNonce), nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.LessOrEqual(t, nonce, atomic.LoadUint64(&latestNonce))
+				return &api.CovalentHyperBlockApiResponse{
+					Data: encodedBlock(nonce),
+					Code: api.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		server := startStreamServer(t, proxy)
+		conn := dialStream(t, server, "?fromNonce=3")
+
+		for nonce := uint64(3); nonce <= 5; nonce++ {
+			apiResp := readStreamResponse(t, conn)
+			require.Equal(t, encodedBlock(nonce), apiResp.Data)
+			require.Equal(t, api.ReturnCodeSuccess, apiResp.Code)
+		}
+
+		atomic.StoreUint64(&latestNonce, 6)
+		apiResp := readStreamResponse(t, conn)
+		require.Equal(t, encodedBlock(6), apiResp.Data)
+	})
+
+	t.Run("missing nonce, should start from the latest hyper block", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(44)
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return latestNonce, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				return &api.CovalentHyperBlockApiResponse{
+					Data: encodedBlock(nonce),
+					Code: api.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		server := startStreamServer(t, proxy)
+		conn := dialStream(t, server, "")
+
+		apiResp := readStreamResponse(t, conn)
+		require.Equal(t, encodedBlock(latestNonce), apiResp.Data)
+	})
+
+	t.Run("transactions filter query parameters, should narrow configured filter", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 4, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.Equal(t, config.TransactionsFilter{
+					Senders:   []string{"erd1a"},
+					Functions: []string{"claim", "stake"},
+				}, options.TransactionsFilter)
+				return &api.CovalentHyperBlockApiResponse{
+					Data: encodedBlock(nonce),
+					Code: api.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		cfg := getStreamConfig()
+		cfg.HyperBlockQueryOptions.TransactionsFilter.Senders = []string{"erd1a"}
+		cfg.HyperBlockQueryOptions.TransactionsFilter.Functions = []string{"claim", "stake", "unstake"}
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, cfg)
+		server := startStreamServer(t, proxy)
+		conn := dialStream(t, server, "?fromNonce=4&function=claim,stake&sender=")
+
+		apiResp := readStreamResponse(t, conn)
+		require.Equal(t, encodedBlock(4), apiResp.Data)
+	})
+
+	t.Run("transactions filter query parameter outside configured filter, should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := getStreamConfig()
+		cfg.HyperBlockQueryOptions.TransactionsFilter.Functions = []string{"unstake"}
+		proxy, _ := api.NewHyperBlockStreamProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, cfg)
+		ws := gin.New()
+		ws.GET(hyperBlocksStreamPath, proxy.StreamHyperBlocks)
+
+		requestPath := fmt.Sprintf("%s?fromNonce=4&function=claim", hyperBlocksStreamPath)
+		apiResp := sendRequest(t, ws, requestPath, http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrTransactionsFilterNotAllowed.Error()))
+	})
+
+	t.Run("query options overrides, should be applied to the pushed hyper blocks", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 4, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				require.False(t, options.WithAlteredAccounts)
+				require.True(t, options.FinalOnly)
+				return &api.CovalentHyperBlockApiResponse{
+					Data: encodedBlock(nonce),
+					Code: api.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		cfg := getStreamConfig()
+		cfg.HyperBlockQueryOptions.WithAlteredAccounts = true
+		cfg.QueryOptionsOverrides = []string{api.UrlParameterWithAlteredAccounts}
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, cfg)
+		server := startStreamServer(t, proxy)
+		conn := dialStream(t, server, "?fromNonce=4&withAlteredAccounts=false&finalOnly=true")
+
+		apiResp := readStreamResponse(t, conn)
+		require.Equal(t, encodedBlock(4), apiResp.Data)
+	})
+
+	t.Run("query option override not allowed, should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockStreamProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		ws := gin.New()
+		ws.GET(hyperBlocksStreamPath, proxy.StreamHyperBlocks)
+
+		requestPath := fmt.Sprintf("%s?fromNonce=4&withAlteredAccounts=false", hyperBlocksStreamPath)
+		apiResp := sendRequest(t, ws, requestPath, http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrQueryOptionOverrideNotAllowed.Error()))
+	})
+
+	t.Run("json encoding, should push converted hyper blocks", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 4, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				return &api.CovalentHyperBlockApiResponse{
+					Data: encodedBlock(nonce),
+					Code: api.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		jsonConverter := &mock.HyperBlockJsonConverterStub{
+			ToReadableJsonCalled: func(encodedHyperBlock []byte) (json.RawMessage, error) {
+				return json.RawMessage(fmt.Sprintf(`{"block":"%s"}`, encodedHyperBlock)), nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, jsonConverter, getStreamConfig())
+		server := startStreamServer(t, proxy)
+		conn := dialStream(t, server, "?fromNonce=4&encoding=json")
+
+		err := conn.SetReadDeadline(time.Now().Add(time.Second))
+		require.Nil(t, err)
+		apiResp := &api.CovalentHyperBlockJsonApiResponse{}
+		err = conn.ReadJSON(apiResp)
+		require.Nil(t, err)
+		require.JSONEq(t, `{"block":"encodedBlock4"}`, string(apiResp.Data))
+		require.Equal(t, api.ReturnCodeSuccess, apiResp.Code)
+	})
+
+	t.Run("invalid encoding, should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockStreamProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		ws := gin.New()
+		ws.GET(hyperBlocksStreamPath, proxy.StreamHyperBlocks)
+
+		requestPath := fmt.Sprintf("%s?fromNonce=4&encoding=xml", hyperBlocksStreamPath)
+		apiResp := sendRequest(t, ws, requestPath, http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidEncoding.Error()))
+	})
+
+	t.Run("could not get hyper block or latest nonce from facade, should retry", func(t *testing.T) {
+		t.Parallel()
+
+		errFacade := errors.New("error getting hyper block from facade")
+		getLatestNonceCt := uint32(0)
+		getHyperBlockCt := uint32(0)
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				if atomic.AddUint32(&getLatestNonceCt, 1) == 1 {
+					return 0, errFacade
+				}
+				return 4, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				if atomic.AddUint32(&getHyperBlockCt, 1) == 1 {
+					return nil, errFacade
+				}
+				return &api.CovalentHyperBlockApiResponse{
+					Data: encodedBlock(nonce),
+					Code: api.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		server := startStreamServer(t, proxy)
+		conn := dialStream(t, server, "?fromNonce=4")
+
+		apiResp := readStreamResponse(t, conn)
+		require.Equal(t, encodedBlock(4), apiResp.Data)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&getHyperBlockCt))
+	})
+
+	t.Run("invalid nonce, should error", func(t *testing.T) {
+		t.Parallel()
+
+		getLatestNonceCalled := false
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				getLatestNonceCalled = true
+				return 0, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		ws := gin.New()
+		ws.GET(hyperBlocksStreamPath, proxy.StreamHyperBlocks)
+
+		requestPath := fmt.Sprintf("%s?fromNonce=abc", hyperBlocksStreamPath)
+		apiResp := sendRequest(t, ws, requestPath, http.StatusBadRequest)
+		require.False(t, getLatestNonceCalled)
+		require.Empty(t, apiResp.Data)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, "abc"))
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidFromNonceParameter.Error()))
+	})
+
+	t.Run("could not get latest nonce, should respond with facade error", func(t *testing.T) {
+		t.Parallel()
+
+		errLatestNonce := errors.New("upstream unavailable")
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 0, errLatestNonce
+			},
+		}
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		ws := gin.New()
+		ws.GET(hyperBlocksStreamPath, proxy.StreamHyperBlocks)
+
+		apiResp := sendRequest(t, ws, hyperBlocksStreamPath, http.StatusInternalServerError)
+		require.Empty(t, apiResp.Data)
+		require.Equal(t, api.ReturnCodeInternalError, apiResp.Code)
+		require.Equal(t, errLatestNonce.Error(), apiResp.Error)
+	})
+
+	t.Run("not a websocket request, should not query facade", func(t *testing.T) {
+		t.Parallel()
+
+		getHyperBlockCalled := false
+		facade := &apiMocks.HyperBlockFacadeStub{
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				getHyperBlockCalled = true
+				return nil, nil
+			},
+		}
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		ws := gin.New()
+		ws.GET(hyperBlocksStreamPath, proxy.StreamHyperBlocks)
+
+		requestPath := fmt.Sprintf("%s?fromNonce=4", hyperBlocksStreamPath)
+		serveHTTPRequest(t, ws, requestPath, http.StatusBadRequest)
+		require.False(t, getHyperBlockCalled)
+	})
+}
+
+func TestHyperBlockStreamProxy_CheckOrigin(t *testing.T) {
+	t.Parallel()
+
+	dialWithOrigin := func(server *httptest.Server, origin string) (*websocket.Conn, *http.Response, error) {
+		url := fmt.Sprintf("ws%s%s?fromNonce=4", strings.TrimPrefix(server.URL, "http"), hyperBlocksStreamPath)
+		header := http.Header{}
+		header.Set("Origin", origin)
+		return websocket.DefaultDialer.Dial(url, header)
+	}
+	facade := &apiMocks.HyperBlockFacadeStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			return 4, nil
+		},
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+			return &api.CovalentHyperBlockApiResponse{Data: encodedBlock(nonce), Code: api.ReturnCodeSuccess}, nil
+		},
+	}
+
+	t.Run("no allowed origins, should only accept same origin", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		server := startStreamServer(t, proxy)
+
+		_, resp, err := dialWithOrigin(server, "https://other.example.com")
+		require.NotNil(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		conn, _, err := dialWithOrigin(server, server.URL)
+		require.Nil(t, err)
+		_ = conn.Close()
+	})
+
+	t.Run("allowed origins, should accept them along with same origin", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := getStreamConfig()
+		cfg.StreamAllowedOrigins = []string{"https://explorer.example.com"}
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, cfg)
+		server := startStreamServer(t, proxy)
+
+		conn, _, err := dialWithOrigin(server, "https://explorer.example.com")
+		require.Nil(t, err)
+		require.Equal(t, encodedBlock(4), readStreamResponse(t, conn).Data)
+		_ = conn.Close()
+
+		conn, _, err = dialWithOrigin(server, server.URL)
+		require.Nil(t, err)
+		_ = conn.Close()
+
+		_, resp, err := dialWithOrigin(server, "https://other.example.com")
+		require.NotNil(t, err)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+}
+
+func dialEpochStream(t *testing.T, server *httptest.Server, epoch uint32) *websocket.Conn {
+	path := strings.Replace(hyperBlocksEpochStreamPath, ":epoch", fmt.Sprintf("%d", epoch), 1)
+	url := fmt.Sprintf("ws%s%s", strings.TrimPrefix(server.URL, "http"), path)
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func requireStreamClosed(t *testing.T, conn *websocket.Conn) {
+	err := conn.SetReadDeadline(time.Now().Add(time.Second))
+	require.Nil(t, err)
+
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), err)
+}
+
+func TestHyperBlockStreamProxy_StreamEpochHyperBlocks(t *testing.T) {
+	t.Parallel()
+
+	createFacade := func(getEpochNoncesInterval func(epoch uint32) (*api.EpochNoncesInterval, error)) *apiMocks.HyperBlockFacadeStub {
+		return &apiMocks.HyperBlockFacadeStub{
+			GetEpochNoncesIntervalCalled: func(ctx context.Context, epoch uint32) (*api.EpochNoncesInterval, error) {
+				return getEpochNoncesInterval(epoch)
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+				return &api.CovalentHyperBlockApiResponse{
+					Data: encodedBlock(nonce),
+					Code: api.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+	}
+
+	t.Run("complete epoch, should push its hyper blocks and close the stream", func(t *testing.T) {
+		t.Parallel()
+
+		numIntervalRequests := uint32(0)
+		facade := createFacade(func(epoch uint32) (*api.EpochNoncesInterval, error) {
+			atomic.AddUint32(&numIntervalRequests, 1)
+			require.Equal(t, uint32(4), epoch)
+			return &api.EpochNoncesInterval{
+				Epoch:      epoch,
+				StartNonce: 40,
+				EndNonce:   42,
+				IsComplete: true,
+			}, nil
+		})
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		server := startStreamServer(t, proxy)
+		conn := dialEpochStream(t, server, 4)
+
+		for nonce := uint64(40); nonce <= 42; nonce++ {
+			apiResp := readStreamResponse(t, conn)
+			require.Equal(t, encodedBlock(nonce), apiResp.Data)
+		}
+		requireStreamClosed(t, conn)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numIntervalRequests))
+	})
+
+	t.Run("ongoing epoch, should follow the chain tip until the epoch is complete", func(t *testing.T) {
+		t.Parallel()
+
+		endNonce := uint64(41)
+		isComplete := uint32(0)
+		facade := createFacade(func(epoch uint32) (*api.EpochNoncesInterval, error) {
+			return &api.EpochNoncesInterval{
+				Epoch:      epoch,
+				StartNonce: 40,
+				EndNonce:   atomic.LoadUint64(&endNonce),
+				IsComplete: atomic.LoadUint32(&isComplete) == 1,
+			}, nil
+		})
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		server := startStreamServer(t, proxy)
+		conn := dialEpochStream(t, server, 4)
+
+		require.Equal(t, encodedBlock(40), readStreamResponse(t, conn).Data)
+		require.Equal(t, encodedBlock(41), readStreamResponse(t, conn).Data)
+
+		atomic.StoreUint64(&endNonce, 43)
+		atomic.StoreUint32(&isComplete, 1)
+		require.Equal(t, encodedBlock(42), readStreamResponse(t, conn).Data)
+		require.Equal(t, encodedBlock(43), readStreamResponse(t, conn).Data)
+		requireStreamClosed(t, conn)
+	})
+
+	t.Run("epoch not started yet, should respond with not found", func(t *testing.T) {
+		t.Parallel()
+
+		errNotFound := fmt.Errorf("%w: epoch 5 has not started yet, current epoch: 4", api.ErrHyperBlockNotFound)
+		facade := createFacade(func(epoch uint32) (*api.EpochNoncesInterval, error) {
+			return nil, errNotFound
+		})
+		proxy, _ := api.NewHyperBlockStreamProxy(facade, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		ws := gin.New()
+		ws.GET(hyperBlocksEpochStreamPath, proxy.StreamEpochHyperBlocks)
+
+		apiResp := sendRequest(t, ws, "/hyperblocks/by-epoch/5/stream", http.StatusNotFound)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.Equal(t, errNotFound.Error(), apiResp.Error)
+	})
+
+	t.Run("invalid epoch, should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, _ := api.NewHyperBlockStreamProxy(&apiMocks.HyperBlockFacadeStub{}, &mock.HyperBlockJsonConverterStub{}, getStreamConfig())
+		ws := gin.New()
+		ws.GET(hyperBlocksEpochStreamPath, proxy.StreamEpochHyperBlocks)
+
+		apiResp := sendRequest(t, ws, "/hyperblocks/by-epoch/abc/stream", http.StatusBadRequest)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidEpoch.Error()))
+	})
+}
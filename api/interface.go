@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -17,19 +18,53 @@ type HTTPServer interface {
 
 // HTTPClient defines what a client which should be able to GET requests should do
 type HTTPClient interface {
-	Get(url string) (resp *http.Response, err error)
+	Get(ctx context.Context, url string) (resp *http.Response, err error)
 }
 
 // MultiversxHyperBlockEndpointHandler should fetch hyper block api responses from Multiversx
 type MultiversxHyperBlockEndpointHandler interface {
-	GetHyperBlock(path string) (*MultiversxHyperBlockApiResponse, error)
+	GetHyperBlock(ctx context.Context, path string) (*MultiversxHyperBlockApiResponse, error)
+	GetNetworkStatus(ctx context.Context, path string) (*MultiversxNetworkStatusApiResponse, error)
+	GetTransaction(ctx context.Context, path string) (*MultiversxTransactionApiResponse, error)
+}
+
+// UpstreamsHandler should provide, for each request, the order in which upstream Multiversx proxies should be tried,
+// and should be notified about the outcome of each request sent to them
+type UpstreamsHandler interface {
+	GetUpstreams() []string
+	ReportSuccess(upstream string)
+	ReportFailure(upstream string)
+}
+
+// UpstreamsMetricsHandler should record metrics about the responses of each upstream Multiversx proxy
+type UpstreamsMetricsHandler interface {
+	ObserveUpstreamResponse(upstream string, err error)
+	IncFallbackResponses(upstream string)
 }
 
 // HyperBlockFacadeHandler defines the actions needed for fetching of hyperBlocks from Multiversx proxy in covalent format
 type HyperBlockFacadeHandler interface {
-	GetHyperBlockByNonce(nonce uint64, options config.HyperBlockQueryOptions) (*CovalentHyperBlockApiResponse, error)
-	GetHyperBlockByHash(hash string, options config.HyperBlockQueryOptions) (*CovalentHyperBlockApiResponse, error)
-	GetHyperBlocksByInterval(noncesInterval *Interval, options config.HyperBlocksQueryOptions) (*CovalentHyperBlocksApiResponse, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*CovalentHyperBlockApiResponse, error)
+	GetHyperBlockByHash(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*CovalentHyperBlockApiResponse, error)
+	GetHyperBlocksByInterval(ctx context.Context, noncesInterval *Interval, options config.HyperBlocksQueryOptions) (*CovalentHyperBlocksApiResponse, error)
+	GetHyperBlocksContainerByInterval(ctx context.Context, noncesInterval *Interval, options config.HyperBlocksQueryOptions, codec string) ([]byte, error)
+	GetPartialHyperBlocksByInterval(ctx context.Context, noncesInterval *Interval, options config.HyperBlocksQueryOptions) (*CovalentPartialHyperBlocksApiResponse, error)
+	GetLatestHyperBlockNonce(ctx context.Context) (uint64, error)
+	GetHyperBlockNonceByTimestamp(ctx context.Context, timestamp int64) (uint64, error)
+	GetEpochNoncesInterval(ctx context.Context, epoch uint32) (*EpochNoncesInterval, error)
+	GetAccountBalanceUpdates(ctx context.Context, address string, noncesInterval *Interval, options config.HyperBlocksQueryOptions) (*CovalentHyperBlockApiResponse, error)
+}
+
+// TransactionFacadeHandler defines the actions needed for fetching of single transactions from Multiversx proxy in
+// covalent format
+type TransactionFacadeHandler interface {
+	GetTransactionByHash(ctx context.Context, hash string) (*CovalentHyperBlockApiResponse, error)
+}
+
+// HyperBlockJsonConverter should convert avro encoded hyper blocks to avro json and human readable json
+type HyperBlockJsonConverter interface {
+	ToAvroJson(encodedHyperBlock []byte) (json.RawMessage, error)
+	ToReadableJson(encodedHyperBlock []byte) (json.RawMessage, error)
 }
 
 // HyperBlockProxy is the covalent proxy. It should be able to fetch hyper blocks from
@@ -38,4 +73,32 @@ type HyperBlockProxy interface {
 	GetHyperBlockByNonce(c *gin.Context)
 	GetHyperBlockByHash(c *gin.Context)
 	GetHyperBlocksByInterval(c *gin.Context)
+	GetHyperBlockByTimestamp(c *gin.Context)
+	GetEpochNoncesInterval(c *gin.Context)
+	GetAccountBalanceUpdates(c *gin.Context)
+}
+
+// HyperBlockStreamProxy should be able to push avro schema defined hyper blocks over a websocket connection,
+// following the chain tip of the Multiversx proxy
+type HyperBlockStreamProxy interface {
+	StreamHyperBlocks(c *gin.Context)
+	StreamEpochHyperBlocks(c *gin.Context)
+}
+
+// TransactionProxy should be able to fetch a single transaction from Multiversx proxy and provide it as an avro schema
+// defined transaction(as byte array)
+type TransactionProxy interface {
+	GetTransactionByHash(c *gin.Context)
+}
+
+// ReorgsHandler should provide the rollback events of served hyper blocks, which were replaced by other ones, along
+// with the id of the instance which published them
+type ReorgsHandler interface {
+	GetReorgs(fromId uint64) []ReorgEvent
+	GetInstanceId() string
+}
+
+// ReorgsProxy should be able to provide rollback notifications to followers
+type ReorgsProxy interface {
+	GetReorgs(c *gin.Context)
 }
@@ -1,38 +1,179 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
 var log = logger.GetOrCreate("api")
 
+const transactionNotFoundResponseError = "transaction not found"
+
+// ArgsMultiversxHyperBlockEndPoint holds all input dependencies required by Multiversx hyper block endpoint
+type ArgsMultiversxHyperBlockEndPoint struct {
+	HttpClient HTTPClient
+	Upstreams  UpstreamsHandler
+	Metrics    UpstreamsMetricsHandler
+}
+
 type multiversxHyperBlockEndPoint struct {
 	httpClient HTTPClient
+	upstreams  UpstreamsHandler
+	metrics    UpstreamsMetricsHandler
 }
 
-// NewMultiversxHyperBlockEndPoint will create a handler which can fetch hyper blocks from Multiversx gateway
-func NewMultiversxHyperBlockEndPoint(httpClient HTTPClient) (*multiversxHyperBlockEndPoint, error) {
-	if httpClient == nil {
+// NewMultiversxHyperBlockEndPoint will create a handler which can fetch hyper blocks from Multiversx gateways.
+// Each request is sent to the upstreams provided by the upstreams handler, in order, until one of them serves it
+func NewMultiversxHyperBlockEndPoint(args ArgsMultiversxHyperBlockEndPoint) (*multiversxHyperBlockEndPoint, error) {
+	if args.HttpClient == nil {
 		return nil, errNilHttpServer
 	}
+	if args.Upstreams == nil {
+		return nil, errNilUpstreamsHandler
+	}
+	if args.Metrics == nil {
+		return nil, errNilUpstreamsMetricsHandler
+	}
 
 	return &multiversxHyperBlockEndPoint{
-		httpClient: httpClient,
+		httpClient: args.HttpClient,
+		upstreams:  args.Upstreams,
+		metrics:    args.Metrics,
 	}, nil
 }
 
 // GetHyperBlock will fetch an MultiversxHyperBlockApiResponse from provided path
-func (hpe *multiversxHyperBlockEndPoint) GetHyperBlock(path string) (*MultiversxHyperBlockApiResponse, error) {
-	resp, err := hpe.httpClient.Get(path)
+func (hpe *multiversxHyperBlockEndPoint) GetHyperBlock(ctx context.Context, path string) (*MultiversxHyperBlockApiResponse, error) {
+	var response *MultiversxHyperBlockApiResponse
+	err := hpe.requestWithFailover(ctx, path, func(url string) (int, string, error) {
+		response = &MultiversxHyperBlockApiResponse{}
+		statusCode, err := hpe.getResponse(ctx, url, response)
+		return statusCode, response.Error, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetNetworkStatus will fetch an MultiversxNetworkStatusApiResponse from provided path
+func (hpe *multiversxHyperBlockEndPoint) GetNetworkStatus(ctx context.Context, path string) (*MultiversxNetworkStatusApiResponse, error) {
+	var response *MultiversxNetworkStatusApiResponse
+	err := hpe.requestWithFailover(ctx, path, func(url string) (int, string, error) {
+		response = &MultiversxNetworkStatusApiResponse{}
+		statusCode, err := hpe.getResponse(ctx, url, response)
+		return statusCode, response.Error, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetTransaction will fetch an MultiversxTransactionApiResponse from provided path
+func (hpe *multiversxHyperBlockEndPoint) GetTransaction(ctx context.Context, path string) (*MultiversxTransactionApiResponse, error) {
+	var response *MultiversxTransactionApiResponse
+	err := hpe.requestWithFailover(ctx, path, func(url string) (int, string, error) {
+		response = &MultiversxTransactionApiResponse{}
+		statusCode, err := hpe.getResponse(ctx, url, response)
+		if err == nil && isTransactionNotFoundResponse(statusCode, response.Error) {
+			err = fmt.Errorf("%w, status code: %d, multiversx proxy response error: %s", ErrTransactionNotFound, statusCode, response.Error)
+		}
+		return statusCode, response.Error, err
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	return response, nil
+}
+
+// requestWithFailover sends the request for the provided path to each upstream, in order, until one of them serves it.
+// Transport errors and transient error responses(5xx, 408, 429) count as upstream failures and trigger a failover,
+// while any other non-ok response(including an unknown transaction) is returned as is, since the next upstreams would
+// respond the same. Once the
+// context is done, no other upstream is tried and the failed request is not held against the upstream
+func (hpe *multiversxHyperBlockEndPoint) requestWithFailover(
+	ctx context.Context,
+	path string,
+	request func(url string) (int, string, error),
+) error {
+	var err error
+	upstreams := hpe.upstreams.GetUpstreams()
+	for idx, upstream := range upstreams {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var statusCode int
+		var responseError string
+		statusCode, responseError, err = request(upstream + path)
+		if err != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err == nil && statusCode != http.StatusOK {
+			err = createResponseError(statusCode, responseError)
+		}
+
+		if isUpstreamFailure(err) {
+			hpe.upstreams.ReportFailure(upstream)
+			hpe.metrics.ObserveUpstreamResponse(upstream, err)
+			log.Warn("upstream could not serve request",
+				"upstream", upstream,
+				"path", path,
+				"error", err,
+				"num remaining upstreams", len(upstreams)-idx-1,
+			)
+			continue
+		}
+
+		hpe.upstreams.ReportSuccess(upstream)
+		hpe.metrics.ObserveUpstreamResponse(upstream, nil)
+		if idx > 0 {
+			hpe.metrics.IncFallbackResponses(upstream)
+			log.Info("request served by fallback upstream", "upstream", upstream, "path", path, "num failed upstreams", idx)
+		}
+
+		return err
+	}
+
+	if err == nil {
+		return errNoUpstreamAvailable
+	}
+
+	return err
+}
+
+func isUpstreamFailure(err error) bool {
+	return err != nil && !errors.Is(err, ErrNonRetryableResponse) && !errors.Is(err, ErrTransactionNotFound)
+}
+
+// isTransactionNotFoundResponse checks whether the Multiversx proxy responded that it does not know the transaction.
+// The proxy reports it either with a 404 or with a 5xx response, depending on its version, so the response error is
+// checked as well
+func isTransactionNotFoundResponse(statusCode int, responseError string) bool {
+	if statusCode == http.StatusOK {
+		return false
+	}
+
+	return statusCode == http.StatusNotFound || strings.Contains(strings.ToLower(responseError), transactionNotFoundResponseError)
+}
+
+func (hpe *multiversxHyperBlockEndPoint) getResponse(ctx context.Context, path string, response interface{}) (int, error) {
+	resp, err := hpe.httpClient.Get(ctx, path)
+	if err != nil {
+		return 0, err
+	}
+
 	defer func() {
 		if resp != nil && resp.Body != nil {
 			errNotCritical := resp.Body.Close()
@@ -44,18 +185,29 @@ func (hpe *multiversxHyperBlockEndPoint) GetHyperBlock(path string) (*Multiversx
 
 	responseBodyBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	var response MultiversxHyperBlockApiResponse
-	err = json.Unmarshal(responseBodyBytes, &response)
+	err = json.Unmarshal(responseBodyBytes, response)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code: %d, multiversx proxy response error: %s", resp.StatusCode, response.Error)
+	return resp.StatusCode, nil
+}
+
+func createResponseError(statusCode int, responseError string) error {
+	if isNonRetryableStatusCode(statusCode) {
+		return fmt.Errorf("%w, status code: %d, multiversx proxy response error: %s", ErrNonRetryableResponse, statusCode, responseError)
+	}
+
+	return fmt.Errorf("status code: %d, multiversx proxy response error: %s", statusCode, responseError)
+}
+
+func isNonRetryableStatusCode(statusCode int) bool {
+	if statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests {
+		return false
 	}
 
-	return &response, nil
+	return statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError
 }
@@ -2,27 +2,39 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-covalent-go/hyperBlock"
 	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
 	"github.com/stretchr/testify/require"
 )
 
+func createMockArgsMultiversxHyperBlockEndPoint(client HTTPClient) ArgsMultiversxHyperBlockEndPoint {
+	return ArgsMultiversxHyperBlockEndPoint{
+		HttpClient: client,
+		Upstreams:  &mock.UpstreamsHandlerStub{},
+		Metrics:    &mock.MetricsHandlerStub{},
+	}
+}
+
 func TestNewMultiversxHyperBlockEndPoint(t *testing.T) {
 	t.Parallel()
 
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
-		endPoint, err := NewMultiversxHyperBlockEndPoint(&mock.HTTPClientStub{})
+		endPoint, err := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(&mock.HTTPClientStub{}))
 		require.NotNil(t, endPoint)
 		require.Nil(t, err)
 	})
@@ -30,10 +42,30 @@ func TestNewMultiversxHyperBlockEndPoint(t *testing.T) {
 	t.Run("nil http client, should return error", func(t *testing.T) {
 		t.Parallel()
 
-		endPoint, err := NewMultiversxHyperBlockEndPoint(nil)
+		endPoint, err := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(nil))
 		require.Nil(t, endPoint)
 		require.Equal(t, errNilHttpServer, err)
 	})
+
+	t.Run("nil upstreams handler, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiversxHyperBlockEndPoint(&mock.HTTPClientStub{})
+		args.Upstreams = nil
+		endPoint, err := NewMultiversxHyperBlockEndPoint(args)
+		require.Nil(t, endPoint)
+		require.Equal(t, errNilUpstreamsHandler, err)
+	})
+
+	t.Run("nil metrics handler, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiversxHyperBlockEndPoint(&mock.HTTPClientStub{})
+		args.Metrics = nil
+		endPoint, err := NewMultiversxHyperBlockEndPoint(args)
+		require.Nil(t, endPoint)
+		require.Equal(t, errNilUpstreamsMetricsHandler, err)
+	})
 }
 
 func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
@@ -56,7 +88,7 @@ func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
 		t.Parallel()
 
 		client := &mock.HTTPClientStub{
-			GetCalled: func(url string) (resp *http.Response, err error) {
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
 				require.Equal(t, path, url)
 
 				return &http.Response{
@@ -66,8 +98,8 @@ func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
 			},
 		}
 
-		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(client)
-		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(path)
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(context.Background(), path)
 		require.Nil(t, err)
 		require.Equal(t, expectedMultiversxApiResponse, hyperBlockApiResponse)
 	})
@@ -84,7 +116,7 @@ func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
 			},
 		}
 		client := &mock.HTTPClientStub{
-			GetCalled: func(url string) (resp *http.Response, err error) {
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
 				require.Equal(t, path, url)
 
 				return &http.Response{
@@ -94,8 +126,8 @@ func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
 			},
 		}
 
-		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(client)
-		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(path)
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(context.Background(), path)
 		require.Nil(t, err)
 		require.Equal(t, expectedMultiversxApiResponse, hyperBlockApiResponse)
 	})
@@ -105,13 +137,13 @@ func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
 
 		errHttpClient := errors.New("http client local err")
 		client := &mock.HTTPClientStub{
-			GetCalled: func(url string) (resp *http.Response, err error) {
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
 				return nil, errHttpClient
 			},
 		}
 
-		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(client)
-		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(path)
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(context.Background(), path)
 		require.Nil(t, hyperBlockApiResponse)
 		require.Equal(t, errHttpClient, err)
 	})
@@ -131,7 +163,7 @@ func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
 			},
 		}
 		client := &mock.HTTPClientStub{
-			GetCalled: func(url string) (resp *http.Response, err error) {
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
 				require.Equal(t, path, url)
 
 				return &http.Response{
@@ -141,8 +173,8 @@ func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
 			},
 		}
 
-		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(client)
-		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(path)
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(context.Background(), path)
 		require.Nil(t, hyperBlockApiResponse)
 		require.Equal(t, errReadBytes, err)
 		require.True(t, wasReaderClosed)
@@ -157,7 +189,7 @@ func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
 			},
 		}
 		client := &mock.HTTPClientStub{
-			GetCalled: func(url string) (resp *http.Response, err error) {
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
 				require.Equal(t, path, url)
 
 				return &http.Response{
@@ -167,8 +199,8 @@ func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
 			},
 		}
 
-		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(client)
-		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(path)
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(context.Background(), path)
 		require.Nil(t, hyperBlockApiResponse)
 		require.NotNil(t, err)
 	})
@@ -177,7 +209,7 @@ func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
 		t.Parallel()
 
 		client := &mock.HTTPClientStub{
-			GetCalled: func(url string) (resp *http.Response, err error) {
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
 				require.Equal(t, path, url)
 
 				return &http.Response{
@@ -187,10 +219,510 @@ func TestMultiversxHyperBlockEndPoint_GetHyperBlock(t *testing.T) {
 			},
 		}
 
-		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(client)
-		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(path)
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(context.Background(), path)
 		require.Nil(t, hyperBlockApiResponse)
 		require.NotNil(t, err)
 		require.True(t, strings.Contains(err.Error(), strconv.Itoa(http.StatusBadRequest)))
 	})
 }
+
+func TestMultiversxHyperBlockEndPoint_GetNetworkStatus(t *testing.T) {
+	t.Parallel()
+
+	path := "path"
+	expectedNetworkStatusApiResponse := &MultiversxNetworkStatusApiResponse{
+		Data: MultiversxNetworkStatusApiResponsePayload{
+			Status: NetworkStatus{
+				Nonce:             44,
+				HighestFinalNonce: 43,
+			},
+		},
+		Error: "",
+		Code:  "success",
+	}
+	bodyResponse, errMarshal := json.Marshal(expectedNetworkStatusApiResponse)
+	require.Nil(t, errMarshal)
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				require.Equal(t, path, url)
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewBuffer(bodyResponse)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		networkStatusApiResponse, err := multiversxEndPoint.GetNetworkStatus(context.Background(), path)
+		require.Nil(t, err)
+		require.Equal(t, expectedNetworkStatusApiResponse, networkStatusApiResponse)
+	})
+
+	t.Run("could not get response from http client, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errHttpClient := errors.New("http client local err")
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				return nil, errHttpClient
+			},
+		}
+
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		networkStatusApiResponse, err := multiversxEndPoint.GetNetworkStatus(context.Background(), path)
+		require.Nil(t, networkStatusApiResponse)
+		require.Equal(t, errHttpClient, err)
+	})
+
+	t.Run("status code not ok, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewBuffer(bodyResponse)),
+					StatusCode: http.StatusInternalServerError,
+				}, nil
+			},
+		}
+
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		networkStatusApiResponse, err := multiversxEndPoint.GetNetworkStatus(context.Background(), path)
+		require.Nil(t, networkStatusApiResponse)
+		require.NotNil(t, err)
+		require.True(t, strings.Contains(err.Error(), strconv.Itoa(http.StatusInternalServerError)))
+	})
+}
+
+func TestMultiversxHyperBlockEndPoint_GetTransaction(t *testing.T) {
+	t.Parallel()
+
+	path := "path"
+	expectedTransactionApiResponse := &MultiversxTransactionApiResponse{
+		Data: MultiversxTransactionApiResponsePayload{
+			Transaction: transaction.ApiTransactionResult{
+				Hash:     "hash",
+				Function: "claim",
+			},
+		},
+		Error: "",
+		Code:  "success",
+	}
+	bodyResponse, errMarshal := json.Marshal(expectedTransactionApiResponse)
+	require.Nil(t, errMarshal)
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				require.Equal(t, path, url)
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewBuffer(bodyResponse)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		transactionApiResponse, err := multiversxEndPoint.GetTransaction(context.Background(), path)
+		require.Nil(t, err)
+		require.Equal(t, expectedTransactionApiResponse, transactionApiResponse)
+	})
+
+	t.Run("transaction not found, should return not found error", func(t *testing.T) {
+		t.Parallel()
+
+		errorBodyResponse, _ := json.Marshal(&MultiversxTransactionApiResponse{Error: "not found"})
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewBuffer(errorBodyResponse)),
+					StatusCode: http.StatusNotFound,
+				}, nil
+			},
+		}
+
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		transactionApiResponse, err := multiversxEndPoint.GetTransaction(context.Background(), path)
+		require.Nil(t, transactionApiResponse)
+		require.True(t, errors.Is(err, ErrTransactionNotFound))
+	})
+
+	t.Run("transaction not found with 5xx response, should return not found error without failing over", func(t *testing.T) {
+		t.Parallel()
+
+		errorBodyResponse, _ := json.Marshal(&MultiversxTransactionApiResponse{Error: "Transaction not found"})
+		requestedUrls := make([]string, 0)
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				requestedUrls = append(requestedUrls, url)
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewBuffer(errorBodyResponse)),
+					StatusCode: http.StatusInternalServerError,
+				}, nil
+			},
+		}
+
+		failures := make([]string, 0)
+		args := createMockArgsMultiversxHyperBlockEndPoint(client)
+		args.Upstreams = &mock.UpstreamsHandlerStub{
+			GetUpstreamsCalled: func() []string {
+				return []string{"url1", "url2"}
+			},
+			ReportFailureCalled: func(upstream string) {
+				failures = append(failures, upstream)
+			},
+		}
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(args)
+		transactionApiResponse, err := multiversxEndPoint.GetTransaction(context.Background(), path)
+		require.Nil(t, transactionApiResponse)
+		require.True(t, errors.Is(err, ErrTransactionNotFound))
+		require.Equal(t, []string{"url1" + path}, requestedUrls)
+		require.Empty(t, failures)
+	})
+
+	t.Run("5xx response for a known transaction, should fail over", func(t *testing.T) {
+		t.Parallel()
+
+		errorBodyResponse, _ := json.Marshal(&MultiversxTransactionApiResponse{Error: "upstream error"})
+		requestedUrls := make([]string, 0)
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				requestedUrls = append(requestedUrls, url)
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewBuffer(errorBodyResponse)),
+					StatusCode: http.StatusInternalServerError,
+				}, nil
+			},
+		}
+
+		args := createMockArgsMultiversxHyperBlockEndPoint(client)
+		args.Upstreams = &mock.UpstreamsHandlerStub{
+			GetUpstreamsCalled: func() []string {
+				return []string{"url1", "url2"}
+			},
+		}
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(args)
+		transactionApiResponse, err := multiversxEndPoint.GetTransaction(context.Background(), path)
+		require.Nil(t, transactionApiResponse)
+		require.False(t, errors.Is(err, ErrTransactionNotFound))
+		require.Equal(t, []string{"url1" + path, "url2" + path}, requestedUrls)
+	})
+}
+
+func TestMultiversxHyperBlockEndPoint_Failover(t *testing.T) {
+	t.Parallel()
+
+	path := "/path"
+	expectedMultiversxApiResponse := &MultiversxHyperBlockApiResponse{
+		Data: MultiversxHyperBlockApiResponsePayload{
+			HyperBlock: hyperBlock.HyperBlock{
+				Hash: "hash",
+			},
+		},
+		Code: "success",
+	}
+	bodyResponse, errMarshal := json.Marshal(expectedMultiversxApiResponse)
+	require.Nil(t, errMarshal)
+	errorBodyResponse, errMarshal := json.Marshal(&MultiversxHyperBlockApiResponse{Error: "upstream error"})
+	require.Nil(t, errMarshal)
+
+	type upstreamsRecorder struct {
+		successes []string
+		failures  []string
+		responses map[string]int
+		fallbacks []string
+	}
+	createArgs := func(recorder *upstreamsRecorder, client HTTPClient) ArgsMultiversxHyperBlockEndPoint {
+		recorder.responses = make(map[string]int)
+		return ArgsMultiversxHyperBlockEndPoint{
+			HttpClient: client,
+			Upstreams: &mock.UpstreamsHandlerStub{
+				GetUpstreamsCalled: func() []string {
+					return []string{"url1", "url2", "url3"}
+				},
+				ReportSuccessCalled: func(upstream string) {
+					recorder.successes = append(recorder.successes, upstream)
+				},
+				ReportFailureCalled: func(upstream string) {
+					recorder.failures = append(recorder.failures, upstream)
+				},
+			},
+			Metrics: &mock.MetricsHandlerStub{
+				ObserveUpstreamResponseCalled: func(upstream string, err error) {
+					recorder.responses[upstream]++
+				},
+				IncFallbackResponsesCalled: func(upstream string) {
+					recorder.fallbacks = append(recorder.fallbacks, upstream)
+				},
+			},
+		}
+	}
+
+	t.Run("first upstream serves request, should not fail over", func(t *testing.T) {
+		t.Parallel()
+
+		requestedUrls := make([]string, 0)
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				requestedUrls = append(requestedUrls, url)
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewBuffer(bodyResponse)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+
+		recorder := &upstreamsRecorder{}
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createArgs(recorder, client))
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(context.Background(), path)
+		require.Nil(t, err)
+		require.Equal(t, expectedMultiversxApiResponse, hyperBlockApiResponse)
+		require.Equal(t, []string{"url1/path"}, requestedUrls)
+		require.Equal(t, []string{"url1"}, recorder.successes)
+		require.Empty(t, recorder.failures)
+		require.Empty(t, recorder.fallbacks)
+	})
+
+	t.Run("transport error and 5xx response, should be served by fallback upstream", func(t *testing.T) {
+		t.Parallel()
+
+		requestedUrls := make([]string, 0)
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				requestedUrls = append(requestedUrls, url)
+				switch url {
+				case "url1/path":
+					return nil, errors.New("connection refused")
+				case "url2/path":
+					return &http.Response{
+						Body:       ioutil.NopCloser(bytes.NewBuffer(errorBodyResponse)),
+						StatusCode: http.StatusBadGateway,
+					}, nil
+				default:
+					return &http.Response{
+						Body:       ioutil.NopCloser(bytes.NewBuffer(bodyResponse)),
+						StatusCode: http.StatusOK,
+					}, nil
+				}
+			},
+		}
+
+		recorder := &upstreamsRecorder{}
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createArgs(recorder, client))
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(context.Background(), path)
+		require.Nil(t, err)
+		require.Equal(t, expectedMultiversxApiResponse, hyperBlockApiResponse)
+		require.Equal(t, []string{"url1/path", "url2/path", "url3/path"}, requestedUrls)
+		require.Equal(t, []string{"url1", "url2"}, recorder.failures)
+		require.Equal(t, []string{"url3"}, recorder.successes)
+		require.Equal(t, []string{"url3"}, recorder.fallbacks)
+		require.Equal(t, map[string]int{"url1": 1, "url2": 1, "url3": 1}, recorder.responses)
+	})
+
+	t.Run("4xx response, should not fail over", func(t *testing.T) {
+		t.Parallel()
+
+		requestedUrls := make([]string, 0)
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				requestedUrls = append(requestedUrls, url)
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewBuffer(errorBodyResponse)),
+					StatusCode: http.StatusNotFound,
+				}, nil
+			},
+		}
+
+		recorder := &upstreamsRecorder{}
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createArgs(recorder, client))
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(context.Background(), path)
+		require.Nil(t, hyperBlockApiResponse)
+		require.True(t, errors.Is(err, ErrNonRetryableResponse))
+		require.True(t, strings.Contains(err.Error(), "upstream error"))
+		require.Equal(t, []string{"url1/path"}, requestedUrls)
+		require.Equal(t, []string{"url1"}, recorder.successes)
+		require.Empty(t, recorder.failures)
+	})
+
+	t.Run("408 and 429 responses, should be served by fallback upstream", func(t *testing.T) {
+		t.Parallel()
+
+		requestedUrls := make([]string, 0)
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				requestedUrls = append(requestedUrls, url)
+				switch url {
+				case "url1/path":
+					return &http.Response{
+						Body:       ioutil.NopCloser(bytes.NewBuffer(errorBodyResponse)),
+						StatusCode: http.StatusRequestTimeout,
+					}, nil
+				case "url2/path":
+					return &http.Response{
+						Body:       ioutil.NopCloser(bytes.NewBuffer(errorBodyResponse)),
+						StatusCode: http.StatusTooManyRequests,
+					}, nil
+				default:
+					return &http.Response{
+						Body:       ioutil.NopCloser(bytes.NewBuffer(bodyResponse)),
+						StatusCode: http.StatusOK,
+					}, nil
+				}
+			},
+		}
+
+		recorder := &upstreamsRecorder{}
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createArgs(recorder, client))
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(context.Background(), path)
+		require.Nil(t, err)
+		require.Equal(t, expectedMultiversxApiResponse, hyperBlockApiResponse)
+		require.Equal(t, []string{"url1/path", "url2/path", "url3/path"}, requestedUrls)
+		require.Equal(t, []string{"url1", "url2"}, recorder.failures)
+		require.Equal(t, []string{"url3"}, recorder.fallbacks)
+	})
+
+	t.Run("all upstreams failed, should return last error", func(t *testing.T) {
+		t.Parallel()
+
+		errLast := errors.New("last error")
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				if url == "url3/path" {
+					return nil, errLast
+				}
+				return nil, errors.New("error")
+			},
+		}
+
+		recorder := &upstreamsRecorder{}
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createArgs(recorder, client))
+		networkStatusApiResponse, err := multiversxEndPoint.GetNetworkStatus(context.Background(), path)
+		require.Nil(t, networkStatusApiResponse)
+		require.Equal(t, errLast, err)
+		require.Equal(t, []string{"url1", "url2", "url3"}, recorder.failures)
+		require.Empty(t, recorder.fallbacks)
+	})
+
+	t.Run("no upstreams, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiversxHyperBlockEndPoint(&mock.HTTPClientStub{})
+		args.Upstreams = &mock.UpstreamsHandlerStub{
+			GetUpstreamsCalled: func() []string {
+				return nil
+			},
+		}
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(args)
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(context.Background(), path)
+		require.Nil(t, hyperBlockApiResponse)
+		require.Equal(t, errNoUpstreamAvailable, err)
+	})
+}
+
+func TestMultiversxHyperBlockEndPoint_ContextCancellation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cancelled request, should not fail over nor report upstream failure", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		requestedUrls := make([]string, 0)
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				requestedUrls = append(requestedUrls, url)
+				cancel()
+				return nil, ctx.Err()
+			},
+		}
+		numFailures := 0
+		args := createMockArgsMultiversxHyperBlockEndPoint(client)
+		args.Upstreams = &mock.UpstreamsHandlerStub{
+			GetUpstreamsCalled: func() []string {
+				return []string{"url1", "url2"}
+			},
+			ReportFailureCalled: func(upstream string) {
+				numFailures++
+			},
+		}
+
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(args)
+		hyperBlockApiResponse, err := multiversxEndPoint.GetHyperBlock(ctx, "/path")
+		require.Nil(t, hyperBlockApiResponse)
+		require.Equal(t, context.Canceled, err)
+		require.Equal(t, []string{"url1/path"}, requestedUrls)
+		require.Zero(t, numFailures)
+	})
+
+	t.Run("expired deadline, should not send any request", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+
+		client := &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+
+		multiversxEndPoint, _ := NewMultiversxHyperBlockEndPoint(createMockArgsMultiversxHyperBlockEndPoint(client))
+		networkStatusApiResponse, err := multiversxEndPoint.GetNetworkStatus(ctx, "/path")
+		require.Nil(t, networkStatusApiResponse)
+		require.Equal(t, context.DeadlineExceeded, err)
+	})
+}
+
+func TestDefaultHttpClient_Get(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	resp, err := NewDefaultHttpClient(0).Get(ctx, server.URL)
+	require.Nil(t, resp)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestCreateResponseError(t *testing.T) {
+	t.Parallel()
+
+	nonRetryableStatusCodes := []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity}
+	for _, statusCode := range nonRetryableStatusCodes {
+		err := createResponseError(statusCode, "error")
+		require.True(t, errors.Is(err, ErrNonRetryableResponse), statusCode)
+		require.True(t, strings.Contains(err.Error(), strconv.Itoa(statusCode)))
+	}
+
+	retryableStatusCodes := []int{
+		http.StatusRequestTimeout,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+	}
+	for _, statusCode := range retryableStatusCodes {
+		err := createResponseError(statusCode, "error")
+		require.False(t, errors.Is(err, ErrNonRetryableResponse), statusCode)
+		require.True(t, strings.Contains(err.Error(), strconv.Itoa(statusCode)))
+	}
+}
@@ -9,6 +9,62 @@ const (
 	UrlParameterWithAlteredAccounts = "withAlteredAccounts"
 	// UrlParameterTokens represents the name of an URL parameter to query altered accounts with tokens
 	UrlParameterTokens = "tokens"
+	// UrlParameterFormat represents the name of an URL parameter to select the format of hyper blocks responses
+	UrlParameterFormat = "format"
+	// UrlParameterCodec represents the name of an URL parameter to select the codec of avro object container files
+	UrlParameterCodec = "codec"
+	// UrlParameterPartial represents the name of an URL parameter to return the successfully fetched hyper blocks of
+	// an interval, along with the errors of the failed ones, instead of failing the whole request
+	UrlParameterPartial = "partial"
+	// UrlParameterFinalOnly represents the name of an URL parameter to only serve final hyper blocks. It can only
+	// tighten the configured option
+	UrlParameterFinalOnly = "finalOnly"
+	// UrlParameterEncoding represents the name of an URL parameter to select the encoding of hyper blocks, overriding
+	// the one negotiated through the Accept header
+	UrlParameterEncoding = "encoding"
+	// UrlParameterFromId represents the name of an URL parameter to only return the reorg events having an id greater
+	// than or equal to the provided one
+	UrlParameterFromId = "fromId"
+	// UrlParameterSender represents the name of an URL parameter to only keep the transactions sent by the provided
+	// comma separated bech32 addresses, narrowing the configured transactions filter
+	UrlParameterSender = "sender"
+	// UrlParameterReceiver represents the name of an URL parameter to only keep the transactions received by the
+	// provided comma separated bech32 addresses, narrowing the configured transactions filter
+	UrlParameterReceiver = "receiver"
+	// UrlParameterFunction represents the name of an URL parameter to only keep the transactions calling the provided
+	// comma separated functions, narrowing the configured transactions filter
+	UrlParameterFunction = "function"
+	// UrlParameterToken represents the name of an URL parameter to only keep the transactions transferring any of the
+	// provided comma separated tokens, narrowing the configured transactions filter
+	UrlParameterToken = "token"
+	// UrlParameterStatus represents the name of an URL parameter to only keep the transactions having any of the
+	// provided comma separated statuses, narrowing the configured transactions filter
+	UrlParameterStatus = "status"
+	// UrlParameterMiniBlockType represents the name of an URL parameter to only keep the transactions included in
+	// mini blocks of any of the provided comma separated types, narrowing the configured transactions filter
+	UrlParameterMiniBlockType = "miniBlockType"
+)
+
+// FormatObjectContainerFile defines a hyper blocks response as a single avro object container file
+const FormatObjectContainerFile = "ocf"
+
+const (
+	// EncodingAvro defines hyper blocks encoded as binary avro(default)
+	EncodingAvro = "avro"
+	// EncodingAvroJson defines hyper blocks encoded as avro json, as defined by the avro specification
+	EncodingAvroJson = "avro-json"
+	// EncodingJson defines hyper blocks encoded as human readable json, having hex hashes, decimal big integers and
+	// bech32 addresses
+	EncodingJson = "json"
+)
+
+const (
+	// MediaTypeAvro is the Accept header media type selecting EncodingAvro
+	MediaTypeAvro = "avro/binary"
+	// MediaTypeAvroJson is the Accept header media type selecting EncodingAvroJson
+	MediaTypeAvroJson = "avro/json"
+	// MediaTypeJson is the Accept header media type selecting EncodingJson
+	MediaTypeJson = "application/vnd.covalent.hyperblock+json"
 )
 
 // Interval defines a [start,end] interval
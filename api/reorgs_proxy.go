@@ -0,0 +1,57 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type reorgsProxy struct {
+	reorgsHandler ReorgsHandler
+}
+
+// NewReorgsProxy will create a proxy able to provide the detected reorgs of served hyper blocks
+func NewReorgsProxy(reorgsHandler ReorgsHandler) (*reorgsProxy, error) {
+	if reorgsHandler == nil {
+		return nil, errNilReorgsHandler
+	}
+
+	return &reorgsProxy{
+		reorgsHandler: reorgsHandler,
+	}, nil
+}
+
+// GetReorgs will return the rollback events of served hyper blocks, starting from the requested event id
+func (rp *reorgsProxy) GetReorgs(c *gin.Context) {
+	fromId, err := getFromIdFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		CovalentReorgsApiResponse{
+			Data:       rp.reorgsHandler.GetReorgs(fromId),
+			InstanceId: rp.reorgsHandler.GetInstanceId(),
+			Error:      "",
+			Code:       ReturnCodeSuccess,
+		},
+	)
+}
+
+func getFromIdFromRequest(c *gin.Context) (uint64, error) {
+	param := c.Request.URL.Query().Get(UrlParameterFromId)
+	if param == "" {
+		return 0, nil
+	}
+
+	fromId, err := strconv.ParseUint(param, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errInvalidFromIdParameter, param)
+	}
+
+	return fromId, nil
+}
@@ -0,0 +1,127 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock/apiMocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+const reorgsPath = "/reorgs"
+
+func startReorgsServer(proxy api.ReorgsProxy) *gin.Engine {
+	ws := gin.New()
+	ws.GET(reorgsPath, proxy.GetReorgs)
+
+	return ws
+}
+
+func sendReorgsRequest(t *testing.T, ws *gin.Engine, path string, expectedStatus int) *api.CovalentReorgsApiResponse {
+	body := serveHTTPRequest(t, ws, path, expectedStatus)
+
+	apiResp := &api.CovalentReorgsApiResponse{}
+	loadResponse(t, body, apiResp)
+
+	return apiResp
+}
+
+func TestNewReorgsProxy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, err := api.NewReorgsProxy(&apiMocks.ReorgsTrackerStub{})
+		require.Nil(t, err)
+		require.NotNil(t, proxy)
+	})
+
+	t.Run("nil reorgs handler, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, err := api.NewReorgsProxy(nil)
+		require.Nil(t, proxy)
+		require.Equal(t, api.ErrNilReorgsHandler, err)
+	})
+}
+
+func TestReorgsProxy_GetReorgs(t *testing.T) {
+	t.Parallel()
+
+	events := []api.ReorgEvent{
+		{
+			Id:         4,
+			InstanceId: "instance",
+			Nonce:      100,
+			OldHash:    "oldHash",
+			NewHash:    "newHash",
+			Timestamp:  1000,
+		},
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		requestedFromId := uint64(0)
+		reorgsHandler := &apiMocks.ReorgsTrackerStub{
+			GetReorgsCalled: func(fromId uint64) []api.ReorgEvent {
+				requestedFromId = fromId
+				return events
+			},
+			GetInstanceIdCalled: func() string {
+				return "instance"
+			},
+		}
+		proxy, _ := api.NewReorgsProxy(reorgsHandler)
+		ws := startReorgsServer(proxy)
+
+		apiResp := sendReorgsRequest(t, ws, reorgsPath+"?fromId=4", http.StatusOK)
+		require.Equal(t, &api.CovalentReorgsApiResponse{
+			Data:       events,
+			InstanceId: "instance",
+			Error:      "",
+			Code:       api.ReturnCodeSuccess,
+		}, apiResp)
+		require.Equal(t, uint64(4), requestedFromId)
+	})
+
+	t.Run("no fromId parameter, should request all events", func(t *testing.T) {
+		t.Parallel()
+
+		requestedFromId := uint64(1)
+		reorgsHandler := &apiMocks.ReorgsTrackerStub{
+			GetReorgsCalled: func(fromId uint64) []api.ReorgEvent {
+				requestedFromId = fromId
+				return make([]api.ReorgEvent, 0)
+			},
+		}
+		proxy, _ := api.NewReorgsProxy(reorgsHandler)
+		ws := startReorgsServer(proxy)
+
+		apiResp := sendReorgsRequest(t, ws, reorgsPath, http.StatusOK)
+		require.Empty(t, apiResp.Data)
+		require.Equal(t, api.ReturnCodeSuccess, apiResp.Code)
+		require.Equal(t, uint64(0), requestedFromId)
+	})
+
+	t.Run("invalid fromId parameter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		reorgsHandler := &apiMocks.ReorgsTrackerStub{
+			GetReorgsCalled: func(fromId uint64) []api.ReorgEvent {
+				require.Fail(t, "should not get reorgs")
+				return nil
+			},
+		}
+		proxy, _ := api.NewReorgsProxy(reorgsHandler)
+		ws := startReorgsServer(proxy)
+
+		apiResp := sendReorgsRequest(t, ws, reorgsPath+"?fromId=abc", http.StatusBadRequest)
+		require.Nil(t, apiResp.Data)
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+		require.Contains(t, apiResp.Error, api.ErrInvalidFromIdParameter.Error())
+	})
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type transactionProxy struct {
+	transactionFacade TransactionFacadeHandler
+}
+
+// NewTransactionProxy will create a proxy able to fetch single transactions from Multiversx and return them in
+// covalent format
+func NewTransactionProxy(transactionFacade TransactionFacadeHandler) (*transactionProxy, error) {
+	if transactionFacade == nil {
+		return nil, errNilTransactionFacade
+	}
+
+	return &transactionProxy{
+		transactionFacade: transactionFacade,
+	}, nil
+}
+
+// GetTransactionByHash will fetch requested transaction by hash
+func (tp *transactionProxy) GetTransactionByHash(c *gin.Context) {
+	hash, err := getTransactionHashFromRequest(c)
+	if err != nil {
+		respondWithBadRequest(c, err)
+		return
+	}
+
+	transactionApiResponse, err := tp.transactionFacade.GetTransactionByHash(c.Request.Context(), hash)
+	if err != nil {
+		respondWithFacadeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, transactionApiResponse)
+}
+
+func getTransactionHashFromRequest(c *gin.Context) (string, error) {
+	hash := c.Param("hash")
+	_, err := hex.DecodeString(hash)
+	if err != nil || len(hash) == 0 {
+		return "", fmt.Errorf("%w: %s", errInvalidTransactionHash, hash)
+	}
+
+	return hash, nil
+}
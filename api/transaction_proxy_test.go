@@ -0,0 +1,138 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock/apiMocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+const transactionPath = "/transaction"
+
+func startTransactionServer(proxy api.TransactionProxy) *gin.Engine {
+	ws := gin.New()
+	ws.GET(transactionPath+"/:hash", proxy.GetTransactionByHash)
+
+	return ws
+}
+
+func sendTransactionRequest(t *testing.T, ws *gin.Engine, path string, expectedStatus int) *api.CovalentHyperBlockApiResponse {
+	body := serveHTTPRequest(t, ws, path, expectedStatus)
+
+	apiResp := &api.CovalentHyperBlockApiResponse{}
+	loadResponse(t, body, apiResp)
+
+	return apiResp
+}
+
+func TestNewTransactionProxy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, err := api.NewTransactionProxy(&apiMocks.TransactionFacadeStub{})
+		require.Nil(t, err)
+		require.NotNil(t, proxy)
+	})
+
+	t.Run("nil transaction facade, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy, err := api.NewTransactionProxy(nil)
+		require.Nil(t, proxy)
+		require.Equal(t, api.ErrNilTransactionFacade, err)
+	})
+}
+
+func TestTransactionProxy_GetTransactionByHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		requestedHash := ""
+		expectedResponse := &api.CovalentHyperBlockApiResponse{
+			Data:  []byte("transaction"),
+			Error: "",
+			Code:  api.ReturnCodeSuccess,
+		}
+		facade := &apiMocks.TransactionFacadeStub{
+			GetTransactionByHashCalled: func(ctx context.Context, hash string) (*api.CovalentHyperBlockApiResponse, error) {
+				requestedHash = hash
+				return expectedResponse, nil
+			},
+		}
+		proxy, _ := api.NewTransactionProxy(facade)
+		ws := startTransactionServer(proxy)
+
+		apiResp := sendTransactionRequest(t, ws, transactionPath+"/ff01", http.StatusOK)
+		require.Equal(t, expectedResponse, apiResp)
+		require.Equal(t, "ff01", requestedHash)
+	})
+
+	t.Run("invalid hash, should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &apiMocks.TransactionFacadeStub{
+			GetTransactionByHashCalled: func(ctx context.Context, hash string) (*api.CovalentHyperBlockApiResponse, error) {
+				require.Fail(t, "should not call facade")
+				return nil, nil
+			},
+		}
+		proxy, _ := api.NewTransactionProxy(facade)
+		ws := startTransactionServer(proxy)
+
+		apiResp := sendTransactionRequest(t, ws, transactionPath+"/xyz", http.StatusBadRequest)
+		require.Nil(t, apiResp.Data)
+		require.True(t, strings.Contains(apiResp.Error, api.ErrInvalidTransactionHash.Error()))
+		require.Equal(t, api.ReturnCodeRequestError, apiResp.Code)
+	})
+
+	t.Run("transaction not found, should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		errNotFound := fmt.Errorf("%w: hash ff01", api.ErrTransactionNotFound)
+		facade := &apiMocks.TransactionFacadeStub{
+			GetTransactionByHashCalled: func(ctx context.Context, hash string) (*api.CovalentHyperBlockApiResponse, error) {
+				return nil, errNotFound
+			},
+		}
+		proxy, _ := api.NewTransactionProxy(facade)
+		ws := startTransactionServer(proxy)
+
+		apiResp := sendTransactionRequest(t, ws, transactionPath+"/ff01", http.StatusNotFound)
+		require.Equal(t, &api.CovalentHyperBlockApiResponse{
+			Data:  nil,
+			Error: errNotFound.Error(),
+			Code:  api.ReturnCodeRequestError,
+		}, apiResp)
+	})
+
+	t.Run("facade error, should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		errFacade := errors.New("error getting transaction")
+		facade := &apiMocks.TransactionFacadeStub{
+			GetTransactionByHashCalled: func(ctx context.Context, hash string) (*api.CovalentHyperBlockApiResponse, error) {
+				return nil, errFacade
+			},
+		}
+		proxy, _ := api.NewTransactionProxy(facade)
+		ws := startTransactionServer(proxy)
+
+		apiResp := sendTransactionRequest(t, ws, transactionPath+"/ff01", http.StatusInternalServerError)
+		require.Equal(t, &api.CovalentHyperBlockApiResponse{
+			Data:  nil,
+			Error: errFacade.Error(),
+			Code:  api.ReturnCodeInternalError,
+		}, apiResp)
+	})
+}
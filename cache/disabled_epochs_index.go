@@ -0,0 +1,29 @@
+package cache
+
+type disabledEpochsIndex struct {
+}
+
+// NewDisabledEpochsIndex will create an epochs index which does not store anything
+func NewDisabledEpochsIndex() *disabledEpochsIndex {
+	return &disabledEpochsIndex{}
+}
+
+// GetEpochStartNonce returns nothing, since nothing is indexed
+func (dei *disabledEpochsIndex) GetEpochStartNonce(_ uint32) (uint64, bool) {
+	return 0, false
+}
+
+// PutEpochStartNonce does nothing
+func (dei *disabledEpochsIndex) PutEpochStartNonce(_ uint32, _ uint64) error {
+	return nil
+}
+
+// Close does nothing
+func (dei *disabledEpochsIndex) Close() error {
+	return nil
+}
+
+// IsEnabled returns false, since nothing is indexed
+func (dei *disabledEpochsIndex) IsEnabled() bool {
+	return false
+}
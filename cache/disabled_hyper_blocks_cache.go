@@ -0,0 +1,34 @@
+package cache
+
+type disabledHyperBlocksCache struct {
+}
+
+// NewDisabledHyperBlocksCache will create a hyper blocks cache which does not store anything
+func NewDisabledHyperBlocksCache() *disabledHyperBlocksCache {
+	return &disabledHyperBlocksCache{}
+}
+
+// GetByNonce returns nothing, since nothing is cached
+func (dhc *disabledHyperBlocksCache) GetByNonce(_ uint64, _ string) ([]byte, bool) {
+	return nil, false
+}
+
+// GetByHash returns nothing, since nothing is cached
+func (dhc *disabledHyperBlocksCache) GetByHash(_ string, _ string) ([]byte, bool) {
+	return nil, false
+}
+
+// Put does nothing
+func (dhc *disabledHyperBlocksCache) Put(_ uint64, _ string, _ string, _ []byte) error {
+	return nil
+}
+
+// Close does nothing
+func (dhc *disabledHyperBlocksCache) Close() error {
+	return nil
+}
+
+// IsEnabled returns false, since nothing is cached
+func (dhc *disabledHyperBlocksCache) IsEnabled() bool {
+	return false
+}
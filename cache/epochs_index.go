@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"encoding/binary"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+const uint32Size = 4
+
+// epochsIndex is an on-disk store of the start nonces of epochs, such that the boundaries of an epoch are only
+// resolved once
+type epochsIndex struct {
+	db *leveldb.DB
+}
+
+// NewEpochsIndex will open (or create) an on-disk epochs index at the provided path
+func NewEpochsIndex(path string) (*epochsIndex, error) {
+	if len(path) == 0 {
+		return nil, errEmptyPath
+	}
+
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("opened epochs index", "path", path)
+	return &epochsIndex{
+		db: db,
+	}, nil
+}
+
+// GetEpochStartNonce returns the indexed nonce of the first hyper block of the provided epoch
+func (ei *epochsIndex) GetEpochStartNonce(epoch uint32) (uint64, bool) {
+	nonce, err := ei.db.Get(epochKey(epoch), nil)
+	if err != nil {
+		if err != leveldb.ErrNotFound {
+			log.Warn("could not get epoch start nonce from index", "epoch", epoch, "error", err)
+		}
+		return 0, false
+	}
+	if len(nonce) != uint64Size {
+		log.Warn("invalid epoch start nonce in index", "epoch", epoch, "nonce", nonce)
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint64(nonce), true
+}
+
+// PutEpochStartNonce will store the nonce of the first hyper block of the provided epoch
+func (ei *epochsIndex) PutEpochStartNonce(epoch uint32, nonce uint64) error {
+	return ei.db.Put(epochKey(epoch), appendUint64(nil, nonce), nil)
+}
+
+// Close will close the underlying storage
+func (ei *epochsIndex) Close() error {
+	return ei.db.Close()
+}
+
+// IsEnabled returns true, since epoch start nonces are indexed
+func (ei *epochsIndex) IsEnabled() bool {
+	return true
+}
+
+func epochKey(epoch uint32) []byte {
+	key := make([]byte, uint32Size)
+	binary.BigEndian.PutUint32(key, epoch)
+	return key
+}
@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEpochsIndex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ei, err := NewEpochsIndex(t.TempDir())
+		require.Nil(t, err)
+		require.NotNil(t, ei)
+		require.True(t, ei.IsEnabled())
+		require.Nil(t, ei.Close())
+	})
+
+	t.Run("empty path, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		ei, err := NewEpochsIndex("")
+		require.Nil(t, ei)
+		require.Equal(t, errEmptyPath, err)
+	})
+}
+
+func TestEpochsIndex_PutGetEpochStartNonce(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	ei, _ := NewEpochsIndex(path)
+
+	nonce, found := ei.GetEpochStartNonce(4)
+	require.False(t, found)
+	require.Zero(t, nonce)
+
+	require.Nil(t, ei.PutEpochStartNonce(4, 14400))
+	require.Nil(t, ei.PutEpochStartNonce(5, 28800))
+	nonce, found = ei.GetEpochStartNonce(4)
+	require.True(t, found)
+	require.Equal(t, uint64(14400), nonce)
+
+	require.Nil(t, ei.Close())
+
+	ei, _ = NewEpochsIndex(path)
+	defer func() {
+		_ = ei.Close()
+	}()
+
+	nonce, found = ei.GetEpochStartNonce(5)
+	require.True(t, found)
+	require.Equal(t, uint64(28800), nonce)
+}
+
+func TestDisabledEpochsIndex(t *testing.T) {
+	t.Parallel()
+
+	dei := NewDisabledEpochsIndex()
+	require.False(t, dei.IsEnabled())
+	require.Nil(t, dei.PutEpochStartNonce(4, 14400))
+
+	nonce, found := dei.GetEpochStartNonce(4)
+	require.False(t, found)
+	require.Zero(t, nonce)
+	require.Nil(t, dei.Close())
+}
@@ -0,0 +1,13 @@
+package cache
+
+import "errors"
+
+var errEmptyPath = errors.New("empty cache path provided")
+
+var errInvalidMaxNumHyperBlocks = errors.New("invalid max number of hyper blocks")
+
+var errInvalidMaxSize = errors.New("invalid max size")
+
+var errEmptyAddressEncoding = errors.New("empty address encoding provided")
+
+var errEmptySchemaDefinition = errors.New("empty schema definition provided")
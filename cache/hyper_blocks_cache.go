@@ -0,0 +1,281 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+var log = logger.GetOrCreate("cache")
+
+const (
+	dataKeyPrefix     = 'd'
+	nonceKeyPrefix    = 'n'
+	sequenceKeyPrefix = 's'
+	keySeparator      = 0
+	uint64Size        = 8
+	bytesInMB         = 1024 * 1024
+	fingerprintLen    = 16
+)
+
+// HyperBlocksCacheArgs holds all input dependencies required by hyper blocks cache
+type HyperBlocksCacheArgs struct {
+	Path              string
+	MaxNumHyperBlocks uint64
+	MaxSizeInMB       uint64
+	AddressEncoding   string
+	SchemaDefinition  string
+	SchemaId          uint32
+	UsesWireFormat    bool
+}
+
+// hyperBlocksCache is an on-disk store of avro encoded hyper blocks, indexed by nonce and by hash.
+// Each entry is also indexed by an insertion sequence, such that the oldest entries are evicted first,
+// once the configured limits are exceeded.
+type hyperBlocksCache struct {
+	db                *leveldb.DB
+	mutex             sync.Mutex
+	maxNumHyperBlocks uint64
+	maxSizeInBytes    uint64
+	numHyperBlocks    uint64
+	sizeInBytes       uint64
+	nextSequence      uint64
+	encodingKey       string
+}
+
+// NewHyperBlocksCache will open (or create) an on-disk hyper blocks cache at the provided path
+func NewHyperBlocksCache(args HyperBlocksCacheArgs) (*hyperBlocksCache, error) {
+	if len(args.Path) == 0 {
+		return nil, errEmptyPath
+	}
+	if args.MaxNumHyperBlocks == 0 {
+		return nil, fmt.Errorf("%w; expected non zero value", errInvalidMaxNumHyperBlocks)
+	}
+	if args.MaxSizeInMB == 0 {
+		return nil, fmt.Errorf("%w; expected non zero value", errInvalidMaxSize)
+	}
+	if len(args.AddressEncoding) == 0 {
+		return nil, errEmptyAddressEncoding
+	}
+	if len(args.SchemaDefinition) == 0 {
+		return nil, errEmptySchemaDefinition
+	}
+
+	db, err := leveldb.OpenFile(args.Path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hbc := &hyperBlocksCache{
+		db:                db,
+		maxNumHyperBlocks: args.MaxNumHyperBlocks,
+		maxSizeInBytes:    args.MaxSizeInMB * bytesInMB,
+		encodingKey:       createEncodingKey(args),
+	}
+
+	err = hbc.loadStats()
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	log.Debug("opened hyper blocks cache", "path", args.Path, "encoding key", hbc.encodingKey,
+		"num hyper blocks", hbc.numHyperBlocks, "size in bytes", hbc.sizeInBytes)
+	return hbc, nil
+}
+
+// createEncodingKey identifies how the cached hyper blocks are encoded, such that hyper blocks cached with a
+// different address encoding, schema or wire format header are never returned
+func createEncodingKey(args HyperBlocksCacheArgs) string {
+	schemaHash := sha256.Sum256([]byte(args.SchemaDefinition))
+	schemaFingerprint := hex.EncodeToString(schemaHash[:])[:fingerprintLen]
+
+	encodingKey := fmt.Sprintf("addressEncoding=%s&schema=%s", args.AddressEncoding, schemaFingerprint)
+	if args.UsesWireFormat {
+		encodingKey += fmt.Sprintf("&schemaId=%d", args.SchemaId)
+	}
+
+	return encodingKey
+}
+
+func (hbc *hyperBlocksCache) loadStats() error {
+	iterator := hbc.db.NewIterator(util.BytesPrefix([]byte{sequenceKeyPrefix}), nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		hbc.numHyperBlocks++
+		hbc.sizeInBytes += binary.BigEndian.Uint64(iterator.Value()[:uint64Size])
+		hbc.nextSequence = binary.BigEndian.Uint64(iterator.Key()[1:]) + 1
+	}
+
+	return iterator.Error()
+}
+
+// GetByNonce returns the cached avro encoded hyper block with provided nonce, queried with the provided options
+func (hbc *hyperBlocksCache) GetByNonce(nonce uint64, optionsKey string) ([]byte, bool) {
+	optionsKey = hbc.entryOptionsKey(optionsKey)
+	hash, err := hbc.db.Get(nonceKey(nonce, optionsKey), nil)
+	if err != nil {
+		return nil, false
+	}
+
+	return hbc.get(dataKey(hash, optionsKey))
+}
+
+// GetByHash returns the cached avro encoded hyper block with provided hex encoded hash, queried with the provided options
+func (hbc *hyperBlocksCache) GetByHash(hash string, optionsKey string) ([]byte, bool) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, false
+	}
+
+	return hbc.get(dataKey(hashBytes, hbc.entryOptionsKey(optionsKey)))
+}
+
+func (hbc *hyperBlocksCache) get(key []byte) ([]byte, bool) {
+	encodedHyperBlock, err := hbc.db.Get(key, nil)
+	if err != nil {
+		if err != leveldb.ErrNotFound {
+			log.Warn("could not get hyper block from cache", "error", err)
+		}
+		return nil, false
+	}
+
+	return encodedHyperBlock, true
+}
+
+// Put will store the avro encoded hyper block, having the provided nonce and hex encoded hash, queried with the
+// provided options. If needed, the oldest hyper blocks are evicted, such that the configured limits are respected.
+func (hbc *hyperBlocksCache) Put(nonce uint64, hash string, optionsKey string, encodedHyperBlock []byte) error {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return err
+	}
+
+	hbc.mutex.Lock()
+	defer hbc.mutex.Unlock()
+
+	optionsKey = hbc.entryOptionsKey(optionsKey)
+	nonceIdxKey := nonceKey(nonce, optionsKey)
+	exists, err := hbc.db.Has(nonceIdxKey, nil)
+	if err != nil || exists {
+		return err
+	}
+
+	entrySize := uint64(len(encodedHyperBlock))
+	sequenceValue := make([]byte, uint64Size, uint64Size+len(nonceIdxKey))
+	binary.BigEndian.PutUint64(sequenceValue, entrySize)
+	sequenceValue = append(sequenceValue, nonceIdxKey...)
+
+	batch := &leveldb.Batch{}
+	batch.Put(dataKey(hashBytes, optionsKey), encodedHyperBlock)
+	batch.Put(nonceIdxKey, hashBytes)
+	batch.Put(sequenceKey(hbc.nextSequence), sequenceValue)
+	err = hbc.db.Write(batch, nil)
+	if err != nil {
+		return err
+	}
+
+	hbc.nextSequence++
+	hbc.numHyperBlocks++
+	hbc.sizeInBytes += entrySize
+
+	return hbc.evictIfNeeded()
+}
+
+func (hbc *hyperBlocksCache) entryOptionsKey(optionsKey string) string {
+	return hbc.encodingKey + "/" + optionsKey
+}
+
+// evictIfNeeded evicts the oldest hyper blocks until the cache is within the configured limits. An entry missing its
+// nonce index is still evicted, though its hyper block data can not be found anymore
+func (hbc *hyperBlocksCache) evictIfNeeded() error {
+	if !hbc.isFull() {
+		return nil
+	}
+
+	iterator := hbc.db.NewIterator(util.BytesPrefix([]byte{sequenceKeyPrefix}), nil)
+	defer iterator.Release()
+
+	batch := &leveldb.Batch{}
+	numEvicted := 0
+	for hbc.isFull() && iterator.Next() {
+		entrySize := binary.BigEndian.Uint64(iterator.Value()[:uint64Size])
+		nonceIdxKey := iterator.Value()[uint64Size:]
+
+		hash, err := hbc.db.Get(nonceIdxKey, nil)
+		switch err {
+		case nil:
+			optionsKey := nonceIdxKey[1 : len(nonceIdxKey)-uint64Size-1]
+			batch.Delete(dataKey(hash, string(optionsKey)))
+			batch.Delete(nonceIdxKey)
+		case leveldb.ErrNotFound:
+			log.Warn("missing nonce index of cached hyper block, evicting it anyway", "sequence", binary.BigEndian.Uint64(iterator.Key()[1:]))
+		default:
+			return err
+		}
+		batch.Delete(copyBytes(iterator.Key()))
+
+		hbc.numHyperBlocks--
+		hbc.sizeInBytes -= entrySize
+		numEvicted++
+	}
+	if iterator.Error() != nil {
+		return iterator.Error()
+	}
+
+	log.Trace("evicted hyper blocks from cache", "num evicted", numEvicted)
+	return hbc.db.Write(batch, nil)
+}
+
+func (hbc *hyperBlocksCache) isFull() bool {
+	return hbc.numHyperBlocks > hbc.maxNumHyperBlocks || hbc.sizeInBytes > hbc.maxSizeInBytes
+}
+
+// Close will close the underlying storage
+func (hbc *hyperBlocksCache) Close() error {
+	return hbc.db.Close()
+}
+
+// IsEnabled returns true, since hyper blocks are cached
+func (hbc *hyperBlocksCache) IsEnabled() bool {
+	return true
+}
+
+func dataKey(hash []byte, optionsKey string) []byte {
+	key := make([]byte, 0, 2+len(optionsKey)+len(hash))
+	key = append(key, dataKeyPrefix)
+	key = append(key, optionsKey...)
+	key = append(key, keySeparator)
+	return append(key, hash...)
+}
+
+func nonceKey(nonce uint64, optionsKey string) []byte {
+	key := make([]byte, 0, 2+len(optionsKey)+uint64Size)
+	key = append(key, nonceKeyPrefix)
+	key = append(key, optionsKey...)
+	key = append(key, keySeparator)
+	return appendUint64(key, nonce)
+}
+
+func sequenceKey(sequence uint64) []byte {
+	return appendUint64([]byte{sequenceKeyPrefix}, sequence)
+}
+
+func appendUint64(key []byte, value uint64) []byte {
+	buff := make([]byte, uint64Size)
+	binary.BigEndian.PutUint64(buff, value)
+	return append(key, buff...)
+}
+
+func copyBytes(in []byte) []byte {
+	out := make([]byte, len(in))
+	copy(out, in)
+	return out
+}
@@ -0,0 +1,380 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createMockHyperBlocksCacheArgs(t *testing.T) HyperBlocksCacheArgs {
+	return HyperBlocksCacheArgs{
+		Path:              t.TempDir(),
+		MaxNumHyperBlocks: 10,
+		MaxSizeInMB:       1,
+		AddressEncoding:   "bech32",
+		SchemaDefinition:  `{"type": "record", "name": "HyperBlock", "fields": []}`,
+	}
+}
+
+func hashFromNonce(nonce uint64) string {
+	return fmt.Sprintf("%064x", nonce)
+}
+
+func TestNewHyperBlocksCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hbc, err := NewHyperBlocksCache(createMockHyperBlocksCacheArgs(t))
+		require.Nil(t, err)
+		require.NotNil(t, hbc)
+		require.True(t, hbc.IsEnabled())
+		require.Nil(t, hbc.Close())
+	})
+
+	t.Run("empty path, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlocksCacheArgs(t)
+		args.Path = ""
+		hbc, err := NewHyperBlocksCache(args)
+		require.Nil(t, hbc)
+		require.Equal(t, errEmptyPath, err)
+	})
+
+	t.Run("invalid max num hyper blocks, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlocksCacheArgs(t)
+		args.MaxNumHyperBlocks = 0
+		hbc, err := NewHyperBlocksCache(args)
+		require.Nil(t, hbc)
+		require.True(t, errors.Is(err, errInvalidMaxNumHyperBlocks))
+	})
+
+	t.Run("invalid max size, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlocksCacheArgs(t)
+		args.MaxSizeInMB = 0
+		hbc, err := NewHyperBlocksCache(args)
+		require.Nil(t, hbc)
+		require.True(t, errors.Is(err, errInvalidMaxSize))
+	})
+
+	t.Run("empty address encoding, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlocksCacheArgs(t)
+		args.AddressEncoding = ""
+		hbc, err := NewHyperBlocksCache(args)
+		require.Nil(t, hbc)
+		require.Equal(t, errEmptyAddressEncoding, err)
+	})
+
+	t.Run("empty schema definition, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlocksCacheArgs(t)
+		args.SchemaDefinition = ""
+		hbc, err := NewHyperBlocksCache(args)
+		require.Nil(t, hbc)
+		require.Equal(t, errEmptySchemaDefinition, err)
+	})
+}
+
+func TestHyperBlocksCache_PutGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should get cached hyper block by nonce and by hash", func(t *testing.T) {
+		t.Parallel()
+
+		hbc, _ := NewHyperBlocksCache(createMockHyperBlocksCacheArgs(t))
+		defer func() {
+			_ = hbc.Close()
+		}()
+
+		encodedHyperBlock := []byte("encodedHyperBlock")
+		err := hbc.Put(4, hashFromNonce(4), "?withLogs=true", encodedHyperBlock)
+		require.Nil(t, err)
+
+		cachedHyperBlock, found := hbc.GetByNonce(4, "?withLogs=true")
+		require.True(t, found)
+		require.Equal(t, encodedHyperBlock, cachedHyperBlock)
+
+		cachedHyperBlock, found = hbc.GetByHash(hashFromNonce(4), "?withLogs=true")
+		require.True(t, found)
+		require.Equal(t, encodedHyperBlock, cachedHyperBlock)
+
+		cachedHyperBlock, found = hbc.GetByNonce(5, "?withLogs=true")
+		require.False(t, found)
+		require.Nil(t, cachedHyperBlock)
+	})
+
+	t.Run("different query options should not share cached hyper blocks", func(t *testing.T) {
+		t.Parallel()
+
+		hbc, _ := NewHyperBlocksCache(createMockHyperBlocksCacheArgs(t))
+		defer func() {
+			_ = hbc.Close()
+		}()
+
+		err := hbc.Put(4, hashFromNonce(4), "", []byte("noOptions"))
+		require.Nil(t, err)
+		err = hbc.Put(4, hashFromNonce(4), "?withLogs=true", []byte("withLogs"))
+		require.Nil(t, err)
+
+		cachedHyperBlock, _ := hbc.GetByNonce(4, "")
+		require.Equal(t, []byte("noOptions"), cachedHyperBlock)
+		cachedHyperBlock, _ = hbc.GetByHash(hashFromNonce(4), "?withLogs=true")
+		require.Equal(t, []byte("withLogs"), cachedHyperBlock)
+
+		_, found := hbc.GetByNonce(4, "?tokens=all")
+		require.False(t, found)
+	})
+
+	t.Run("invalid hash, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		hbc, _ := NewHyperBlocksCache(createMockHyperBlocksCacheArgs(t))
+		defer func() {
+			_ = hbc.Close()
+		}()
+
+		err := hbc.Put(4, "not hex", "", []byte("encodedHyperBlock"))
+		require.NotNil(t, err)
+
+		_, found := hbc.GetByHash("not hex", "")
+		require.False(t, found)
+	})
+
+	t.Run("already cached hyper block should not be overwritten", func(t *testing.T) {
+		t.Parallel()
+
+		hbc, _ := NewHyperBlocksCache(createMockHyperBlocksCacheArgs(t))
+		defer func() {
+			_ = hbc.Close()
+		}()
+
+		err := hbc.Put(4, hashFromNonce(4), "", []byte("first"))
+		require.Nil(t, err)
+		err = hbc.Put(4, hashFromNonce(4), "", []byte("second"))
+		require.Nil(t, err)
+
+		cachedHyperBlock, _ := hbc.GetByNonce(4, "")
+		require.Equal(t, []byte("first"), cachedHyperBlock)
+		require.Equal(t, uint64(1), hbc.numHyperBlocks)
+	})
+}
+
+func TestHyperBlocksCache_Eviction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("max num hyper blocks exceeded, should evict oldest hyper blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlocksCacheArgs(t)
+		args.MaxNumHyperBlocks = 3
+		hbc, _ := NewHyperBlocksCache(args)
+		defer func() {
+			_ = hbc.Close()
+		}()
+
+		for nonce := uint64(1); nonce <= 5; nonce++ {
+			err := hbc.Put(nonce, hashFromNonce(nonce), "", []byte(fmt.Sprintf("encodedHyperBlock%d", nonce)))
+			require.Nil(t, err)
+		}
+
+		for nonce := uint64(1); nonce <= 2; nonce++ {
+			_, found := hbc.GetByNonce(nonce, "")
+			require.False(t, found)
+			_, found = hbc.GetByHash(hashFromNonce(nonce), "")
+			require.False(t, found)
+		}
+		for nonce := uint64(3); nonce <= 5; nonce++ {
+			cachedHyperBlock, found := hbc.GetByNonce(nonce, "")
+			require.True(t, found)
+			require.Equal(t, []byte(fmt.Sprintf("encodedHyperBlock%d", nonce)), cachedHyperBlock)
+		}
+		require.Equal(t, uint64(3), hbc.numHyperBlocks)
+	})
+
+	t.Run("max size exceeded, should evict oldest hyper blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlocksCacheArgs(t)
+		args.MaxSizeInMB = 1
+		hbc, _ := NewHyperBlocksCache(args)
+		defer func() {
+			_ = hbc.Close()
+		}()
+
+		halfMB := make([]byte, bytesInMB/2)
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			err := hbc.Put(nonce, hashFromNonce(nonce), "", halfMB)
+			require.Nil(t, err)
+		}
+
+		_, found := hbc.GetByNonce(1, "")
+		require.False(t, found)
+		_, found = hbc.GetByNonce(2, "")
+		require.True(t, found)
+		_, found = hbc.GetByNonce(3, "")
+		require.True(t, found)
+		require.Equal(t, uint64(bytesInMB), hbc.sizeInBytes)
+	})
+
+	t.Run("missing nonce index entry, should skip it and keep evicting", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlocksCacheArgs(t)
+		args.MaxNumHyperBlocks = 3
+		hbc, _ := NewHyperBlocksCache(args)
+		defer func() {
+			_ = hbc.Close()
+		}()
+
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			err := hbc.Put(nonce, hashFromNonce(nonce), "", []byte(fmt.Sprintf("encodedHyperBlock%d", nonce)))
+			require.Nil(t, err)
+		}
+		err := hbc.db.Delete(nonceKey(1, hbc.entryOptionsKey("")), nil)
+		require.Nil(t, err)
+
+		for nonce := uint64(4); nonce <= 6; nonce++ {
+			err = hbc.Put(nonce, hashFromNonce(nonce), "", []byte(fmt.Sprintf("encodedHyperBlock%d", nonce)))
+			require.Nil(t, err)
+		}
+
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			_, found := hbc.GetByNonce(nonce, "")
+			require.False(t, found)
+		}
+		for nonce := uint64(4); nonce <= 6; nonce++ {
+			cachedHyperBlock, found := hbc.GetByNonce(nonce, "")
+			require.True(t, found)
+			require.Equal(t, []byte(fmt.Sprintf("encodedHyperBlock%d", nonce)), cachedHyperBlock)
+		}
+		require.Equal(t, uint64(3), hbc.numHyperBlocks)
+	})
+}
+
+func TestHyperBlocksCache_Reopen(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHyperBlocksCacheArgs(t)
+	args.MaxNumHyperBlocks = 3
+	hbc, _ := NewHyperBlocksCache(args)
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		err := hbc.Put(nonce, hashFromNonce(nonce), "", []byte("encodedHyperBlock"))
+		require.Nil(t, err)
+	}
+	require.Nil(t, hbc.Close())
+
+	hbc, err := NewHyperBlocksCache(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = hbc.Close()
+	}()
+	require.Equal(t, uint64(2), hbc.numHyperBlocks)
+	require.Equal(t, uint64(2*len("encodedHyperBlock")), hbc.sizeInBytes)
+
+	cachedHyperBlock, found := hbc.GetByHash(hashFromNonce(2), "")
+	require.True(t, found)
+	require.Equal(t, []byte("encodedHyperBlock"), cachedHyperBlock)
+
+	for nonce := uint64(3); nonce <= 4; nonce++ {
+		err = hbc.Put(nonce, hashFromNonce(nonce), "", []byte("encodedHyperBlock"))
+		require.Nil(t, err)
+	}
+	_, found = hbc.GetByNonce(1, "")
+	require.False(t, found)
+	_, found = hbc.GetByNonce(4, "")
+	require.True(t, found)
+}
+
+func TestHyperBlocksCache_ReopenWithDifferentEncoding(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHyperBlocksCacheArgs(t)
+	hbc, _ := NewHyperBlocksCache(args)
+	err := hbc.Put(1, hashFromNonce(1), "", []byte("encodedHyperBlock"))
+	require.Nil(t, err)
+	require.Nil(t, hbc.Close())
+
+	t.Run("different address encoding should not share cached hyper blocks", func(t *testing.T) {
+		reopenArgs := args
+		reopenArgs.AddressEncoding = "hex"
+		hbc, err = NewHyperBlocksCache(reopenArgs)
+		require.Nil(t, err)
+
+		_, found := hbc.GetByNonce(1, "")
+		require.False(t, found)
+		_, found = hbc.GetByHash(hashFromNonce(1), "")
+		require.False(t, found)
+		require.Nil(t, hbc.Close())
+	})
+
+	t.Run("different schema definition should not share cached hyper blocks", func(t *testing.T) {
+		reopenArgs := args
+		reopenArgs.SchemaDefinition = `{"type": "record", "name": "HyperBlock", "fields": [{"name": "Nonce", "type": "long"}]}`
+		hbc, err = NewHyperBlocksCache(reopenArgs)
+		require.Nil(t, err)
+
+		_, found := hbc.GetByNonce(1, "")
+		require.False(t, found)
+		_, found = hbc.GetByHash(hashFromNonce(1), "")
+		require.False(t, found)
+		require.Nil(t, hbc.Close())
+	})
+
+	t.Run("wire format should not share cached hyper blocks", func(t *testing.T) {
+		reopenArgs := args
+		reopenArgs.SchemaId = 7
+		reopenArgs.UsesWireFormat = true
+		hbc, err = NewHyperBlocksCache(reopenArgs)
+		require.Nil(t, err)
+
+		err = hbc.Put(1, hashFromNonce(1), "", []byte("schemaId7"))
+		require.Nil(t, err)
+		cachedHyperBlock, found := hbc.GetByNonce(1, "")
+		require.True(t, found)
+		require.Equal(t, []byte("schemaId7"), cachedHyperBlock)
+		require.Nil(t, hbc.Close())
+
+		reopenArgs.SchemaId = 8
+		hbc, err = NewHyperBlocksCache(reopenArgs)
+		require.Nil(t, err)
+
+		_, found = hbc.GetByNonce(1, "")
+		require.False(t, found)
+		require.Nil(t, hbc.Close())
+	})
+
+	t.Run("same encoding should share cached hyper blocks", func(t *testing.T) {
+		hbc, err = NewHyperBlocksCache(args)
+		require.Nil(t, err)
+
+		cachedHyperBlock, found := hbc.GetByNonce(1, "")
+		require.True(t, found)
+		require.Equal(t, []byte("encodedHyperBlock"), cachedHyperBlock)
+		require.Nil(t, hbc.Close())
+	})
+}
+
+func TestDisabledHyperBlocksCache(t *testing.T) {
+	t.Parallel()
+
+	dhc := NewDisabledHyperBlocksCache()
+	require.False(t, dhc.IsEnabled())
+	require.Nil(t, dhc.Put(4, hashFromNonce(4), "", []byte("encodedHyperBlock")))
+
+	_, found := dhc.GetByNonce(4, "")
+	require.False(t, found)
+	_, found = dhc.GetByHash(hashFromNonce(4), "")
+	require.False(t, found)
+	require.Nil(t, dhc.Close())
+}
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/facade"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/schemaRegistry"
+	"github.com/multiversx/mx-chain-covalent-go/validator"
+)
+
+// CreateAvroMarshaller creates the hyper blocks avro marshaller. If the schema registry is enabled, records are
+// encoded in the confluent wire format, with the schema id looked up in the registry
+func CreateAvroMarshaller(cfg config.SchemaRegistry, schemaDefinition string) (*utility.AvroMarshaller, error) {
+	if !cfg.Enabled {
+		return utility.NewAvroMarshallerWithSchema(schemaDefinition)
+	}
+
+	schemaRegistryClient, err := schemaRegistry.NewSchemaRegistryClient(schemaRegistry.ArgsSchemaRegistryClient{
+		Url:               cfg.Url,
+		AutoRegister:      cfg.AutoRegister,
+		RequestTimeOutSec: cfg.RequestTimeOutSec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return utility.NewAvroMarshallerWithSchemaRegistry(utility.ArgsAvroMarshallerWithSchemaRegistry{
+		SchemaDefinition: schemaDefinition,
+		SchemaRegistry:   schemaRegistryClient,
+		Subject:          cfg.Subject,
+	})
+}
+
+// CreateChainValidator creates the chain validator of processed hyper blocks, or a disabled one
+func CreateChainValidator(cfg config.ChainValidation) facade.ChainValidator {
+	if !cfg.Enabled {
+		return validator.NewDisabledChainValidator()
+	}
+
+	return validator.NewChainValidator(validator.ArgsChainValidator{
+		FailOnViolation: cfg.FailOnViolation,
+	})
+}
@@ -1,4 +1,4 @@
-package main
+package common
 
 import (
 	"fmt"
@@ -17,7 +17,9 @@ const (
 	logFileMaxSizeInMB   = 1024
 )
 
-func attachFileLogger(log logger.Logger, logFilePrefix string, flagsConfig config.FlagsLog) error {
+// AttachFileLogger applies the log flags to the logger and, if requested, also saves the logs in files having the
+// provided prefix
+func AttachFileLogger(log logger.Logger, logFilePrefix string, flagsConfig config.FlagsLog) error {
 	var err error
 	if flagsConfig.SaveLogFile {
 		fileLogging, err := file.NewFileLogging(file.ArgsFileLogging{
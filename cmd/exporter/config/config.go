@@ -0,0 +1,48 @@
+package config
+
+import (
+	"io/ioutil"
+
+	proxyConfig "github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/pelletier/go-toml"
+)
+
+// Config holds the config for hyper blocks exporter
+type Config struct {
+	RequestTimeOutSec      uint64                             `toml:"requestTimeOutSec"`
+	HyperBlocksBatchSize   uint32                             `toml:"hyperBlocksBatchSize"`
+	PollingIntervalMs      uint64                             `toml:"pollingIntervalMs"`
+	AddressEncoding        string                             `toml:"addressEncoding"`
+	HyperBlockQueryOptions proxyConfig.HyperBlockQueryOptions `toml:"hyperBlockQueryOptions"`
+	Output                 OutputConfig                       `toml:"output"`
+	Upstreams              []proxyConfig.Upstream             `toml:"upstreams"`
+	UpstreamsHealthCheck   proxyConfig.UpstreamsHealthCheck   `toml:"upstreamsHealthCheck"`
+	RetryPolicy            proxyConfig.RetryPolicy            `toml:"retryPolicy"`
+	ChainValidation        proxyConfig.ChainValidation        `toml:"chainValidation"`
+}
+
+// OutputConfig holds the config for the exported files
+type OutputConfig struct {
+	Directory             string `toml:"directory"`
+	FilePrefix            string `toml:"filePrefix"`
+	Codec                 string `toml:"codec"`
+	MaxHyperBlocksPerFile uint64 `toml:"maxHyperBlocksPerFile"`
+	RotatePerEpoch        bool   `toml:"rotatePerEpoch"`
+	CheckpointFile        string `toml:"checkpointFile"`
+}
+
+// LoadConfig will load the Config from the provided file
+func LoadConfig(tomlFile string) (*Config, error) {
+	tomlBytes, err := ioutil.ReadFile(tomlFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	err = toml.Unmarshal(tomlBytes, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+var (
+	configFile = cli.StringFlag{
+		Name:  "config",
+		Usage: "This flag specifies the `file` holding the exporter config.",
+		Value: "./config.toml",
+	}
+	startNonce = cli.Uint64Flag{
+		Name:  "start-nonce",
+		Usage: "This flag specifies the first hyperblock `nonce` to be exported. If a checkpoint with a higher nonce exists, the export is resumed from the checkpoint.",
+		Value: 0,
+	}
+	endNonce = cli.Uint64Flag{
+		Name:  "end-nonce",
+		Usage: "This flag specifies the last hyperblock `nonce` to be exported. If not set, hyperblocks are exported up to the highest final one. It cannot be used together with --follow.",
+		Value: 0,
+	}
+	follow = cli.BoolFlag{
+		Name:  "follow",
+		Usage: "Boolean option for tailing new hyperblocks. If set, the exporter keeps waiting for new final hyperblocks after exporting the highest final one, until it is stopped.",
+	}
+	workingDirectory = cli.StringFlag{
+		Name:  "working-directory",
+		Usage: "This flag specifies the `directory` where the application will use the logs.",
+		Value: "",
+	}
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+	saveLogFile = cli.BoolFlag{
+		Name:  "log-save",
+		Usage: "Boolean option for enabling log saving. If set, it will automatically save all the logs into a file.",
+	}
+	enableLogName = cli.BoolFlag{
+		Name:  "log-logger-name",
+		Usage: "Boolean option to enable logger name in the logs.",
+	}
+	disableAnsiColor = cli.BoolFlag{
+		Name:  "disable-ansi-color",
+		Usage: "Boolean option for disabling ANSI colors in the logging system.",
+	}
+)
+
+func getFlags() []cli.Flag {
+	return []cli.Flag{
+		configFile,
+		startNonce,
+		endNonce,
+		follow,
+		workingDirectory,
+		logLevel,
+		saveLogFile,
+		enableLogName,
+		disableAnsiColor,
+	}
+}
+
+func getFlagsLogConfig(ctx *cli.Context) config.FlagsLog {
+	return config.FlagsLog{
+		WorkingDir:       ctx.GlobalString(workingDirectory.Name),
+		LogLevel:         ctx.GlobalString(logLevel.Name),
+		DisableAnsiColor: ctx.GlobalBool(disableAnsiColor.Name),
+		SaveLogFile:      ctx.GlobalBool(saveLogFile.Name),
+		EnableLogName:    ctx.GlobalBool(enableLogName.Name),
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cache"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/common"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/exporter/config"
+	proxyConfig "github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/exporter"
+	"github.com/multiversx/mx-chain-covalent-go/facade"
+	"github.com/multiversx/mx-chain-covalent-go/metrics"
+	"github.com/multiversx/mx-chain-covalent-go/process/factory"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/reorgs"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/upstreams"
+	"github.com/urfave/cli"
+)
+
+const logFilePrefix = "covalent-exporter"
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "Covalent hyperblocks exporter tool"
+	app.Usage = "This tool exports hyperblocks fetched from Multiversx, converted in covalent format, into rotated Avro object container files. It can resume an interrupted export and it can keep tailing new hyperblocks"
+	app.Flags = getFlags()
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Multiversx Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	app.Action = startExporter
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+		return
+	}
+}
+
+func startExporter(ctx *cli.Context) error {
+	flagsConfig := getFlagsLogConfig(ctx)
+	errLogger := common.AttachFileLogger(log, logFilePrefix, flagsConfig)
+	if errLogger != nil {
+		return errLogger
+	}
+
+	cfg, err := config.LoadConfig(ctx.GlobalString(configFile.Name))
+	if err != nil {
+		return err
+	}
+
+	httpClient := api.NewDefaultHttpClient(cfg.RequestTimeOutSec)
+	upstreamsHandler, err := upstreams.NewUpstreamsHandler(upstreams.ArgsUpstreamsHandler{
+		Upstreams:   cfg.Upstreams,
+		HealthCheck: cfg.UpstreamsHealthCheck,
+		HttpClient:  httpClient,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(upstreamsHandler.Close())
+	}()
+
+	hyperBlocksExporter, err := createExporter(cfg, httpClient, upstreamsHandler)
+	if err != nil {
+		return err
+	}
+
+	exportContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, os.Interrupt, os.Kill)
+		<-quit
+
+		log.Info("stopping exporter")
+		cancel()
+	}()
+
+	log.Info("starting exporter")
+	return hyperBlocksExporter.Export(
+		exportContext,
+		ctx.GlobalUint64(startNonce.Name),
+		ctx.GlobalUint64(endNonce.Name),
+		ctx.GlobalBool(follow.Name),
+	)
+}
+
+func createExporter(
+	cfg *config.Config,
+	httpClient api.HTTPClient,
+	upstreamsHandler api.UpstreamsHandler,
+) (exporter.HyperBlocksExporter, error) {
+	multiversxHyperBlockEndpointHandler, err := api.NewMultiversxHyperBlockEndPoint(api.ArgsMultiversxHyperBlockEndPoint{
+		HttpClient: httpClient,
+		Upstreams:  upstreamsHandler,
+		Metrics:    metrics.NewDisabledMetrics(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlockProcessor, err := factory.CreateHyperBlockProcessor(cfg.AddressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	addressConverter, err := factory.CreateAddressConverter(cfg.AddressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlockSchemaDefinition, err := schema.GetHyperBlockSchemaDefinition(cfg.AddressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	avroMarshaller, err := utility.NewAvroMarshallerWithSchema(hyperBlockSchemaDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceUpdatesEncoder, err := factory.CreateBalanceUpdatesEncoder(cfg.AddressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlockFacade, err := facade.NewHyperBlockFacade(&facade.HyperBlockFacadeArgs{
+		AvroEncoder:                  avroMarshaller,
+		MultiversxHyperBlockEndpoint: multiversxHyperBlockEndpointHandler,
+		HyperBlockProcessor:          hyperBlockProcessor,
+		HyperBlocksCache:             cache.NewDisabledHyperBlocksCache(),
+		HyperBlockSchemaDefinition:   hyperBlockSchemaDefinition,
+		Metrics:                      metrics.NewDisabledMetrics(),
+		RetryPolicy:                  cfg.RetryPolicy,
+		ChainValidator:               common.CreateChainValidator(cfg.ChainValidation),
+		ReorgsTracker:                reorgs.NewDisabledReorgsTracker(),
+		AddressConverter:             addressConverter,
+		EpochsIndex:                  cache.NewDisabledEpochsIndex(),
+		BalanceUpdatesEncoder:        balanceUpdatesEncoder,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return exporter.NewHyperBlocksExporter(exporter.ArgsHyperBlocksExporter{
+		Facade:           hyperBlockFacade,
+		Marshaller:       avroMarshaller,
+		SchemaDefinition: hyperBlockSchemaDefinition,
+		QueryOptions: proxyConfig.HyperBlocksQueryOptions{
+			QueryOptions: cfg.HyperBlockQueryOptions,
+			BatchSize:    cfg.HyperBlocksBatchSize,
+		},
+		OutputDirectory:       cfg.Output.Directory,
+		FilePrefix:            cfg.Output.FilePrefix,
+		Codec:                 cfg.Output.Codec,
+		MaxHyperBlocksPerFile: cfg.Output.MaxHyperBlocksPerFile,
+		RotatePerEpoch:        cfg.Output.RotatePerEpoch,
+		CheckpointFile:        cfg.Output.CheckpointFile,
+		PollingInterval:       time.Duration(cfg.PollingIntervalMs) * time.Millisecond,
+	})
+}
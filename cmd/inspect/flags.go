@@ -0,0 +1,51 @@
+package main
+
+import (
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/urfave/cli"
+)
+
+var (
+	addressEncoding = cli.StringFlag{
+		Name:  "address-encoding",
+		Usage: "This flag specifies the `encoding` of the addresses in the inspected hyperblocks, as configured in the proxy which encoded them. It can be bech32 or pubkey.",
+		Value: schema.AddressEncodingBech32,
+	}
+	wireFormat = cli.BoolFlag{
+		Name:  "wire-format",
+		Usage: "Boolean option for inspecting hyperblocks encoded in the schema registry wire format. If set, the 5 bytes wire format header is removed before decoding.",
+	}
+	sender = cli.StringFlag{
+		Name:  "sender",
+		Usage: "This flag specifies the bech32 sender `address` of the printed transactions. If set, only transactions sent from this address are printed.",
+		Value: "",
+	}
+	receiver = cli.StringFlag{
+		Name:  "receiver",
+		Usage: "This flag specifies the bech32 receiver `address` of the printed transactions. If set, only transactions sent to this address are printed.",
+		Value: "",
+	}
+	requestTimeOutSec = cli.Uint64Flag{
+		Name:  "request-timeout-sec",
+		Usage: "This flag specifies the timeout, in `seconds`, of the request fetching a hyperblock from a proxy url.",
+		Value: 30,
+	}
+)
+
+func getFlags() []cli.Flag {
+	return []cli.Flag{
+		addressEncoding,
+		wireFormat,
+		sender,
+		receiver,
+		requestTimeOutSec,
+	}
+}
+
+func getDiffFlags() []cli.Flag {
+	return []cli.Flag{
+		addressEncoding,
+		wireFormat,
+		requestTimeOutSec,
+	}
+}
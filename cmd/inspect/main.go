@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/inspector"
+	"github.com/multiversx/mx-chain-covalent-go/jsonConverter"
+	"github.com/multiversx/mx-chain-covalent-go/process/factory"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/urfave/cli"
+)
+
+const stdinSource = "-"
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "Covalent hyperblocks inspector tool"
+	app.Usage = "This tool decodes avro encoded hyperblocks and prints them as human readable json. Hyperblocks are read from a file, from stdin(-) or from a proxy url"
+	app.ArgsUsage = "<file | - | url>"
+	app.Flags = getFlags()
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Multiversx Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:      "diff",
+			Usage:     "Prints the differences between two hyperblocks and fails if they differ",
+			ArgsUsage: "<file | - | url> <file | - | url>",
+			Flags:     getDiffFlags(),
+			Action:    diffHyperBlocks,
+		},
+	}
+
+	app.Action = inspectHyperBlock
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+		return
+	}
+}
+
+func inspectHyperBlock(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("expected one hyperblock source, got %d", ctx.NArg())
+	}
+
+	hyperBlockInspector, err := createInspector(ctx.String(addressEncoding.Name))
+	if err != nil {
+		return err
+	}
+
+	encodedHyperBlock, err := readEncodedHyperBlock(ctx, ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	inspectedHyperBlock, err := hyperBlockInspector.Inspect(encodedHyperBlock, config.TransactionsFilter{
+		Senders:   getFilterValues(ctx.String(sender.Name)),
+		Receivers: getFilterValues(ctx.String(receiver.Name)),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(inspectedHyperBlock))
+	return nil
+}
+
+// getFilterValues returns the accepted values of a transactions filter criterion; an empty flag matches any value
+func getFilterValues(flagValue string) []string {
+	if len(flagValue) == 0 {
+		return nil
+	}
+
+	return []string{flagValue}
+}
+
+func diffHyperBlocks(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return fmt.Errorf("expected two hyperblock sources, got %d", ctx.NArg())
+	}
+
+	hyperBlockInspector, err := createInspector(ctx.String(addressEncoding.Name))
+	if err != nil {
+		return err
+	}
+
+	firstEncodedHyperBlock, err := readEncodedHyperBlock(ctx, ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	secondEncodedHyperBlock, err := readEncodedHyperBlock(ctx, ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	differences, err := hyperBlockInspector.Diff(firstEncodedHyperBlock, secondEncodedHyperBlock)
+	if err != nil {
+		return err
+	}
+
+	for _, difference := range differences {
+		fmt.Println(difference)
+	}
+	if len(differences) != 0 {
+		return fmt.Errorf("hyperblocks differ in %d field(s)", len(differences))
+	}
+
+	return nil
+}
+
+func createInspector(addressEncoding string) (inspector.HyperBlockInspector, error) {
+	hyperBlockSchemaDefinition, err := schema.GetHyperBlockSchemaDefinition(addressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	avroMarshaller, err := utility.NewAvroMarshallerWithSchema(hyperBlockSchemaDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	addressConverter, err := factory.CreateAddressConverter(addressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlockJsonConverter, err := jsonConverter.NewHyperBlockJsonConverter(jsonConverter.ArgsHyperBlockJsonConverter{
+		SchemaDefinition: hyperBlockSchemaDefinition,
+		AvroDecoder:      avroMarshaller,
+		AddressConverter: addressConverter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return inspector.NewHyperBlockInspector(inspector.ArgsHyperBlockInspector{
+		AvroMarshaller:   avroMarshaller,
+		JsonConverter:    hyperBlockJsonConverter,
+		AddressConverter: addressConverter,
+	})
+}
+
+func readEncodedHyperBlock(ctx *cli.Context, source string) ([]byte, error) {
+	payload, err := readSource(source, ctx.Uint64(requestTimeOutSec.Name))
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", source, err)
+	}
+
+	return inspector.GetEncodedHyperBlock(payload, ctx.Bool(wireFormat.Name))
+}
+
+func readSource(source string, requestTimeOutSec uint64) ([]byte, error) {
+	if source == stdinSource {
+		return ioutil.ReadAll(os.Stdin)
+	}
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return ioutil.ReadFile(source)
+	}
+
+	resp, err := api.NewDefaultHttpClient(requestTimeOutSec).Get(context.Background(), source)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(resp.Body.Close())
+	}()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d, response: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
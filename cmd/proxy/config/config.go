@@ -6,23 +6,119 @@ import (
 	"github.com/pelletier/go-toml"
 )
 
-// Config holds the config for covalent proxy
+// Config holds the config for covalent proxy. MultiversxProxyUrl is deprecated in favor of Upstreams
 type Config struct {
-	Port                   uint32                 `toml:"port"`
-	HyperBlockPath         string                 `toml:"hyperBlockPath"`
-	HyperBlocksPath        string                 `toml:"hyperBlocksPath"`
-	HyperBlocksBatchSize   uint32                 `toml:"hyperBlocksBatchSize"`
-	MultiversxProxyUrl     string                 `toml:"multiversxProxyUrl"`
-	RequestTimeOutSec      uint64                 `toml:"requestTimeOutSec"`
-	HyperBlockQueryOptions HyperBlockQueryOptions `toml:"hyperBlockQueryOptions"`
+	Port                    uint32                 `toml:"port"`
+	HyperBlockPath          string                 `toml:"hyperBlockPath"`
+	HyperBlocksPath         string                 `toml:"hyperBlocksPath"`
+	TransactionPath         string                 `toml:"transactionPath"`
+	AccountsPath            string                 `toml:"accountsPath"`
+	HyperBlocksBatchSize    uint32                 `toml:"hyperBlocksBatchSize"`
+	RequestTimeOutSec       uint64                 `toml:"requestTimeOutSec"`
+	StreamPollingIntervalMs uint64                 `toml:"streamPollingIntervalMs"`
+	StreamAllowedOrigins    []string               `toml:"streamAllowedOrigins"`
+	AddressEncoding         string                 `toml:"addressEncoding"`
+	HyperBlockQueryOptions  HyperBlockQueryOptions `toml:"hyperBlockQueryOptions"`
+	QueryOptionsOverrides   []string               `toml:"queryOptionsOverrides"`
+	HyperBlocksCache        HyperBlocksCache       `toml:"hyperBlocksCache"`
+	EpochsIndex             EpochsIndex            `toml:"epochsIndex"`
+	Metrics                 Metrics                `toml:"metrics"`
+	Upstreams               []Upstream             `toml:"upstreams"`
+	MultiversxProxyUrl      string                 `toml:"multiversxProxyUrl"`
+	UpstreamsHealthCheck    UpstreamsHealthCheck   `toml:"upstreamsHealthCheck"`
+	RetryPolicy             RetryPolicy            `toml:"retryPolicy"`
+	ChainValidation         ChainValidation        `toml:"chainValidation"`
+	Reorgs                  Reorgs                 `toml:"reorgs"`
+	SchemaRegistry          SchemaRegistry         `toml:"schemaRegistry"`
 }
 
-// HyperBlockQueryOptions holds the hyper block query params options
+// SchemaRegistry holds the config for encoding hyper blocks in the confluent schema registry wire format
+type SchemaRegistry struct {
+	Enabled           bool   `toml:"enabled"`
+	Url               string `toml:"url"`
+	Subject           string `toml:"subject"`
+	AutoRegister      bool   `toml:"autoRegister"`
+	RequestTimeOutSec uint64 `toml:"requestTimeOutSec"`
+}
+
+// Reorgs holds the config for detecting reorgs of served hyper blocks, which were not final yet
+type Reorgs struct {
+	Enabled          bool   `toml:"enabled"`
+	Path             string `toml:"path"`
+	VerifyIntervalMs uint64 `toml:"verifyIntervalMs"`
+	MaxTrackedNonces uint32 `toml:"maxTrackedNonces"`
+	MaxEvents        uint32 `toml:"maxEvents"`
+}
+
+// ChainValidation holds the config for validating the consistency of processed hyper blocks
+type ChainValidation struct {
+	Enabled         bool `toml:"enabled"`
+	FailOnViolation bool `toml:"failOnViolation"`
+}
+
+// RetryPolicy holds the config for retrying failed hyper block requests
+type RetryPolicy struct {
+	MaxAttempts   uint32 `toml:"maxAttempts"`
+	BaseDelayMs   uint64 `toml:"baseDelayMs"`
+	MaxDelayMs    uint64 `toml:"maxDelayMs"`
+	TotalBudgetMs uint64 `toml:"totalBudgetMs"`
+}
+
+// Upstream holds the config for an upstream Multiversx proxy used to fetch hyper blocks
+type Upstream struct {
+	Url    string `toml:"url"`
+	Weight uint32 `toml:"weight"`
+}
+
+// UpstreamsHealthCheck holds the config for detecting unhealthy upstream Multiversx proxies
+type UpstreamsHealthCheck struct {
+	ProbeIntervalMs     uint64  `toml:"probeIntervalMs"`
+	ProbePath           string  `toml:"probePath"`
+	ErrorRateWindowSize uint32  `toml:"errorRateWindowSize"`
+	MinNumRequests      uint32  `toml:"minNumRequests"`
+	MaxErrorRate        float64 `toml:"maxErrorRate"`
+}
+
+// Metrics holds the config for the prometheus metrics endpoint
+type Metrics struct {
+	Enabled bool   `toml:"enabled"`
+	Path    string `toml:"path"`
+}
+
+// HyperBlocksCache holds the config for the local persistent cache of processed hyper blocks
+type HyperBlocksCache struct {
+	Enabled           bool   `toml:"enabled"`
+	Path              string `toml:"path"`
+	MaxNumHyperBlocks uint64 `toml:"maxNumHyperBlocks"`
+	MaxSizeInMB       uint64 `toml:"maxSizeInMB"`
+}
+
+// EpochsIndex holds the config for the local persistent index of resolved epoch start nonces
+type EpochsIndex struct {
+	Enabled bool   `toml:"enabled"`
+	Path    string `toml:"path"`
+}
+
+// HyperBlockQueryOptions holds the hyper block query params options. FinalOnly and TransactionsFilter are not sent to
+// Multiversx proxy: the former restricts the served hyper blocks to the final ones, the latter prunes their transactions
 type HyperBlockQueryOptions struct {
-	WithLogs            bool   `toml:"withLogs"`
-	WithAlteredAccounts bool   `toml:"withAlteredAccounts"`
-	NotarizedAtSource   bool   `toml:"notarizedAtSource"`
-	Tokens              string `toml:"tokens"`
+	WithLogs            bool               `toml:"withLogs"`
+	WithAlteredAccounts bool               `toml:"withAlteredAccounts"`
+	NotarizedAtSource   bool               `toml:"notarizedAtSource"`
+	Tokens              string             `toml:"tokens"`
+	FinalOnly           bool               `toml:"finalOnly"`
+	TransactionsFilter  TransactionsFilter `toml:"transactionsFilter"`
+}
+
+// TransactionsFilter holds the accepted values of each transaction field used to filter the transactions of served
+// hyper blocks. A transaction is kept if it matches all non empty criteria
+type TransactionsFilter struct {
+	Senders        []string `toml:"senders"`
+	Receivers      []string `toml:"receivers"`
+	Functions      []string `toml:"functions"`
+	Tokens         []string `toml:"tokens"`
+	Statuses       []string `toml:"statuses"`
+	MiniBlockTypes []string `toml:"miniBlockTypes"`
 }
 
 // HyperBlocksQueryOptions holds the hyper blocks query params options
@@ -44,5 +140,23 @@ func LoadConfig(tomlFile string) (*Config, error) {
 		return nil, err
 	}
 
+	err = applyLegacyUpstream(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	return &cfg, nil
 }
+
+// applyLegacyUpstream keeps accepting the deprecated multiversxProxyUrl, as a single upstream
+func applyLegacyUpstream(cfg *Config) error {
+	if len(cfg.MultiversxProxyUrl) == 0 {
+		return nil
+	}
+	if len(cfg.Upstreams) != 0 {
+		return errLegacyProxyUrlWithUpstreams
+	}
+
+	cfg.Upstreams = []Upstream{{Url: cfg.MultiversxProxyUrl, Weight: 1}}
+	return nil
+}
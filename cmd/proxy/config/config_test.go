@@ -0,0 +1,66 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	tomlFile := filepath.Join(t.TempDir(), "config.toml")
+	err := ioutil.WriteFile(tomlFile, []byte(content), 0644)
+	require.Nil(t, err)
+
+	return tomlFile
+}
+
+func TestLoadConfig_Upstreams(t *testing.T) {
+	t.Parallel()
+
+	t.Run("upstreams", func(t *testing.T) {
+		t.Parallel()
+
+		tomlFile := writeConfigFile(t, `
+[[upstreams]]
+    url = "https://gateway1.multiversx.com"
+    weight = 2
+[[upstreams]]
+    url = "https://gateway2.multiversx.com"
+    weight = 1
+`)
+
+		cfg, err := LoadConfig(tomlFile)
+		require.Nil(t, err)
+		require.Equal(t, []Upstream{
+			{Url: "https://gateway1.multiversx.com", Weight: 2},
+			{Url: "https://gateway2.multiversx.com", Weight: 1},
+		}, cfg.Upstreams)
+	})
+
+	t.Run("legacy multiversxProxyUrl is used as a single upstream", func(t *testing.T) {
+		t.Parallel()
+
+		tomlFile := writeConfigFile(t, `multiversxProxyUrl = "https://gateway.multiversx.com"`)
+
+		cfg, err := LoadConfig(tomlFile)
+		require.Nil(t, err)
+		require.Equal(t, []Upstream{{Url: "https://gateway.multiversx.com", Weight: 1}}, cfg.Upstreams)
+	})
+
+	t.Run("legacy multiversxProxyUrl along with upstreams, should error", func(t *testing.T) {
+		t.Parallel()
+
+		tomlFile := writeConfigFile(t, `
+multiversxProxyUrl = "https://gateway.multiversx.com"
+[[upstreams]]
+    url = "https://gateway1.multiversx.com"
+    weight = 1
+`)
+
+		cfg, err := LoadConfig(tomlFile)
+		require.Nil(t, cfg)
+		require.Equal(t, errLegacyProxyUrlWithUpstreams, err)
+	})
+}
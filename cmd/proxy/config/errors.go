@@ -0,0 +1,5 @@
+package config
+
+import "errors"
+
+var errLegacyProxyUrlWithUpstreams = errors.New("multiversxProxyUrl is deprecated and cannot be used along with [[upstreams]], move its url to an [[upstreams]] entry")
@@ -10,10 +10,17 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cache"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/common"
 	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
 	"github.com/multiversx/mx-chain-covalent-go/facade"
+	"github.com/multiversx/mx-chain-covalent-go/jsonConverter"
+	"github.com/multiversx/mx-chain-covalent-go/metrics"
 	"github.com/multiversx/mx-chain-covalent-go/process/factory"
 	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/reorgs"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/upstreams"
 	"github.com/urfave/cli"
 )
 
@@ -22,6 +29,29 @@ const (
 	tomlFile      = "./config.toml"
 )
 
+type hyperBlocksCacheCloser interface {
+	facade.HyperBlocksCache
+	Close() error
+}
+
+type epochsIndexCloser interface {
+	facade.EpochsIndex
+	Close() error
+}
+
+type reorgsTrackerCloser interface {
+	facade.ReorgsTracker
+	api.ReorgsHandler
+	Close() error
+}
+
+type metricsHandler interface {
+	facade.MetricsHandler
+	api.UpstreamsMetricsHandler
+	metrics.RequestsMetricsHandler
+	Handler() http.Handler
+}
+
 func main() {
 	app := cli.NewApp()
 	app.Name = "Covalent proxy indexer tool"
@@ -45,7 +75,7 @@ func main() {
 
 func startProxy(ctx *cli.Context) error {
 	flagsConfig := getFlagsLogConfig(ctx)
-	errLogger := attachFileLogger(log, logFilePrefix, flagsConfig)
+	errLogger := common.AttachFileLogger(log, logFilePrefix, flagsConfig)
 	if errLogger != nil {
 		return errLogger
 	}
@@ -56,8 +86,81 @@ func startProxy(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if len(cfg.MultiversxProxyUrl) != 0 {
+		log.Warn("multiversxProxyUrl is deprecated, use [[upstreams]] instead", "url", cfg.MultiversxProxyUrl)
+	}
+
+	hyperBlockSchemaDefinition, err := schema.GetHyperBlockSchemaDefinition(cfg.AddressEncoding)
+	if err != nil {
+		return err
+	}
+
+	avroEncoder, err := common.CreateAvroMarshaller(cfg.SchemaRegistry, hyperBlockSchemaDefinition)
+	if err != nil {
+		return err
+	}
+
+	hyperBlocksCache, err := createHyperBlocksCache(cfg, hyperBlockSchemaDefinition, avroEncoder)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(hyperBlocksCache.Close())
+	}()
 
-	server, err := createServer(cfg)
+	epochsIndex, err := createEpochsIndex(cfg.EpochsIndex)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(epochsIndex.Close())
+	}()
+
+	metricsHandler, err := createMetricsHandler(cfg.Metrics)
+	if err != nil {
+		return err
+	}
+
+	httpClient := api.NewDefaultHttpClient(cfg.RequestTimeOutSec)
+	upstreamsHandler, err := upstreams.NewUpstreamsHandler(upstreams.ArgsUpstreamsHandler{
+		Upstreams:   cfg.Upstreams,
+		HealthCheck: cfg.UpstreamsHealthCheck,
+		HttpClient:  httpClient,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(upstreamsHandler.Close())
+	}()
+
+	multiversxHyperBlockEndpointHandler, err := api.NewMultiversxHyperBlockEndPoint(api.ArgsMultiversxHyperBlockEndPoint{
+		HttpClient: httpClient,
+		Upstreams:  upstreamsHandler,
+		Metrics:    metricsHandler,
+	})
+	if err != nil {
+		return err
+	}
+
+	reorgsTracker, err := createReorgsTracker(cfg.Reorgs, multiversxHyperBlockEndpointHandler)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(reorgsTracker.Close())
+	}()
+
+	server, err := createServer(
+		cfg,
+		hyperBlockSchemaDefinition,
+		avroEncoder,
+		multiversxHyperBlockEndpointHandler,
+		hyperBlocksCache,
+		epochsIndex,
+		reorgsTracker,
+		metricsHandler,
+	)
 	if err != nil {
 		return err
 	}
@@ -71,38 +174,152 @@ func startProxy(ctx *cli.Context) error {
 	return nil
 }
 
-func createServer(cfg *config.Config) (api.HTTPServer, error) {
-	httpClient := api.NewDefaultHttpClient(cfg.RequestTimeOutSec)
-	multiversxHyperBlockEndpointHandler, err := api.NewMultiversxHyperBlockEndPoint(httpClient)
+func createHyperBlocksCache(
+	cfg *config.Config,
+	hyperBlockSchemaDefinition string,
+	avroEncoder *utility.AvroMarshaller,
+) (hyperBlocksCacheCloser, error) {
+	if !cfg.HyperBlocksCache.Enabled {
+		return cache.NewDisabledHyperBlocksCache(), nil
+	}
+
+	schemaId, usesWireFormat := avroEncoder.SchemaId()
+	return cache.NewHyperBlocksCache(cache.HyperBlocksCacheArgs{
+		Path:              cfg.HyperBlocksCache.Path,
+		MaxNumHyperBlocks: cfg.HyperBlocksCache.MaxNumHyperBlocks,
+		MaxSizeInMB:       cfg.HyperBlocksCache.MaxSizeInMB,
+		AddressEncoding:   cfg.AddressEncoding,
+		SchemaDefinition:  hyperBlockSchemaDefinition,
+		SchemaId:          schemaId,
+		UsesWireFormat:    usesWireFormat,
+	})
+}
+
+func createEpochsIndex(cfg config.EpochsIndex) (epochsIndexCloser, error) {
+	if !cfg.Enabled {
+		return cache.NewDisabledEpochsIndex(), nil
+	}
+
+	return cache.NewEpochsIndex(cfg.Path)
+}
+
+func createMetricsHandler(cfg config.Metrics) (metricsHandler, error) {
+	if !cfg.Enabled {
+		return metrics.NewDisabledMetrics(), nil
+	}
+
+	return metrics.NewPrometheusMetrics()
+}
+
+func createReorgsTracker(cfg config.Reorgs, multiversxHyperBlockEndpointHandler api.MultiversxHyperBlockEndpointHandler) (reorgsTrackerCloser, error) {
+	if !cfg.Enabled {
+		return reorgs.NewDisabledReorgsTracker(), nil
+	}
+
+	return reorgs.NewReorgsTracker(reorgs.ArgsReorgsTracker{
+		MultiversxEndpoint: multiversxHyperBlockEndpointHandler,
+		VerifyIntervalMs:   cfg.VerifyIntervalMs,
+		MaxTrackedNonces:   cfg.MaxTrackedNonces,
+		MaxEvents:          cfg.MaxEvents,
+	})
+}
+
+func createServer(
+	cfg *config.Config,
+	hyperBlockSchemaDefinition string,
+	avroEncoder *utility.AvroMarshaller,
+	multiversxHyperBlockEndpointHandler api.MultiversxHyperBlockEndpointHandler,
+	hyperBlocksCache facade.HyperBlocksCache,
+	epochsIndex facade.EpochsIndex,
+	reorgsTracker reorgsTrackerCloser,
+	metricsHandler metricsHandler,
+) (api.HTTPServer, error) {
+	hyperBlockProcessor, err := factory.CreateHyperBlockProcessor(cfg.AddressEncoding)
 	if err != nil {
 		return nil, err
 	}
 
-	hyperBlockProcessor, err := factory.CreateHyperBlockProcessor()
+	addressConverter, err := factory.CreateAddressConverter(cfg.AddressEncoding)
 	if err != nil {
 		return nil, err
 	}
 
-	avroEncoder := &utility.AvroMarshaller{}
-	hyperBlockFacade, err := facade.NewHyperBlockFacade(
-		cfg.MultiversxProxyUrl,
-		avroEncoder,
-		multiversxHyperBlockEndpointHandler,
-		hyperBlockProcessor,
-	)
+	balanceUpdatesEncoder, err := factory.CreateBalanceUpdatesEncoder(cfg.AddressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlockFacade, err := facade.NewHyperBlockFacade(&facade.HyperBlockFacadeArgs{
+		AvroEncoder:                  avroEncoder,
+		MultiversxHyperBlockEndpoint: multiversxHyperBlockEndpointHandler,
+		HyperBlockProcessor:          hyperBlockProcessor,
+		HyperBlocksCache:             hyperBlocksCache,
+		HyperBlockSchemaDefinition:   hyperBlockSchemaDefinition,
+		Metrics:                      metricsHandler,
+		RetryPolicy:                  cfg.RetryPolicy,
+		ChainValidator:               common.CreateChainValidator(cfg.ChainValidation),
+		ReorgsTracker:                reorgsTracker,
+		AddressConverter:             addressConverter,
+		EpochsIndex:                  epochsIndex,
+		BalanceUpdatesEncoder:        balanceUpdatesEncoder,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlockJsonConverter, err := jsonConverter.NewHyperBlockJsonConverter(jsonConverter.ArgsHyperBlockJsonConverter{
+		SchemaDefinition: hyperBlockSchemaDefinition,
+		AvroDecoder:      avroEncoder,
+		AddressConverter: addressConverter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlockProxy, err := api.NewHyperBlockProxy(hyperBlockFacade, hyperBlockJsonConverter, *cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlockStreamProxy, err := api.NewHyperBlockStreamProxy(hyperBlockFacade, hyperBlockJsonConverter, *cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	hyperBlockProxy, err := api.NewHyperBlockProxy(hyperBlockFacade, *cfg)
+	transactionProxy, err := createTransactionProxy(cfg, multiversxHyperBlockEndpointHandler, metricsHandler)
 	if err != nil {
 		return nil, err
 	}
 
 	router := gin.Default()
+	if cfg.Metrics.Enabled {
+		requestsMiddleware, errMiddleware := metrics.NewRequestsMiddleware(metricsHandler)
+		if errMiddleware != nil {
+			return nil, errMiddleware
+		}
+
+		router.Use(requestsMiddleware)
+		router.GET(cfg.Metrics.Path, gin.WrapH(metricsHandler.Handler()))
+	}
+
 	router.GET(fmt.Sprintf("%s", cfg.HyperBlocksPath), hyperBlockProxy.GetHyperBlocksByInterval)
 	router.GET(fmt.Sprintf("%s/by-nonce/:nonce", cfg.HyperBlockPath), hyperBlockProxy.GetHyperBlockByNonce)
 	router.GET(fmt.Sprintf("%s/by-hash/:hash", cfg.HyperBlockPath), hyperBlockProxy.GetHyperBlockByHash)
+	router.GET(fmt.Sprintf("%s/by-timestamp/:timestamp", cfg.HyperBlockPath), hyperBlockProxy.GetHyperBlockByTimestamp)
+	router.GET(fmt.Sprintf("%s/by-epoch/:epoch", cfg.HyperBlocksPath), hyperBlockProxy.GetEpochNoncesInterval)
+	router.GET(fmt.Sprintf("%s/by-epoch/:epoch/stream", cfg.HyperBlocksPath), hyperBlockStreamProxy.StreamEpochHyperBlocks)
+	router.GET(fmt.Sprintf("%s/stream", cfg.HyperBlocksPath), hyperBlockStreamProxy.StreamHyperBlocks)
+	router.GET(fmt.Sprintf("%s/:hash", cfg.TransactionPath), transactionProxy.GetTransactionByHash)
+	router.GET(fmt.Sprintf("%s/:address/balance-updates", cfg.AccountsPath), hyperBlockProxy.GetAccountBalanceUpdates)
+
+	if cfg.Reorgs.Enabled {
+		reorgsProxy, errReorgs := api.NewReorgsProxy(reorgsTracker)
+		if errReorgs != nil {
+			return nil, errReorgs
+		}
+
+		router.GET(cfg.Reorgs.Path, reorgsProxy.GetReorgs)
+	}
 
 	return &http.Server{
 		Handler: router,
@@ -110,8 +327,43 @@ func createServer(cfg *config.Config) (api.HTTPServer, error) {
 	}, nil
 }
 
+func createTransactionProxy(
+	cfg *config.Config,
+	multiversxHyperBlockEndpointHandler api.MultiversxHyperBlockEndpointHandler,
+	metricsHandler metricsHandler,
+) (api.TransactionProxy, error) {
+	transactionProcessor, err := factory.CreateTransactionProcessor(cfg.AddressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionSchemaDefinition, err := schema.GetTransactionSchemaDefinition(cfg.AddressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	// Single transactions are plain avro encoded, since only the hyper block schema is registered
+	transactionEncoder, err := utility.NewAvroMarshallerWithSchema(transactionSchemaDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionFacade, err := facade.NewTransactionFacade(facade.TransactionFacadeArgs{
+		AvroEncoder:                  transactionEncoder,
+		MultiversxHyperBlockEndpoint: multiversxHyperBlockEndpointHandler,
+		TransactionProcessor:         transactionProcessor,
+		Metrics:                      metricsHandler,
+		RetryPolicy:                  cfg.RetryPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return api.NewTransactionProxy(transactionFacade)
+}
+
 func waitForServerShutdown(httpServer api.HTTPServer) {
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, os.Kill)
 	<-quit
 
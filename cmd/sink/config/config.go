@@ -0,0 +1,67 @@
+package config
+
+import (
+	"io/ioutil"
+
+	proxyConfig "github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/pelletier/go-toml"
+)
+
+// Config holds the config for hyper blocks kafka sink
+type Config struct {
+	RequestTimeOutSec      uint64                             `toml:"requestTimeOutSec"`
+	HyperBlocksBatchSize   uint32                             `toml:"hyperBlocksBatchSize"`
+	PollingIntervalMs      uint64                             `toml:"pollingIntervalMs"`
+	AddressEncoding        string                             `toml:"addressEncoding"`
+	CheckpointFile         string                             `toml:"checkpointFile"`
+	HyperBlockQueryOptions proxyConfig.HyperBlockQueryOptions `toml:"hyperBlockQueryOptions"`
+	Kafka                  KafkaConfig                        `toml:"kafka"`
+	Upstreams              []proxyConfig.Upstream             `toml:"upstreams"`
+	UpstreamsHealthCheck   proxyConfig.UpstreamsHealthCheck   `toml:"upstreamsHealthCheck"`
+	RetryPolicy            proxyConfig.RetryPolicy            `toml:"retryPolicy"`
+	ChainValidation        proxyConfig.ChainValidation        `toml:"chainValidation"`
+	SchemaRegistry         proxyConfig.SchemaRegistry         `toml:"schemaRegistry"`
+}
+
+// KafkaConfig holds the config for the kafka topic where hyper blocks are published
+type KafkaConfig struct {
+	Brokers          []string        `toml:"brokers"`
+	ClientId         string          `toml:"clientId"`
+	Topic            string          `toml:"topic"`
+	SchemaId         uint32          `toml:"schemaId"`
+	RequestTimeoutMs uint64          `toml:"requestTimeoutMs"`
+	MaxBatchBytes    uint64          `toml:"maxBatchBytes"`
+	Compression      string          `toml:"compression"`
+	Tls              KafkaTlsConfig  `toml:"tls"`
+	Sasl             KafkaSaslConfig `toml:"sasl"`
+}
+
+// KafkaTlsConfig holds the config for TLS connections to the kafka brokers
+type KafkaTlsConfig struct {
+	Enabled            bool   `toml:"enabled"`
+	CaFile             string `toml:"caFile"`
+	InsecureSkipVerify bool   `toml:"insecureSkipVerify"`
+}
+
+// KafkaSaslConfig holds the config for SASL authentication to the kafka brokers
+type KafkaSaslConfig struct {
+	Mechanism string `toml:"mechanism"`
+	Username  string `toml:"username"`
+	Password  string `toml:"password"`
+}
+
+// LoadConfig will load the Config from the provided file
+func LoadConfig(tomlFile string) (*Config, error) {
+	tomlBytes, err := ioutil.ReadFile(tomlFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	err = toml.Unmarshal(tomlBytes, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cache"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/common"
+	proxyConfig "github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/sink/config"
+	"github.com/multiversx/mx-chain-covalent-go/facade"
+	"github.com/multiversx/mx-chain-covalent-go/metrics"
+	"github.com/multiversx/mx-chain-covalent-go/process/factory"
+	"github.com/multiversx/mx-chain-covalent-go/reorgs"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/sink"
+	"github.com/multiversx/mx-chain-covalent-go/sink/kafka"
+	"github.com/multiversx/mx-chain-covalent-go/upstreams"
+	"github.com/urfave/cli"
+)
+
+const logFilePrefix = "covalent-sink"
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "Covalent hyperblocks kafka sink tool"
+	app.Usage = "This tool publishes hyperblocks fetched from Multiversx, converted in covalent format, to a kafka topic, keyed by nonce. It keeps a checkpoint of the published hyperblocks and keeps tailing new ones"
+	app.Flags = getFlags()
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Multiversx Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	app.Action = startSink
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+		return
+	}
+}
+
+func startSink(ctx *cli.Context) error {
+	flagsConfig := getFlagsLogConfig(ctx)
+	errLogger := common.AttachFileLogger(log, logFilePrefix, flagsConfig)
+	if errLogger != nil {
+		return errLogger
+	}
+
+	cfg, err := config.LoadConfig(ctx.GlobalString(configFile.Name))
+	if err != nil {
+		return err
+	}
+
+	httpClient := api.NewDefaultHttpClient(cfg.RequestTimeOutSec)
+	upstreamsHandler, err := upstreams.NewUpstreamsHandler(upstreams.ArgsUpstreamsHandler{
+		Upstreams:   cfg.Upstreams,
+		HealthCheck: cfg.UpstreamsHealthCheck,
+		HttpClient:  httpClient,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(upstreamsHandler.Close())
+	}()
+
+	producer, err := kafka.NewProducer(kafka.ArgsProducer{
+		Brokers:               cfg.Kafka.Brokers,
+		ClientId:              cfg.Kafka.ClientId,
+		RequestTimeoutMs:      cfg.Kafka.RequestTimeoutMs,
+		MaxBatchBytes:         cfg.Kafka.MaxBatchBytes,
+		Compression:           cfg.Kafka.Compression,
+		TlsEnabled:            cfg.Kafka.Tls.Enabled,
+		TlsCaFile:             cfg.Kafka.Tls.CaFile,
+		TlsInsecureSkipVerify: cfg.Kafka.Tls.InsecureSkipVerify,
+		SaslMechanism:         cfg.Kafka.Sasl.Mechanism,
+		SaslUsername:          cfg.Kafka.Sasl.Username,
+		SaslPassword:          cfg.Kafka.Sasl.Password,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(producer.Close())
+	}()
+
+	hyperBlocksSink, err := createSink(cfg, httpClient, upstreamsHandler, producer)
+	if err != nil {
+		return err
+	}
+
+	sinkContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, os.Interrupt, os.Kill)
+		<-quit
+
+		log.Info("stopping sink")
+		cancel()
+	}()
+
+	log.Info("starting sink", "topic", cfg.Kafka.Topic)
+	return hyperBlocksSink.Run(sinkContext, ctx.GlobalUint64(startNonce.Name))
+}
+
+func createSink(
+	cfg *config.Config,
+	httpClient api.HTTPClient,
+	upstreamsHandler api.UpstreamsHandler,
+	producer sink.MessageProducer,
+) (sink.HyperBlocksSink, error) {
+	multiversxHyperBlockEndpointHandler, err := api.NewMultiversxHyperBlockEndPoint(api.ArgsMultiversxHyperBlockEndPoint{
+		HttpClient: httpClient,
+		Upstreams:  upstreamsHandler,
+		Metrics:    metrics.NewDisabledMetrics(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlockProcessor, err := factory.CreateHyperBlockProcessor(cfg.AddressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	addressConverter, err := factory.CreateAddressConverter(cfg.AddressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlockSchemaDefinition, err := schema.GetHyperBlockSchemaDefinition(cfg.AddressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	avroMarshaller, err := common.CreateAvroMarshaller(cfg.SchemaRegistry, hyperBlockSchemaDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	schemaId, isWireFormat := avroMarshaller.SchemaId()
+	if !isWireFormat {
+		schemaId = cfg.Kafka.SchemaId
+	}
+
+	balanceUpdatesEncoder, err := factory.CreateBalanceUpdatesEncoder(cfg.AddressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperBlockFacade, err := facade.NewHyperBlockFacade(&facade.HyperBlockFacadeArgs{
+		AvroEncoder:                  avroMarshaller,
+		MultiversxHyperBlockEndpoint: multiversxHyperBlockEndpointHandler,
+		HyperBlockProcessor:          hyperBlockProcessor,
+		HyperBlocksCache:             cache.NewDisabledHyperBlocksCache(),
+		HyperBlockSchemaDefinition:   hyperBlockSchemaDefinition,
+		Metrics:                      metrics.NewDisabledMetrics(),
+		RetryPolicy:                  cfg.RetryPolicy,
+		ChainValidator:               common.CreateChainValidator(cfg.ChainValidation),
+		ReorgsTracker:                reorgs.NewDisabledReorgsTracker(),
+		AddressConverter:             addressConverter,
+		EpochsIndex:                  cache.NewDisabledEpochsIndex(),
+		BalanceUpdatesEncoder:        balanceUpdatesEncoder,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sink.NewHyperBlocksSink(sink.ArgsHyperBlocksSink{
+		Facade:   hyperBlockFacade,
+		Producer: producer,
+		Topic:    cfg.Kafka.Topic,
+		SchemaId: schemaId,
+		QueryOptions: proxyConfig.HyperBlocksQueryOptions{
+			QueryOptions: cfg.HyperBlockQueryOptions,
+			BatchSize:    cfg.HyperBlocksBatchSize,
+		},
+		CheckpointFile:  cfg.CheckpointFile,
+		PollingInterval: time.Duration(cfg.PollingIntervalMs) * time.Millisecond,
+	})
+}
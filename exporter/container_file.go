@@ -0,0 +1,89 @@
+package exporter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+)
+
+const (
+	containerFileExtension = ".avro"
+	tmpFileSuffix          = ".tmp"
+)
+
+// containerFile is an avro object container file which is being exported. Until finalized, hyper blocks are written in
+// a temporary file, which is renamed afterwards to contain the exported nonces interval.
+type containerFile struct {
+	file       *os.File
+	writer     *utility.AvroContainerWriter
+	tmpPath    string
+	firstNonce uint64
+	lastNonce  uint64
+	epoch      int32
+	numBlocks  uint64
+}
+
+func createContainerFile(marshaller AvroMarshaller, schemaDefinition string, directory string, prefix string, codec string, firstNonce uint64, epoch int32) (*containerFile, error) {
+	tmpPath := filepath.Join(directory, fmt.Sprintf("%s_%020d%s%s", prefix, firstNonce, containerFileExtension, tmpFileSuffix))
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := marshaller.NewContainerWriter(file, schemaDefinition, codec)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return &containerFile{
+		file:       file,
+		writer:     writer,
+		tmpPath:    tmpPath,
+		firstNonce: firstNonce,
+		lastNonce:  firstNonce,
+		epoch:      epoch,
+	}, nil
+}
+
+func (cf *containerFile) append(nonce uint64, encodedHyperBlock []byte) error {
+	err := cf.writer.Append(encodedHyperBlock)
+	if err != nil {
+		return err
+	}
+
+	cf.lastNonce = nonce
+	cf.numBlocks++
+	return nil
+}
+
+func (cf *containerFile) flush() error {
+	err := cf.writer.Flush()
+	if err != nil {
+		return err
+	}
+
+	return cf.file.Sync()
+}
+
+// finalize flushes all buffered hyper blocks, closes the file and renames it, returning its final path
+func (cf *containerFile) finalize(directory string, prefix string) (string, error) {
+	err := cf.flush()
+	if err != nil {
+		return "", err
+	}
+	err = cf.file.Close()
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(directory, fmt.Sprintf("%s_%020d_%020d%s", prefix, cf.firstNonce, cf.lastNonce, containerFileExtension))
+	err = os.Rename(cf.tmpPath, path)
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
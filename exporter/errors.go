@@ -0,0 +1,27 @@
+package exporter
+
+import "errors"
+
+var errNilHyperBlocksFacade = errors.New("nil hyper blocks facade provided")
+
+var errNilAvroMarshaller = errors.New("nil avro marshaller provided")
+
+var errEmptySchemaDefinition = errors.New("empty schema definition provided")
+
+var errEmptyOutputDirectory = errors.New("empty output directory provided")
+
+var errEmptyCheckpointFile = errors.New("empty checkpoint file provided")
+
+var errUnsupportedCodec = errors.New("unsupported codec")
+
+var errInvalidBatchSize = errors.New("invalid batch size")
+
+var errInvalidPollingInterval = errors.New("invalid polling interval")
+
+var errNoRotationPolicy = errors.New("no rotation policy provided; expected max hyper blocks per file or rotation per epoch")
+
+var errInvalidNoncesInterval = errors.New("invalid nonces interval")
+
+var errEndNonceInFollowMode = errors.New("end nonce cannot be set in follow mode")
+
+var errUnexpectedNumHyperBlocks = errors.New("unexpected number of hyper blocks received")
@@ -0,0 +1,314 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/polling"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("exporter")
+
+// ArgsHyperBlocksExporter holds all input dependencies required by hyper blocks exporter
+type ArgsHyperBlocksExporter struct {
+	Facade                HyperBlocksFacade
+	Marshaller            AvroMarshaller
+	SchemaDefinition      string
+	QueryOptions          config.HyperBlocksQueryOptions
+	OutputDirectory       string
+	FilePrefix            string
+	Codec                 string
+	MaxHyperBlocksPerFile uint64
+	RotatePerEpoch        bool
+	CheckpointFile        string
+	PollingInterval       time.Duration
+}
+
+type hyperBlocksExporter struct {
+	facade                HyperBlocksFacade
+	marshaller            AvroMarshaller
+	schemaDefinition      string
+	queryOptions          config.HyperBlocksQueryOptions
+	outputDirectory       string
+	filePrefix            string
+	codec                 string
+	maxHyperBlocksPerFile uint64
+	rotatePerEpoch        bool
+	checkpointFile        string
+	pollingInterval       time.Duration
+	currentFile           *containerFile
+}
+
+// NewHyperBlocksExporter will create an exporter, which writes avro encoded hyper blocks in rotated avro object
+// container files, keeping a checkpoint of the exported nonces
+func NewHyperBlocksExporter(args ArgsHyperBlocksExporter) (*hyperBlocksExporter, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(args.OutputDirectory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hyperBlocksExporter{
+		facade:                args.Facade,
+		marshaller:            args.Marshaller,
+		schemaDefinition:      args.SchemaDefinition,
+		queryOptions:          args.QueryOptions,
+		outputDirectory:       args.OutputDirectory,
+		filePrefix:            args.FilePrefix,
+		codec:                 args.Codec,
+		maxHyperBlocksPerFile: args.MaxHyperBlocksPerFile,
+		rotatePerEpoch:        args.RotatePerEpoch,
+		checkpointFile:        args.CheckpointFile,
+		pollingInterval:       args.PollingInterval,
+	}, nil
+}
+
+func checkArgs(args ArgsHyperBlocksExporter) error {
+	if args.Facade == nil {
+		return errNilHyperBlocksFacade
+	}
+	if args.Marshaller == nil {
+		return errNilAvroMarshaller
+	}
+	if len(args.SchemaDefinition) == 0 {
+		return errEmptySchemaDefinition
+	}
+	if len(args.OutputDirectory) == 0 {
+		return errEmptyOutputDirectory
+	}
+	if len(args.CheckpointFile) == 0 {
+		return errEmptyCheckpointFile
+	}
+	if !utility.IsContainerCodecSupported(args.Codec) {
+		return fmt.Errorf("%w: %s", errUnsupportedCodec, args.Codec)
+	}
+	if args.QueryOptions.BatchSize == 0 {
+		return fmt.Errorf("%w; expected non zero value", errInvalidBatchSize)
+	}
+	if args.PollingInterval == 0 {
+		return fmt.Errorf("%w; expected non zero value", errInvalidPollingInterval)
+	}
+	if args.MaxHyperBlocksPerFile == 0 && !args.RotatePerEpoch {
+		return errNoRotationPolicy
+	}
+
+	return nil
+}
+
+// Export will export all hyper blocks in [startNonce, endNonce] interval. If endNonce is zero, hyper blocks are
+// exported up to the highest final hyper block known by the Multiversx proxy. If a checkpoint exists, the export is
+// resumed from the checkpoint. In follow mode, the export never ends: once the highest final hyper block is exported,
+// it keeps waiting for new ones to become final, until the context is done. Non final hyper blocks are never exported,
+// since exported files are never rewritten if they are reverted.
+func (hbe *hyperBlocksExporter) Export(ctx context.Context, startNonce uint64, endNonce uint64, follow bool) error {
+	if follow && endNonce != 0 {
+		return errEndNonceInFollowMode
+	}
+	if endNonce != 0 && startNonce > endNonce {
+		return fmt.Errorf("%w: start nonce: %d, end nonce: %d", errInvalidNoncesInterval, startNonce, endNonce)
+	}
+
+	nonce, err := polling.GetResumeNonce(hbe.checkpointFile, startNonce)
+	if err != nil {
+		return err
+	}
+
+	err = hbe.exportFromNonce(ctx, nonce, endNonce, follow)
+	if err != nil {
+		return err
+	}
+
+	return hbe.finalizeCurrentFile()
+}
+
+func (hbe *hyperBlocksExporter) exportFromNonce(ctx context.Context, nonce uint64, endNonce uint64, follow bool) error {
+	for {
+		if polling.IsContextDone(ctx) {
+			log.Info("export stopped", "next nonce", nonce)
+			return nil
+		}
+		if endNonce != 0 && nonce > endNonce {
+			return nil
+		}
+
+		highestFinalNonce, err := hbe.facade.GetHighestFinalHyperBlockNonce(ctx)
+		if err != nil {
+			if polling.IsContextDone(ctx) {
+				continue
+			}
+			if !follow {
+				return err
+			}
+
+			log.Warn("could not get highest final hyper block nonce, retrying", "error", err)
+			polling.Wait(ctx, hbe.pollingInterval)
+			continue
+		}
+
+		if nonce > highestFinalNonce {
+			if !follow {
+				if endNonce != 0 {
+					return fmt.Errorf("%w: end nonce: %d is higher than highest final hyper block nonce: %d", errInvalidNoncesInterval, endNonce, highestFinalNonce)
+				}
+				return nil
+			}
+
+			err = hbe.flushCurrentFile()
+			if err != nil {
+				return err
+			}
+
+			polling.Wait(ctx, hbe.pollingInterval)
+			continue
+		}
+
+		batchEndNonce := nonce + uint64(hbe.queryOptions.BatchSize) - 1
+		batchEndNonce = core.MinUint64(batchEndNonce, highestFinalNonce)
+		if endNonce != 0 {
+			batchEndNonce = core.MinUint64(batchEndNonce, endNonce)
+		}
+
+		hyperBlocks, err := hbe.getHyperBlocks(ctx, nonce, batchEndNonce)
+		if err != nil {
+			if polling.IsContextDone(ctx) {
+				continue
+			}
+			if !follow {
+				return err
+			}
+
+			log.Warn("could not get hyper blocks, retrying", "start nonce", nonce, "end nonce", batchEndNonce, "error", err)
+			polling.Wait(ctx, hbe.pollingInterval)
+			continue
+		}
+
+		err = hbe.exportHyperBlocks(nonce, hyperBlocks)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("exported hyper blocks", "start nonce", nonce, "end nonce", batchEndNonce)
+		nonce = batchEndNonce + 1
+	}
+}
+
+func (hbe *hyperBlocksExporter) getHyperBlocks(ctx context.Context, startNonce uint64, endNonce uint64) ([][]byte, error) {
+	hyperBlocks, err := hbe.facade.GetHyperBlocksByInterval(ctx, &api.Interval{
+		Start: startNonce,
+		End:   endNonce,
+	}, hbe.queryOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedNumHyperBlocks := endNonce - startNonce + 1
+	if uint64(len(hyperBlocks.Data)) != expectedNumHyperBlocks {
+		return nil, fmt.Errorf("%w: expected %d, got %d", errUnexpectedNumHyperBlocks, expectedNumHyperBlocks, len(hyperBlocks.Data))
+	}
+
+	return hyperBlocks.Data, nil
+}
+
+func (hbe *hyperBlocksExporter) exportHyperBlocks(startNonce uint64, hyperBlocks [][]byte) error {
+	for idx, encodedHyperBlock := range hyperBlocks {
+		err := hbe.exportHyperBlock(startNonce+uint64(idx), encodedHyperBlock)
+		if err != nil {
+			return err
+		}
+	}
+
+	return hbe.flushCurrentFile()
+}
+
+func (hbe *hyperBlocksExporter) exportHyperBlock(nonce uint64, encodedHyperBlock []byte) error {
+	epoch, err := hbe.getEpoch(encodedHyperBlock)
+	if err != nil {
+		return err
+	}
+
+	if hbe.shouldRotate(epoch) {
+		err = hbe.finalizeCurrentFile()
+		if err != nil {
+			return err
+		}
+	}
+
+	if hbe.currentFile == nil {
+		hbe.currentFile, err = createContainerFile(hbe.marshaller, hbe.schemaDefinition, hbe.outputDirectory, hbe.filePrefix, hbe.codec, nonce, epoch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return hbe.currentFile.append(nonce, encodedHyperBlock)
+}
+
+func (hbe *hyperBlocksExporter) getEpoch(encodedHyperBlock []byte) (int32, error) {
+	if !hbe.rotatePerEpoch {
+		return 0, nil
+	}
+
+	hyperBlock := schema.NewHyperBlock()
+	err := hbe.marshaller.Decode(hyperBlock, encodedHyperBlock)
+	if err != nil {
+		return 0, err
+	}
+
+	return hyperBlock.Epoch, nil
+}
+
+func (hbe *hyperBlocksExporter) shouldRotate(epoch int32) bool {
+	if hbe.currentFile == nil {
+		return false
+	}
+	if hbe.maxHyperBlocksPerFile != 0 && hbe.currentFile.numBlocks >= hbe.maxHyperBlocksPerFile {
+		return true
+	}
+
+	return hbe.rotatePerEpoch && hbe.currentFile.epoch != epoch
+}
+
+func (hbe *hyperBlocksExporter) flushCurrentFile() error {
+	if hbe.currentFile == nil {
+		return nil
+	}
+
+	return hbe.currentFile.flush()
+}
+
+// finalizeCurrentFile closes the current file and saves the checkpoint afterwards. If the exporter crashes in between,
+// the hyper blocks from the last file are exported again, overwriting it.
+func (hbe *hyperBlocksExporter) finalizeCurrentFile() error {
+	if hbe.currentFile == nil {
+		return nil
+	}
+
+	path, err := hbe.currentFile.finalize(hbe.outputDirectory, hbe.filePrefix)
+	if err != nil {
+		return err
+	}
+
+	err = polling.SaveCheckpoint(hbe.checkpointFile, &polling.Checkpoint{
+		NextNonce: hbe.currentFile.lastNonce + 1,
+		LastFile:  path,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("exported file", "path", path, "num hyper blocks", hbe.currentFile.numBlocks)
+	hbe.currentFile = nil
+	return nil
+}
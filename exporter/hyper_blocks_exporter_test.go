@@ -0,0 +1,424 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/elodina/go-avro"
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/polling"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock/apiMocks"
+	"github.com/stretchr/testify/require"
+)
+
+const hyperBlocksPerEpoch = 5
+
+func createMockArgsHyperBlocksExporter(t *testing.T) ArgsHyperBlocksExporter {
+	outputDirectory := t.TempDir()
+	return ArgsHyperBlocksExporter{
+		Facade:           &apiMocks.HyperBlockFacadeStub{},
+		Marshaller:       &utility.AvroMarshaller{},
+		SchemaDefinition: schema.HyperBlockSchemaDefinition,
+		QueryOptions: config.HyperBlocksQueryOptions{
+			BatchSize: 3,
+		},
+		OutputDirectory:       outputDirectory,
+		FilePrefix:            "hyperblocks",
+		Codec:                 utility.CodecDeflate,
+		MaxHyperBlocksPerFile: 4,
+		CheckpointFile:        filepath.Join(outputDirectory, "checkpoint.json"),
+		PollingInterval:       time.Millisecond,
+	}
+}
+
+func encodeHyperBlock(t *testing.T, nonce uint64) []byte {
+	hyperBlock := schema.NewHyperBlock()
+	hyperBlock.Hash = make([]byte, 32)
+	hyperBlock.Nonce = int64(nonce)
+	hyperBlock.Epoch = int32(nonce / hyperBlocksPerEpoch)
+
+	encodedHyperBlock, err := (&utility.AvroMarshaller{}).Encode(hyperBlock)
+	require.Nil(t, err)
+
+	return encodedHyperBlock
+}
+
+func createHyperBlockEncoder(t *testing.T) func(nonce uint64) []byte {
+	return func(nonce uint64) []byte {
+		return encodeHyperBlock(t, nonce)
+	}
+}
+
+// readExportedNonces returns, for each exported file, sorted by name, the nonces of the hyper blocks it holds
+func readExportedNonces(t *testing.T, directory string) map[string][]int64 {
+	paths, err := filepath.Glob(filepath.Join(directory, "*"+containerFileExtension))
+	require.Nil(t, err)
+	sort.Strings(paths)
+
+	exportedNonces := make(map[string][]int64)
+	for _, path := range paths {
+		container, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+
+		records, err := (&utility.AvroMarshaller{}).DecodeContainer(container, func() avro.AvroRecord {
+			return schema.NewHyperBlock()
+		})
+		require.Nil(t, err)
+
+		nonces := make([]int64, 0)
+		for _, record := range records {
+			nonces = append(nonces, record.(*schema.HyperBlock).Nonce)
+		}
+		exportedNonces[filepath.Base(path)] = nonces
+	}
+
+	return exportedNonces
+}
+
+func requireCheckpoint(t *testing.T, path string, expectedNextNonce uint64) {
+	cp, found, err := polling.LoadCheckpoint(path)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, expectedNextNonce, cp.NextNonce)
+}
+
+func TestNewHyperBlocksExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hbe, err := NewHyperBlocksExporter(createMockArgsHyperBlocksExporter(t))
+		require.Nil(t, err)
+		require.NotNil(t, hbe)
+	})
+
+	t.Run("nil facade, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.Facade = nil
+		hbe, err := NewHyperBlocksExporter(args)
+		require.Nil(t, hbe)
+		require.Equal(t, errNilHyperBlocksFacade, err)
+	})
+
+	t.Run("nil marshaller, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.Marshaller = nil
+		hbe, err := NewHyperBlocksExporter(args)
+		require.Nil(t, hbe)
+		require.Equal(t, errNilAvroMarshaller, err)
+	})
+
+	t.Run("empty schema definition, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.SchemaDefinition = ""
+		hbe, err := NewHyperBlocksExporter(args)
+		require.Nil(t, hbe)
+		require.Equal(t, errEmptySchemaDefinition, err)
+	})
+
+	t.Run("empty output directory, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.OutputDirectory = ""
+		hbe, err := NewHyperBlocksExporter(args)
+		require.Nil(t, hbe)
+		require.Equal(t, errEmptyOutputDirectory, err)
+	})
+
+	t.Run("empty checkpoint file, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.CheckpointFile = ""
+		hbe, err := NewHyperBlocksExporter(args)
+		require.Nil(t, hbe)
+		require.Equal(t, errEmptyCheckpointFile, err)
+	})
+
+	t.Run("unsupported codec, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.Codec = "gzip"
+		hbe, err := NewHyperBlocksExporter(args)
+		require.Nil(t, hbe)
+		require.True(t, errors.Is(err, errUnsupportedCodec))
+	})
+
+	t.Run("invalid batch size, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.QueryOptions.BatchSize = 0
+		hbe, err := NewHyperBlocksExporter(args)
+		require.Nil(t, hbe)
+		require.True(t, errors.Is(err, errInvalidBatchSize))
+	})
+
+	t.Run("invalid polling interval, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.PollingInterval = 0
+		hbe, err := NewHyperBlocksExporter(args)
+		require.Nil(t, hbe)
+		require.True(t, errors.Is(err, errInvalidPollingInterval))
+	})
+
+	t.Run("no rotation policy, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.MaxHyperBlocksPerFile = 0
+		args.RotatePerEpoch = false
+		hbe, err := NewHyperBlocksExporter(args)
+		require.Nil(t, hbe)
+		require.Equal(t, errNoRotationPolicy, err)
+	})
+}
+
+func TestHyperBlocksExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should rotate files per number of hyper blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.Facade = apiMocks.CreateChainFacadeStub(t, func() uint64 {
+			return 100
+		}, createHyperBlockEncoder(t))
+		hbe, _ := NewHyperBlocksExporter(args)
+
+		err := hbe.Export(context.Background(), 3, 12, false)
+		require.Nil(t, err)
+		require.Equal(t, map[string][]int64{
+			"hyperblocks_00000000000000000003_00000000000000000006.avro": {3, 4, 5, 6},
+			"hyperblocks_00000000000000000007_00000000000000000010.avro": {7, 8, 9, 10},
+			"hyperblocks_00000000000000000011_00000000000000000012.avro": {11, 12},
+		}, readExportedNonces(t, args.OutputDirectory))
+		requireCheckpoint(t, args.CheckpointFile, 13)
+	})
+
+	t.Run("should rotate files per epoch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.Facade = apiMocks.CreateChainFacadeStub(t, func() uint64 {
+			return 100
+		}, createHyperBlockEncoder(t))
+		args.MaxHyperBlocksPerFile = 0
+		args.RotatePerEpoch = true
+		hbe, _ := NewHyperBlocksExporter(args)
+
+		err := hbe.Export(context.Background(), 3, 12, false)
+		require.Nil(t, err)
+		require.Equal(t, map[string][]int64{
+			"hyperblocks_00000000000000000003_00000000000000000004.avro": {3, 4},
+			"hyperblocks_00000000000000000005_00000000000000000009.avro": {5, 6, 7, 8, 9},
+			"hyperblocks_00000000000000000010_00000000000000000012.avro": {10, 11, 12},
+		}, readExportedNonces(t, args.OutputDirectory))
+		requireCheckpoint(t, args.CheckpointFile, 13)
+	})
+
+	t.Run("no end nonce, should export up to the highest final hyper block", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.Facade = apiMocks.CreateChainFacadeStub(t, func() uint64 {
+			return 5
+		}, createHyperBlockEncoder(t))
+		hbe, _ := NewHyperBlocksExporter(args)
+
+		err := hbe.Export(context.Background(), 0, 0, false)
+		require.Nil(t, err)
+		require.Equal(t, map[string][]int64{
+			"hyperblocks_00000000000000000000_00000000000000000003.avro": {0, 1, 2, 3},
+			"hyperblocks_00000000000000000004_00000000000000000005.avro": {4, 5},
+		}, readExportedNonces(t, args.OutputDirectory))
+		requireCheckpoint(t, args.CheckpointFile, 6)
+	})
+
+	t.Run("should resume from checkpoint and overwrite unfinished file", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.Facade = apiMocks.CreateChainFacadeStub(t, func() uint64 {
+			return 100
+		}, createHyperBlockEncoder(t))
+		err := polling.SaveCheckpoint(args.CheckpointFile, &polling.Checkpoint{NextNonce: 7})
+		require.Nil(t, err)
+		unfinishedFile := filepath.Join(args.OutputDirectory, "hyperblocks_00000000000000000007.avro"+tmpFileSuffix)
+		err = ioutil.WriteFile(unfinishedFile, []byte("partially written file"), 0644)
+		require.Nil(t, err)
+
+		hbe, _ := NewHyperBlocksExporter(args)
+		err = hbe.Export(context.Background(), 3, 12, false)
+		require.Nil(t, err)
+		require.Equal(t, map[string][]int64{
+			"hyperblocks_00000000000000000007_00000000000000000010.avro": {7, 8, 9, 10},
+			"hyperblocks_00000000000000000011_00000000000000000012.avro": {11, 12},
+		}, readExportedNonces(t, args.OutputDirectory))
+		requireCheckpoint(t, args.CheckpointFile, 13)
+
+		_, err = os.Stat(unfinishedFile)
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("invalid interval, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		hbe, _ := NewHyperBlocksExporter(createMockArgsHyperBlocksExporter(t))
+		err := hbe.Export(context.Background(), 5, 4, false)
+		require.True(t, errors.Is(err, errInvalidNoncesInterval))
+	})
+
+	t.Run("end nonce higher than highest final nonce, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.Facade = apiMocks.CreateChainFacadeStub(t, func() uint64 {
+			return 5
+		}, createHyperBlockEncoder(t))
+		hbe, _ := NewHyperBlocksExporter(args)
+
+		err := hbe.Export(context.Background(), 0, 10, false)
+		require.True(t, errors.Is(err, errInvalidNoncesInterval))
+	})
+
+	t.Run("end nonce in follow mode, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		hbe, _ := NewHyperBlocksExporter(createMockArgsHyperBlocksExporter(t))
+		err := hbe.Export(context.Background(), 0, 10, true)
+		require.Equal(t, errEndNonceInFollowMode, err)
+	})
+
+	t.Run("cannot get hyper blocks, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errGetHyperBlocks := errors.New("error getting hyper blocks")
+		args := createMockArgsHyperBlocksExporter(t)
+		args.Facade = &apiMocks.HyperBlockFacadeStub{
+			GetHighestFinalHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 100, nil
+			},
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+				return nil, errGetHyperBlocks
+			},
+		}
+		hbe, _ := NewHyperBlocksExporter(args)
+
+		err := hbe.Export(context.Background(), 0, 10, false)
+		require.Equal(t, errGetHyperBlocks, err)
+
+		_, found, _ := polling.LoadCheckpoint(args.CheckpointFile)
+		require.False(t, found)
+	})
+
+	t.Run("export cancelled while getting hyper blocks, should stop without error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.Facade = &apiMocks.HyperBlockFacadeStub{
+			GetHighestFinalHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 100, nil
+			},
+			GetHyperBlocksByIntervalCalled: func(receivedCtx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+				require.Equal(t, ctx, receivedCtx)
+				cancel()
+				return nil, receivedCtx.Err()
+			},
+		}
+		hbe, _ := NewHyperBlocksExporter(args)
+
+		err := hbe.Export(ctx, 0, 10, false)
+		require.Nil(t, err)
+
+		_, found, _ := polling.LoadCheckpoint(args.CheckpointFile)
+		require.False(t, found)
+	})
+
+	t.Run("unexpected number of hyper blocks, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksExporter(t)
+		args.Facade = &apiMocks.HyperBlockFacadeStub{
+			GetHighestFinalHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 100, nil
+			},
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+				return &api.CovalentHyperBlocksApiResponse{
+					Data: [][]byte{encodeHyperBlock(t, noncesInterval.Start)},
+				}, nil
+			},
+		}
+		hbe, _ := NewHyperBlocksExporter(args)
+
+		err := hbe.Export(context.Background(), 0, 10, false)
+		require.True(t, errors.Is(err, errUnexpectedNumHyperBlocks))
+	})
+}
+
+func TestHyperBlocksExporter_ExportFollow(t *testing.T) {
+	t.Parallel()
+
+	latestNonce := uint64(2)
+	numFailedRequests := uint32(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chainFacade := apiMocks.CreateChainFacadeStub(t, func() uint64 {
+		return atomic.LoadUint64(&latestNonce)
+	}, createHyperBlockEncoder(t))
+	args := createMockArgsHyperBlocksExporter(t)
+	args.Facade = &apiMocks.HyperBlockFacadeStub{
+		GetHighestFinalHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			nonce, _ := chainFacade.GetHighestFinalHyperBlockNonce(ctx)
+			if nonce >= 9 {
+				cancel()
+				return nonce, nil
+			}
+
+			atomic.AddUint64(&latestNonce, 1)
+			return nonce, nil
+		},
+		GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+			if noncesInterval.Start == 5 && atomic.AddUint32(&numFailedRequests, 1) == 1 {
+				return nil, errors.New("hyper blocks not available yet")
+			}
+
+			return chainFacade.GetHyperBlocksByInterval(ctx, noncesInterval, options)
+		},
+	}
+	hbe, _ := NewHyperBlocksExporter(args)
+
+	err := hbe.Export(ctx, 0, 0, true)
+	require.Nil(t, err)
+	require.Equal(t, map[string][]int64{
+		"hyperblocks_00000000000000000000_00000000000000000003.avro": {0, 1, 2, 3},
+		"hyperblocks_00000000000000000004_00000000000000000007.avro": {4, 5, 6, 7},
+		"hyperblocks_00000000000000000008_00000000000000000009.avro": {8, 9},
+	}, readExportedNonces(t, args.OutputDirectory))
+	requireCheckpoint(t, args.CheckpointFile, 10)
+}
@@ -0,0 +1,29 @@
+package exporter
+
+import (
+	"context"
+	"io"
+
+	"github.com/elodina/go-avro"
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+)
+
+// HyperBlocksFacade should fetch avro encoded hyper blocks from Multiversx proxy
+type HyperBlocksFacade interface {
+	GetHyperBlocksByInterval(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error)
+	GetHighestFinalHyperBlockNonce(ctx context.Context) (uint64, error)
+}
+
+// AvroMarshaller should decode avro records and write already encoded avro records in object container files
+type AvroMarshaller interface {
+	Decode(record avro.AvroRecord, buffer []byte) error
+	NewContainerWriter(output io.Writer, schemaDefinition string, codec string) (*utility.AvroContainerWriter, error)
+}
+
+// HyperBlocksExporter should export hyper blocks in [startNonce, endNonce] interval, or keep exporting new hyper blocks
+// in follow mode, until the context is done
+type HyperBlocksExporter interface {
+	Export(ctx context.Context, startNonce uint64, endNonce uint64, follow bool) error
+}
@@ -2,12 +2,16 @@ package facade
 
 import "errors"
 
-var errEmptyMultiversxProxyUrl = errors.New("empty proxy url provided")
-
 var errNilAvroEncoder = errors.New("nil avro encoder provided")
 
 var errNilHyperBlockProcessor = errors.New("nil hyper block processor provided")
 
+var errEmptyHyperBlockSchemaDefinition = errors.New("empty hyper block schema definition provided")
+
+var errNilHyperBlocksCache = errors.New("nil hyper blocks cache provided")
+
+var errNilMetricsHandler = errors.New("nil metrics handler provided")
+
 var errNilHyperBlockEndpointHandler = errors.New("nil hyper block endpoint handler provided")
 
 var errInvalidNoncesInterval = errors.New("invalid nonces interval")
@@ -17,3 +21,21 @@ var errInvalidBatchSize = errors.New("received zero batch size")
 var errCouldNotGetHyperBlock = errors.New("could not get hyper block")
 
 var errCouldNotGetAllHyperBlocks = errors.New("could not get all hyper blocks")
+
+var errInvalidRetryMaxAttempts = errors.New("invalid retry policy max attempts")
+
+var errInvalidRetryDelay = errors.New("invalid retry policy delay")
+
+var errNilChainValidator = errors.New("nil chain validator provided")
+
+var errNilReorgsTracker = errors.New("nil reorgs tracker provided")
+
+var errNilAddressConverter = errors.New("nil address converter provided")
+
+var errNilEpochsIndex = errors.New("nil epochs index provided")
+
+var errNilBalanceUpdatesEncoder = errors.New("nil balance updates avro encoder provided")
+
+var errNilTransactionProcessor = errors.New("nil transaction processor provided")
+
+var errCouldNotGetTransaction = errors.New("could not get transaction")
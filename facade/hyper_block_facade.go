@@ -1,64 +1,154 @@
 package facade
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-covalent-go"
 	"github.com/multiversx/mx-chain-covalent-go/api"
 	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/filters"
+	"github.com/multiversx/mx-chain-covalent-go/hyperBlock"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
 const hyperBlockPathByNonce = "/hyperblock/by-nonce"
 const hyperBlockPathByHash = "/hyperblock/by-hash"
 
+const networkStatusPath = "/network/status"
+
+const hyperBlockStatusReverted = "reverted"
+
+const (
+	upstreamEndpointHyperBlock    = "hyperblock"
+	upstreamEndpointNetworkStatus = "network_status"
+)
+
+const (
+	failureReasonUpstream   = "upstream"
+	failureReasonProcess    = "process"
+	failureReasonEncode     = "encode"
+	failureReasonValidation = "validation"
+)
+
 var log = logger.GetOrCreate("facade")
 
+// HyperBlockFacadeArgs holds all input dependencies required by hyper block facade
+type HyperBlockFacadeArgs struct {
+	AvroEncoder                  AvroEncoder
+	MultiversxHyperBlockEndpoint api.MultiversxHyperBlockEndpointHandler
+	HyperBlockProcessor          covalent.HyperBlockProcessor
+	HyperBlocksCache             HyperBlocksCache
+	HyperBlockSchemaDefinition   string
+	Metrics                      MetricsHandler
+	RetryPolicy                  config.RetryPolicy
+	ChainValidator               ChainValidator
+	ReorgsTracker                ReorgsTracker
+	AddressConverter             AddressConverter
+	EpochsIndex                  EpochsIndex
+	BalanceUpdatesEncoder        AvroEncoder
+}
+
 type hyperBlockFacade struct {
-	multiversxProxyUrl string
-	processor          covalent.HyperBlockProcessor
-	multiversxEndpoint api.MultiversxHyperBlockEndpointHandler
-	encoder            AvroEncoder
+	processor             covalent.HyperBlockProcessor
+	multiversxEndpoint    api.MultiversxHyperBlockEndpointHandler
+	encoder               AvroEncoder
+	hyperBlocksCache      HyperBlocksCache
+	schemaDefinition      string
+	metrics               MetricsHandler
+	retryPolicy           *retryPolicy
+	chainValidator        ChainValidator
+	reorgsTracker         ReorgsTracker
+	addressConverter      AddressConverter
+	epochsIndex           EpochsIndex
+	balanceUpdatesEncoder AvroEncoder
+	highestFinalNonce     uint64
 }
 
 // NewHyperBlockFacade will create a hyper block facade, which can fetch hyper blocks from Multiversx proxy
-func NewHyperBlockFacade(
-	multiversxProxyUrl string,
-	avroEncoder AvroEncoder,
-	multiversxHyperBlockEndpoint api.MultiversxHyperBlockEndpointHandler,
-	hyperBlockProcessor covalent.HyperBlockProcessor,
-) (*hyperBlockFacade, error) {
-	if len(multiversxProxyUrl) == 0 {
-		return nil, errEmptyMultiversxProxyUrl
-	}
-	if avroEncoder == nil {
+func NewHyperBlockFacade(args *HyperBlockFacadeArgs) (*hyperBlockFacade, error) {
+	if args.AvroEncoder == nil {
 		return nil, errNilAvroEncoder
 	}
-	if hyperBlockProcessor == nil {
+	if args.HyperBlockProcessor == nil {
 		return nil, errNilHyperBlockProcessor
 	}
-	if multiversxHyperBlockEndpoint == nil {
+	if args.MultiversxHyperBlockEndpoint == nil {
 		return nil, errNilHyperBlockEndpointHandler
 	}
+	if args.HyperBlocksCache == nil {
+		return nil, errNilHyperBlocksCache
+	}
+	if len(args.HyperBlockSchemaDefinition) == 0 {
+		return nil, errEmptyHyperBlockSchemaDefinition
+	}
+	if args.Metrics == nil {
+		return nil, errNilMetricsHandler
+	}
+	if args.ChainValidator == nil {
+		return nil, errNilChainValidator
+	}
+	if args.ReorgsTracker == nil {
+		return nil, errNilReorgsTracker
+	}
+	if args.AddressConverter == nil {
+		return nil, errNilAddressConverter
+	}
+	if args.EpochsIndex == nil {
+		return nil, errNilEpochsIndex
+	}
+	if args.BalanceUpdatesEncoder == nil {
+		return nil, errNilBalanceUpdatesEncoder
+	}
+	retryPolicy, err := newRetryPolicy(args.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
 
 	return &hyperBlockFacade{
-		multiversxProxyUrl: multiversxProxyUrl,
-		processor:          hyperBlockProcessor,
-		encoder:            avroEncoder,
-		multiversxEndpoint: multiversxHyperBlockEndpoint,
+		processor:             args.HyperBlockProcessor,
+		encoder:               args.AvroEncoder,
+		multiversxEndpoint:    args.MultiversxHyperBlockEndpoint,
+		hyperBlocksCache:      args.HyperBlocksCache,
+		schemaDefinition:      args.HyperBlockSchemaDefinition,
+		metrics:               args.Metrics,
+		retryPolicy:           retryPolicy,
+		chainValidator:        args.ChainValidator,
+		reorgsTracker:         args.ReorgsTracker,
+		addressConverter:      args.AddressConverter,
+		epochsIndex:           args.EpochsIndex,
+		balanceUpdatesEncoder: args.BalanceUpdatesEncoder,
 	}, nil
 }
 
 // GetHyperBlockByNonce will fetch the hyper block from Multiversx proxy with provided nonce and options in covalent format
-func (hbf *hyperBlockFacade) GetHyperBlockByNonce(nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+func (hbf *hyperBlockFacade) GetHyperBlockByNonce(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+	optionsKey := getOptionsKey(options)
+	cachedHyperBlock, found := hbf.hyperBlocksCache.GetByNonce(nonce, optionsKey)
+	if found {
+		return createHyperBlockApiResponse(cachedHyperBlock), nil
+	}
+	if options.FinalOnly {
+		err := hbf.checkHyperBlockFinal(ctx, nonce)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	fullPath := hbf.getHyperBlockByNonceFullPath(nonce, options)
-	return hbf.getHyperBlock(fullPath)
+	return hbf.getHyperBlock(ctx, fullPath, options)
 }
 
 // GetHyperBlocksByInterval will fetch the hyper blocks from Multiversx proxy with provided nonces interval and options in covalent format
-func (hbf *hyperBlockFacade) GetHyperBlocksByInterval(noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
-	encodedHyperBlocks, err := hbf.getHyperBlocksByNonces(noncesInterval, options)
+func (hbf *hyperBlockFacade) GetHyperBlocksByInterval(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+	encodedHyperBlocks, err := hbf.getHyperBlocksByNonces(ctx, noncesInterval, options)
 	if err != nil {
 		return nil, err
 	}
@@ -70,27 +160,107 @@ func (hbf *hyperBlockFacade) GetHyperBlocksByInterval(noncesInterval *api.Interv
 	}, nil
 }
 
+// GetPartialHyperBlocksByInterval will fetch the hyper blocks from Multiversx proxy with provided nonces interval and
+// options in covalent format. Unlike GetHyperBlocksByInterval, a failed hyper block does not fail the whole request:
+// the successfully fetched hyper blocks are returned, along with the error of each failed one
+func (hbf *hyperBlockFacade) GetPartialHyperBlocksByInterval(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentPartialHyperBlocksApiResponse, error) {
+	results, err := hbf.fetchHyperBlocksByNonces(ctx, noncesInterval, options, false)
+	if err != nil {
+		return nil, err
+	}
+
+	hbf.validatePartialChain(results)
+
+	hyperBlockResults := make([]api.CovalentHyperBlockResult, 0, len(results.encodedHyperBlocks))
+	numFailed := 0
+	for idx, encodedHyperBlock := range results.encodedHyperBlocks {
+		hyperBlockResult := createHyperBlockResult(noncesInterval.Start+uint64(idx), encodedHyperBlock, results.errors[idx])
+		if hyperBlockResult.Code != api.ReturnCodeSuccess {
+			numFailed++
+		}
+
+		hyperBlockResults = append(hyperBlockResults, hyperBlockResult)
+	}
+
+	if numFailed == 0 {
+		return &api.CovalentPartialHyperBlocksApiResponse{
+			Data:  hyperBlockResults,
+			Error: "",
+			Code:  api.ReturnCodeSuccess,
+		}, nil
+	}
+
+	return &api.CovalentPartialHyperBlocksApiResponse{
+		Data:  hyperBlockResults,
+		Error: fmt.Sprintf("%s: %d out of %d", errCouldNotGetAllHyperBlocks.Error(), numFailed, len(hyperBlockResults)),
+		Code:  api.ReturnCodePartialSuccess,
+	}, nil
+}
+
+func createHyperBlockResult(nonce uint64, encodedHyperBlock []byte, err error) api.CovalentHyperBlockResult {
+	if err == nil && len(encodedHyperBlock) == 0 {
+		err = errCouldNotGetHyperBlock
+	}
+	if err != nil {
+		return api.CovalentHyperBlockResult{
+			Nonce: nonce,
+			Data:  nil,
+			Error: err.Error(),
+			Code:  api.ReturnCodeInternalError,
+		}
+	}
+
+	return api.CovalentHyperBlockResult{
+		Nonce: nonce,
+		Data:  encodedHyperBlock,
+		Error: "",
+		Code:  api.ReturnCodeSuccess,
+	}
+}
+
+// GetHyperBlocksContainerByInterval will fetch the hyper blocks from Multiversx proxy with provided nonces interval and options
+// as a single avro object container file, having its data blocks compressed with the provided codec
+func (hbf *hyperBlockFacade) GetHyperBlocksContainerByInterval(
+	ctx context.Context,
+	noncesInterval *api.Interval,
+	options config.HyperBlocksQueryOptions,
+	codec string,
+) ([]byte, error) {
+	encodedHyperBlocks, err := hbf.getHyperBlocksByNonces(ctx, noncesInterval, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return hbf.encoder.EncodeContainer(hbf.schemaDefinition, codec, encodedHyperBlocks)
+}
+
 func (hbf *hyperBlockFacade) getHyperBlockByNonceFullPath(nonce uint64, options config.HyperBlockQueryOptions) string {
 	blockByNoncePath := fmt.Sprintf("%s/%d", hyperBlockPathByNonce, nonce)
 	return hbf.getFullPathWithOptions(blockByNoncePath, options)
 }
 
 func (hbf *hyperBlockFacade) getFullPathWithOptions(path string, options config.HyperBlockQueryOptions) string {
-	pathWithOptions := buildUrlWithBlockQueryOptions(path, options)
-	return fmt.Sprintf("%s%s", hbf.multiversxProxyUrl, pathWithOptions)
+	return buildUrlWithBlockQueryOptions(path, options)
 }
 
 func buildUrlWithBlockQueryOptions(path string, options config.HyperBlockQueryOptions) string {
-	u := url.URL{Path: path}
-	query := u.Query()
+	u := url.URL{
+		Path:     path,
+		RawQuery: getBlockQueryParams(options).Encode(),
+	}
+
+	return u.String()
+}
+
+func getBlockQueryParams(options config.HyperBlockQueryOptions) url.Values {
+	query := url.Values{}
 
 	setQueryParamIfTrue(query, options.WithLogs, api.UrlParameterWithLogs)
 	setQueryParamIfTrue(query, options.NotarizedAtSource, api.UrlParameterNotarizedAtSource)
 	setQueryParamIfTrue(query, options.WithAlteredAccounts, api.UrlParameterWithAlteredAccounts)
 	setQueryParamIfNotEmpty(query, options.Tokens, api.UrlParameterTokens)
 
-	u.RawQuery = query.Encode()
-	return u.String()
+	return query
 }
 
 func setQueryParamIfTrue(query url.Values, option bool, urlParam string) {
@@ -105,37 +275,229 @@ func setQueryParamIfNotEmpty(query url.Values, option string, urlParam string) {
 	}
 }
 
-func (hbf *hyperBlockFacade) getHyperBlockAvroBytes(path string) ([]byte, error) {
-	multiversxHyperBlock, err := hbf.multiversxEndpoint.GetHyperBlock(path)
+// getOptionsKey returns the key of the hyper blocks processed with the provided options: the query params sent to
+// Multiversx proxy, along with the transactions filter criteria
+func getOptionsKey(options config.HyperBlockQueryOptions) string {
+	query := getBlockQueryParams(options)
+
+	filter := options.TransactionsFilter
+	setQueryParamIfNotEmpty(query, joinSorted(filter.Senders), api.UrlParameterSender)
+	setQueryParamIfNotEmpty(query, joinSorted(filter.Receivers), api.UrlParameterReceiver)
+	setQueryParamIfNotEmpty(query, joinSorted(filter.Functions), api.UrlParameterFunction)
+	setQueryParamIfNotEmpty(query, joinSorted(filter.Tokens), api.UrlParameterToken)
+	setQueryParamIfNotEmpty(query, joinSorted(filter.Statuses), api.UrlParameterStatus)
+	setQueryParamIfNotEmpty(query, joinSorted(filter.MiniBlockTypes), api.UrlParameterMiniBlockType)
+
+	u := url.URL{RawQuery: query.Encode()}
+	return u.String()
+}
+
+func joinSorted(values []string) string {
+	sortedValues := make([]string, len(values))
+	copy(sortedValues, values)
+	sort.Strings(sortedValues)
+
+	return strings.Join(sortedValues, ",")
+}
+
+// fetchHyperBlockAvroBytes returns the avro encoded hyper block from the provided path, holding only the transactions
+// matching the filter of the provided options. In case of an error, the reason of the failure is also returned, to be
+// recorded in metrics
+func (hbf *hyperBlockFacade) fetchHyperBlockAvroBytes(ctx context.Context, path string, options config.HyperBlockQueryOptions) ([]byte, string, error) {
+	multiversxHyperBlock, hyperBlockSchema, failureReason, err := hbf.fetchProcessedHyperBlock(ctx, path)
 	if err != nil {
-		return nil, err
+		return nil, failureReason, err
+	}
+
+	transactionsFilter, err := filters.NewTransactionsFilter(options.TransactionsFilter, hbf.addressConverter)
+	if err != nil {
+		return nil, failureReasonProcess, err
+	}
+	transactionsFilter.Filter(hyperBlockSchema)
+
+	hyperBlockSchemaAvroBytes, err := hbf.encoder.Encode(hyperBlockSchema)
+	if err != nil {
+		return nil, failureReasonEncode, err
+	}
+
+	hbf.metrics.ObserveEncodedHyperBlockSize(len(hyperBlockSchemaAvroBytes))
+	hbf.handleFetchedHyperBlock(ctx, multiversxHyperBlock, getOptionsKey(options), hyperBlockSchemaAvroBytes)
+	return hyperBlockSchemaAvroBytes, "", nil
+}
+
+// fetchProcessedHyperBlock returns the hyper block from the provided path, along with its processed and validated
+// covalent format. In case of an error, the reason of the failure is also returned, to be recorded in metrics
+func (hbf *hyperBlockFacade) fetchProcessedHyperBlock(ctx context.Context, path string) (*hyperBlock.HyperBlock, *schema.HyperBlock, string, error) {
+	start := time.Now()
+	multiversxHyperBlock, err := hbf.multiversxEndpoint.GetHyperBlock(ctx, path)
+	hbf.metrics.ObserveUpstreamRequest(upstreamEndpointHyperBlock, time.Since(start), err)
+	if err != nil {
+		return nil, nil, failureReasonUpstream, err
 	}
 
 	hyperBlockSchema, err := hbf.processor.Process(&multiversxHyperBlock.Data.HyperBlock)
 	if err != nil {
-		return nil, err
+		return nil, nil, failureReasonProcess, err
+	}
+
+	err = hbf.chainValidator.ValidateHyperBlock(hyperBlockSchema)
+	if err != nil {
+		return nil, nil, failureReasonValidation, err
 	}
 
-	return hbf.encoder.Encode(hyperBlockSchema)
+	return &multiversxHyperBlock.Data.HyperBlock, hyperBlockSchema, "", nil
 }
 
-func (hbf *hyperBlockFacade) getHyperBlock(path string) (*api.CovalentHyperBlockApiResponse, error) {
-	hyperBlockSchemaAvroBytes, err := hbf.getHyperBlockAvroBytes(path)
+// handleFetchedHyperBlock caches final hyper blocks and tracks the hashes of non final ones, to detect reorgs
+func (hbf *hyperBlockFacade) handleFetchedHyperBlock(ctx context.Context, hyperBlock *hyperBlock.HyperBlock, optionsKey string, encodedHyperBlock []byte) {
+	if hyperBlock.Status == hyperBlockStatusReverted {
+		return
+	}
+	if !hbf.hyperBlocksCache.IsEnabled() && !hbf.reorgsTracker.IsEnabled() {
+		return
+	}
+	if !hbf.isHyperBlockFinal(ctx, hyperBlock.Nonce) {
+		if hbf.reorgsTracker.IsEnabled() {
+			hbf.reorgsTracker.Track(hyperBlock.Nonce, hyperBlock.Hash)
+		}
+		return
+	}
+	if !hbf.hyperBlocksCache.IsEnabled() {
+		return
+	}
+
+	err := hbf.hyperBlocksCache.Put(hyperBlock.Nonce, hyperBlock.Hash, optionsKey, encodedHyperBlock)
+	if err != nil {
+		log.Warn("could not cache hyper block", "nonce", hyperBlock.Nonce, "hash", hyperBlock.Hash, "error", err)
+	}
+}
+
+func (hbf *hyperBlockFacade) isHyperBlockFinal(ctx context.Context, nonce uint64) bool {
+	highestFinalNonce, err := hbf.getHighestFinalNonce(ctx, nonce)
+	if err != nil {
+		log.Debug("could not get highest final hyper block nonce", "error", err)
+		return false
+	}
+
+	return nonce <= highestFinalNonce
+}
+
+// checkHyperBlockFinal returns an error wrapping api.ErrHyperBlockNotFinal if the hyper block with the provided nonce
+// is above the highest final nonce of the metachain
+func (hbf *hyperBlockFacade) checkHyperBlockFinal(ctx context.Context, nonce uint64) error {
+	highestFinalNonce, err := hbf.getHighestFinalNonce(ctx, nonce)
+	if err != nil {
+		return err
+	}
+	if nonce > highestFinalNonce {
+		return fmt.Errorf("%w: nonce: %d, highest final nonce: %d", api.ErrHyperBlockNotFinal, nonce, highestFinalNonce)
+	}
+
+	return nil
+}
+
+// getHighestFinalNonce returns the highest final nonce of the metachain. It is only fetched from Multiversx proxy if
+// the highest final nonce known so far is lower than the provided nonce
+func (hbf *hyperBlockFacade) getHighestFinalNonce(ctx context.Context, nonce uint64) (uint64, error) {
+	currentHighestFinalNonce := atomic.LoadUint64(&hbf.highestFinalNonce)
+	if nonce <= currentHighestFinalNonce {
+		return currentHighestFinalNonce, nil
+	}
+
+	networkStatus, err := hbf.getMetaNetworkStatus(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return hbf.updateHighestFinalNonce(networkStatus.Data.Status.HighestFinalNonce), nil
+}
+
+// updateHighestFinalNonce stores the provided highest final nonce, if higher than the one known so far, and returns
+// the highest of them
+func (hbf *hyperBlockFacade) updateHighestFinalNonce(highestFinalNonce uint64) uint64 {
+	for {
+		currentHighestFinalNonce := atomic.LoadUint64(&hbf.highestFinalNonce)
+		if highestFinalNonce <= currentHighestFinalNonce {
+			return currentHighestFinalNonce
+		}
+		if atomic.CompareAndSwapUint64(&hbf.highestFinalNonce, currentHighestFinalNonce, highestFinalNonce) {
+			return highestFinalNonce
+		}
+	}
+}
+
+func (hbf *hyperBlockFacade) getHyperBlock(ctx context.Context, path string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+	hyperBlockSchemaAvroBytes, err := hbf.getHyperBlockWithRetrials(ctx, path, options)
 	if err != nil {
 		return nil, err
 	}
 
+	return createHyperBlockApiResponse(hyperBlockSchemaAvroBytes), nil
+}
+
+func createHyperBlockApiResponse(hyperBlockSchemaAvroBytes []byte) *api.CovalentHyperBlockApiResponse {
 	return &api.CovalentHyperBlockApiResponse{
 		Data:  hyperBlockSchemaAvroBytes,
 		Error: "",
 		Code:  api.ReturnCodeSuccess,
-	}, nil
+	}
 }
 
 // GetHyperBlockByHash will fetch the hyper block from Multiversx proxy with provided hash and options in covalent format
-func (hbf *hyperBlockFacade) GetHyperBlockByHash(hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+func (hbf *hyperBlockFacade) GetHyperBlockByHash(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+	optionsKey := getOptionsKey(options)
+	cachedHyperBlock, found := hbf.hyperBlocksCache.GetByHash(hash, optionsKey)
+	if found {
+		return createHyperBlockApiResponse(cachedHyperBlock), nil
+	}
+
 	blockByHashPath := fmt.Sprintf("%s/%s", hyperBlockPathByHash, hash)
 	fullPath := hbf.getFullPathWithOptions(blockByHashPath, options)
+	hyperBlockApiResponse, err := hbf.getHyperBlock(ctx, fullPath, options)
+	if err != nil || !options.FinalOnly {
+		return hyperBlockApiResponse, err
+	}
+
+	hyperBlock, err := hbf.decodeHyperBlock(hyperBlockApiResponse.Data)
+	if err != nil {
+		return nil, err
+	}
+	err = hbf.checkHyperBlockFinal(ctx, uint64(hyperBlock.Nonce))
+	if err != nil {
+		return nil, err
+	}
+
+	return hyperBlockApiResponse, nil
+}
+
+// GetLatestHyperBlockNonce will fetch the latest hyper block nonce known by Multiversx proxy, which is the
+// current nonce of the metachain
+func (hbf *hyperBlockFacade) GetLatestHyperBlockNonce(ctx context.Context) (uint64, error) {
+	networkStatus, err := hbf.getMetaNetworkStatus(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return networkStatus.Data.Status.Nonce, nil
+}
+
+// GetHighestFinalHyperBlockNonce will fetch the highest final hyper block nonce known by Multiversx proxy. Unlike the
+// latest hyper block nonce, hyper blocks up to this nonce can no longer be reverted
+func (hbf *hyperBlockFacade) GetHighestFinalHyperBlockNonce(ctx context.Context) (uint64, error) {
+	networkStatus, err := hbf.getMetaNetworkStatus(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return hbf.updateHighestFinalNonce(networkStatus.Data.Status.HighestFinalNonce), nil
+}
+
+func (hbf *hyperBlockFacade) getMetaNetworkStatus(ctx context.Context) (*api.MultiversxNetworkStatusApiResponse, error) {
+	metaNetworkStatusPath := fmt.Sprintf("%s/%d", networkStatusPath, core.MetachainShardId)
+
+	start := time.Now()
+	networkStatus, err := hbf.multiversxEndpoint.GetNetworkStatus(ctx, metaNetworkStatusPath)
+	hbf.metrics.ObserveUpstreamRequest(upstreamEndpointNetworkStatus, time.Since(start), err)
 
-	return hbf.getHyperBlock(fullPath)
+	return networkStatus, err
 }
@@ -0,0 +1,84 @@
+package facade
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+)
+
+const allTokens = "all"
+
+// GetAccountBalanceUpdates will fetch the hyper blocks from Multiversx proxy with provided nonces interval and collect
+// the balance updates of the provided bech32 address, including its tokens, each attached to its shard block
+func (hbf *hyperBlockFacade) GetAccountBalanceUpdates(
+	ctx context.Context,
+	address string,
+	noncesInterval *api.Interval,
+	options config.HyperBlocksQueryOptions,
+) (*api.CovalentHyperBlockApiResponse, error) {
+	options.QueryOptions = getAccountBalanceUpdatesQueryOptions(options.QueryOptions)
+	encodedHyperBlocks, err := hbf.getHyperBlocksByNonces(ctx, noncesInterval, options)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceUpdates := make(schema.AccountBalanceUpdates, 0)
+	for _, encodedHyperBlock := range encodedHyperBlocks {
+		hyperBlock, errDecode := hbf.decodeHyperBlock(encodedHyperBlock)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		balanceUpdates = append(balanceUpdates, hbf.getAccountBalanceUpdates(address, hyperBlock)...)
+	}
+
+	encodedBalanceUpdates, err := hbf.balanceUpdatesEncoder.Encode(balanceUpdates)
+	if err != nil {
+		hbf.metrics.IncFailures(failureReasonEncode)
+		return nil, err
+	}
+
+	return &api.CovalentHyperBlockApiResponse{
+		Data:  encodedBalanceUpdates,
+		Error: "",
+		Code:  api.ReturnCodeSuccess,
+	}, nil
+}
+
+// getAccountBalanceUpdatesQueryOptions returns the provided options, always requesting the altered accounts, along
+// with all of their tokens unless some tokens are requested. Since the transactions filter does not change the balance
+// updates, it is cleared, such that hyper blocks are shared with the unfiltered ones in the cache
+func getAccountBalanceUpdatesQueryOptions(options config.HyperBlockQueryOptions) config.HyperBlockQueryOptions {
+	options.WithAlteredAccounts = true
+	if len(options.Tokens) == 0 {
+		options.Tokens = allTokens
+	}
+	options.TransactionsFilter = config.TransactionsFilter{}
+
+	return options
+}
+
+func (hbf *hyperBlockFacade) getAccountBalanceUpdates(address string, hyperBlock *schema.HyperBlock) schema.AccountBalanceUpdates {
+	balanceUpdates := make(schema.AccountBalanceUpdates, 0)
+	for _, shardBlock := range hyperBlock.ShardBlocks {
+		if shardBlock == nil {
+			continue
+		}
+
+		for _, balanceUpdate := range shardBlock.StateChanges {
+			if balanceUpdate == nil || hbf.addressConverter.DecodeAddress(balanceUpdate.Address) != address {
+				continue
+			}
+
+			balanceUpdates = append(balanceUpdates, &schema.ShardAccountBalanceUpdate{
+				ShardBlockNonce: shardBlock.Nonce,
+				ShardBlockHash:  shardBlock.Hash,
+				BalanceUpdate:   balanceUpdate,
+			})
+		}
+	}
+
+	return balanceUpdates
+}
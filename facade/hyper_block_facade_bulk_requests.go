@@ -1,46 +1,163 @@
 package facade
 
 import (
+	"context"
 	"fmt"
-	"math"
 	"sync"
-	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-covalent-go/api"
 	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
 )
 
-const (
-	maxRequestsRetrial = 10
-	waitTimeRetrialsMs = 50
-)
+// batchResults holds the encoded hyper blocks of a nonces interval, along with the errors of the ones which could not
+// be fetched, at the same index
+type batchResults struct {
+	encodedHyperBlocks [][]byte
+	errors             []error
+	lastError          error
+}
+
+func (hbf *hyperBlockFacade) getHyperBlocksByNonces(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) ([][]byte, error) {
+	results, err := hbf.fetchHyperBlocksByNonces(ctx, noncesInterval, options, true)
+	if err != nil {
+		return nil, err
+	}
+	if results.lastError != nil {
+		return nil, fmt.Errorf("one or more errors occurred; last known error: %w", results.lastError)
+	}
+
+	encodedHyperBlocks, err := sanityCheckResult(results.encodedHyperBlocks)
+	if err != nil {
+		return nil, err
+	}
 
-func (hbf *hyperBlockFacade) getHyperBlocksByNonces(noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) ([][]byte, error) {
+	err = hbf.validateChain(encodedHyperBlocks)
+	if err != nil {
+		hbf.metrics.IncFailures(failureReasonValidation)
+		return nil, err
+	}
+
+	return encodedHyperBlocks, nil
+}
+
+// validateChain checks that each hyper block is linked to the previous one, including the ones served from cache
+func (hbf *hyperBlockFacade) validateChain(encodedHyperBlocks [][]byte) error {
+	if !hbf.chainValidator.IsEnabled() {
+		return nil
+	}
+
+	var previous *schema.HyperBlock
+	for _, encodedHyperBlock := range encodedHyperBlocks {
+		current, err := hbf.decodeHyperBlock(encodedHyperBlock)
+		if err != nil {
+			return err
+		}
+
+		if previous != nil {
+			err = hbf.chainValidator.ValidateLink(previous, current)
+			if err != nil {
+				return err
+			}
+		}
+
+		previous = current
+	}
+
+	return nil
+}
+
+// validatePartialChain checks that each fetched hyper block is linked to the previous one, if it was also fetched.
+// Unlinked hyper blocks are marked as failed
+func (hbf *hyperBlockFacade) validatePartialChain(results *batchResults) {
+	if !hbf.chainValidator.IsEnabled() {
+		return
+	}
+
+	var previous *schema.HyperBlock
+	for idx, encodedHyperBlock := range results.encodedHyperBlocks {
+		if results.errors[idx] != nil {
+			previous = nil
+			continue
+		}
+
+		current, err := hbf.decodeHyperBlock(encodedHyperBlock)
+		if err == nil && previous != nil {
+			err = hbf.chainValidator.ValidateLink(previous, current)
+		}
+		if err != nil {
+			hbf.metrics.IncFailures(failureReasonValidation)
+			results.encodedHyperBlocks[idx] = nil
+			results.errors[idx] = err
+			previous = nil
+			continue
+		}
+
+		previous = current
+	}
+}
+
+func (hbf *hyperBlockFacade) decodeHyperBlock(encodedHyperBlock []byte) (*schema.HyperBlock, error) {
+	hyperBlock := schema.NewHyperBlock()
+	err := hbf.encoder.Decode(hyperBlock, encodedHyperBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return hyperBlock, nil
+}
+
+// fetchHyperBlocksByNonces fetches all hyper blocks in the provided nonces interval, in batches. If stopOnError is set,
+// no other request is sent after the first failed one
+func (hbf *hyperBlockFacade) fetchHyperBlocksByNonces(
+	ctx context.Context,
+	noncesInterval *api.Interval,
+	options config.HyperBlocksQueryOptions,
+	stopOnError bool,
+) (*batchResults, error) {
 	if noncesInterval.Start > noncesInterval.End {
 		return nil, errInvalidNoncesInterval
 	}
 	if options.BatchSize == 0 {
 		return nil, errInvalidBatchSize
 	}
+	if options.QueryOptions.FinalOnly {
+		err := hbf.checkHyperBlockFinal(ctx, noncesInterval.End)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	expectedNumOfResults := noncesInterval.End - noncesInterval.Start + 1
 	maxGoroutines := core.MinUint64(uint64(options.BatchSize), expectedNumOfResults)
 	done := make(chan struct{}, maxGoroutines)
 	wg := &sync.WaitGroup{}
 
-	results := make([][]byte, expectedNumOfResults)
+	results := &batchResults{
+		encodedHyperBlocks: make([][]byte, expectedNumOfResults),
+		errors:             make([]error, expectedNumOfResults),
+	}
 	mutex := sync.Mutex{}
 	currIdx := uint32(0)
 
-	var requestError error
-	for nonce := noncesInterval.Start; nonce <= noncesInterval.End && requestError == nil; nonce++ {
+	shouldStop := func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return stopOnError && results.lastError != nil
+	}
+
+	optionsKey := getOptionsKey(options.QueryOptions)
+	for nonce := noncesInterval.Start; nonce <= noncesInterval.End && !shouldStop() && ctx.Err() == nil; nonce++ {
 		done <- struct{}{}
 		wg.Add(1)
 
 		request := hbf.getHyperBlockByNonceFullPath(nonce, options.QueryOptions)
-		go func(req string, idx uint32) {
-			res, err := hbf.getHyperBlockWithRetrials(req)
+		go func(req string, idx uint32, nonce uint64) {
+			hbf.metrics.IncInFlightBatchRequests()
+			res, err := hbf.getCachedHyperBlockOrWithRetrials(ctx, req, nonce, options.QueryOptions, optionsKey)
+			hbf.metrics.DecInFlightBatchRequests()
 
 			mutex.Lock()
 			defer func() {
@@ -50,51 +167,62 @@ func (hbf *hyperBlockFacade) getHyperBlocksByNonces(noncesInterval *api.Interval
 			}()
 
 			if err != nil {
-				requestError = err
+				results.errors[idx] = err
+				results.lastError = err
 				return
 			}
 
-			results[idx] = res
-		}(request, currIdx)
+			results.encodedHyperBlocks[idx] = res
+		}(request, currIdx, nonce)
 
 		currIdx++
 	}
 
 	wg.Wait()
 
-	if requestError != nil {
-		return nil, fmt.Errorf("one or more errors occurred; last known error: %w", requestError)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
 	}
 
-	return sanityCheckResult(results)
+	return results, nil
 }
 
-func (hbf *hyperBlockFacade) getHyperBlockWithRetrials(request string) ([]byte, error) {
-	ctRetrials := 0
-	for ctRetrials < maxRequestsRetrial {
-		res, err := hbf.getHyperBlockAvroBytes(request)
-		if err == nil {
-			return res, nil
-		}
-
-		ctRetrials++
-		sleepDuration := calcRetryBackOffTime(ctRetrials)
-		log.Warn("could not get hyperblock; retrying...",
-			"request", request,
-			"error", err,
-			"num retrials", ctRetrials,
-			"sleep duration", sleepDuration,
-		)
-
-		time.Sleep(sleepDuration)
+func (hbf *hyperBlockFacade) getCachedHyperBlockOrWithRetrials(
+	ctx context.Context,
+	request string,
+	nonce uint64,
+	options config.HyperBlockQueryOptions,
+	optionsKey string,
+) ([]byte, error) {
+	cachedHyperBlock, found := hbf.hyperBlocksCache.GetByNonce(nonce, optionsKey)
+	if found {
+		return cachedHyperBlock, nil
 	}
 
-	return nil, fmt.Errorf("%w from request = %s after num of retrials = %d", errCouldNotGetHyperBlock, request, maxRequestsRetrial)
+	return hbf.getHyperBlockWithRetrials(ctx, request, options)
 }
 
-func calcRetryBackOffTime(attemptNumber int) time.Duration {
-	exp := math.Exp2(float64(attemptNumber))
-	return time.Duration(exp) * waitTimeRetrialsMs * time.Millisecond
+// getHyperBlockWithRetrials retries fetching the hyper block, as defined by the retry policy
+func (hbf *hyperBlockFacade) getHyperBlockWithRetrials(ctx context.Context, request string, options config.HyperBlockQueryOptions) ([]byte, error) {
+	var res []byte
+	err := hbf.retryPolicy.retry(ctx, retriedRequest{
+		name:         "hyperblock",
+		request:      request,
+		errExhausted: errCouldNotGetHyperBlock,
+		attempt: func() (string, error) {
+			var failureReason string
+			var err error
+			res, failureReason, err = hbf.fetchHyperBlockAvroBytes(ctx, request, options)
+			return failureReason, err
+		},
+		incRetries:  hbf.metrics.IncRetries,
+		incFailures: hbf.metrics.IncFailures,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
 func sanityCheckResult(encodedHyperBlocks [][]byte) ([][]byte, error) {
@@ -0,0 +1,153 @@
+package facade
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+)
+
+// GetHyperBlockNonceByTimestamp returns the nonce of the latest hyper block having its timestamp at or before the
+// provided one, found by binary search over nonces
+func (hbf *hyperBlockFacade) GetHyperBlockNonceByTimestamp(ctx context.Context, timestamp int64) (uint64, error) {
+	latestNonce, err := hbf.GetLatestHyperBlockNonce(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	nonce, err := hbf.searchFirstNonce(ctx, 0, latestNonce+1, func(hyperBlock *schema.HyperBlock) bool {
+		return hyperBlock.Timestamp > timestamp
+	})
+	if err != nil {
+		return 0, err
+	}
+	if nonce == 0 {
+		return 0, fmt.Errorf("%w: timestamp %d is before the genesis", api.ErrHyperBlockNotFound, timestamp)
+	}
+
+	return nonce - 1, nil
+}
+
+// GetEpochNoncesInterval returns the nonces of the first and last hyper blocks of the provided epoch. The start nonces
+// of final epochs are stored in the epochs index, such that they are only searched once
+func (hbf *hyperBlockFacade) GetEpochNoncesInterval(ctx context.Context, epoch uint32) (*api.EpochNoncesInterval, error) {
+	latestNonce, err := hbf.GetLatestHyperBlockNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	latestHyperBlock, err := hbf.getProcessedHyperBlockByNonce(ctx, latestNonce)
+	if err != nil {
+		return nil, err
+	}
+	latestEpoch := uint32(latestHyperBlock.Epoch)
+	if epoch > latestEpoch {
+		return nil, fmt.Errorf("%w: epoch %d has not started yet, current epoch: %d", api.ErrHyperBlockNotFound, epoch, latestEpoch)
+	}
+
+	startNonce, err := hbf.getEpochStartNonce(ctx, epoch, 0, latestNonce)
+	if err != nil {
+		return nil, err
+	}
+	if epoch == latestEpoch {
+		return &api.EpochNoncesInterval{
+			Epoch:      epoch,
+			StartNonce: startNonce,
+			EndNonce:   latestNonce,
+			IsComplete: false,
+		}, nil
+	}
+
+	nextEpochStartNonce, err := hbf.getEpochStartNonce(ctx, epoch+1, startNonce+1, latestNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.EpochNoncesInterval{
+		Epoch:      epoch,
+		StartNonce: startNonce,
+		EndNonce:   nextEpochStartNonce - 1,
+		IsComplete: true,
+	}, nil
+}
+
+// getEpochStartNonce returns the nonce of the first hyper block of the provided epoch, searching it in
+// [lowNonce, highNonce] if not found in the epochs index. The hyper block with highNonce should be in or after the
+// provided epoch
+func (hbf *hyperBlockFacade) getEpochStartNonce(ctx context.Context, epoch uint32, lowNonce uint64, highNonce uint64) (uint64, error) {
+	if hbf.epochsIndex.IsEnabled() {
+		startNonce, found := hbf.epochsIndex.GetEpochStartNonce(epoch)
+		if found {
+			return startNonce, nil
+		}
+	}
+
+	startNonce, err := hbf.searchFirstNonce(ctx, lowNonce, highNonce, func(hyperBlock *schema.HyperBlock) bool {
+		return uint32(hyperBlock.Epoch) >= epoch
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if hbf.epochsIndex.IsEnabled() && hbf.isHyperBlockFinal(ctx, startNonce) {
+		err = hbf.epochsIndex.PutEpochStartNonce(epoch, startNonce)
+		if err != nil {
+			log.Warn("could not index epoch start nonce", "epoch", epoch, "nonce", startNonce, "error", err)
+		}
+	}
+
+	return startNonce, nil
+}
+
+// searchFirstNonce returns the first nonce in [lowNonce, highNonce) of the hyper block matching the provided condition,
+// or highNonce if none matches. The condition should be monotonic: once matched by a hyper block, it should also be
+// matched by all the following ones
+func (hbf *hyperBlockFacade) searchFirstNonce(
+	ctx context.Context,
+	lowNonce uint64,
+	highNonce uint64,
+	condition func(hyperBlock *schema.HyperBlock) bool,
+) (uint64, error) {
+	for lowNonce < highNonce {
+		middleNonce := lowNonce + (highNonce-lowNonce)/2
+		hyperBlock, err := hbf.getProcessedHyperBlockByNonce(ctx, middleNonce)
+		if err != nil {
+			return 0, err
+		}
+
+		if condition(hyperBlock) {
+			highNonce = middleNonce
+		} else {
+			lowNonce = middleNonce + 1
+		}
+	}
+
+	return lowNonce, nil
+}
+
+// getProcessedHyperBlockByNonce returns the processed hyper block with the provided nonce, probed by lookups. Unlike
+// the hyper blocks served by the proxy, it is neither cached nor tracked for reorgs
+func (hbf *hyperBlockFacade) getProcessedHyperBlockByNonce(ctx context.Context, nonce uint64) (*schema.HyperBlock, error) {
+	request := hbf.getHyperBlockByNonceFullPath(nonce, config.HyperBlockQueryOptions{})
+	var hyperBlockSchema *schema.HyperBlock
+	err := hbf.retryPolicy.retry(ctx, retriedRequest{
+		name:         "hyperblock",
+		request:      request,
+		errExhausted: errCouldNotGetHyperBlock,
+		attempt: func() (string, error) {
+			var failureReason string
+			var err error
+			_, hyperBlockSchema, failureReason, err = hbf.fetchProcessedHyperBlock(ctx, request)
+			return failureReason, err
+		},
+		incRetries:  hbf.metrics.IncRetries,
+		incFailures: hbf.metrics.IncFailures,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hyperBlockSchema, nil
+}
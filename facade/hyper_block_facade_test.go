@@ -1,14 +1,18 @@
 package facade
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/elodina/go-avro"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-covalent-go/api"
 	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
 	"github.com/multiversx/mx-chain-covalent-go/hyperBlock"
@@ -18,29 +22,48 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func createMockHyperBlockFacadeArgs() *HyperBlockFacadeArgs {
+	return &HyperBlockFacadeArgs{
+		AvroEncoder:                  &mock.AvroEncoderStub{},
+		MultiversxHyperBlockEndpoint: &apiMocks.MultiversxHyperBlockEndPointStub{},
+		HyperBlockProcessor:          &mock.HyperBlockProcessorStub{},
+		HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+		HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+		Metrics:                      &mock.MetricsHandlerStub{},
+		RetryPolicy:                  createMockRetryPolicy(),
+		ChainValidator:               &mock.ChainValidatorStub{},
+		ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+		AddressConverter:             &mock.AddressConverterStub{},
+		EpochsIndex:                  &mock.EpochsIndexStub{},
+		BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+	}
+}
+
+func createMockRetryPolicy() config.RetryPolicy {
+	return config.RetryPolicy{
+		MaxAttempts: 10,
+		BaseDelayMs: 1,
+		MaxDelayMs:  10,
+	}
+}
+
 func TestNewHyperBlockFacade(t *testing.T) {
 	t.Parallel()
 
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
-		facade, err := NewHyperBlockFacade("url", &mock.AvroEncoderStub{}, &apiMocks.MultiversxHyperBlockEndPointStub{}, &mock.HyperBlockProcessorStub{})
+		facade, err := NewHyperBlockFacade(createMockHyperBlockFacadeArgs())
 		require.NotNil(t, facade)
 		require.Nil(t, err)
 	})
 
-	t.Run("empty url, should return error", func(t *testing.T) {
-		t.Parallel()
-
-		facade, err := NewHyperBlockFacade("", &mock.AvroEncoderStub{}, &apiMocks.MultiversxHyperBlockEndPointStub{}, &mock.HyperBlockProcessorStub{})
-		require.Nil(t, facade)
-		require.Equal(t, errEmptyMultiversxProxyUrl, err)
-	})
-
 	t.Run("nil encoder, should return error", func(t *testing.T) {
 		t.Parallel()
 
-		facade, err := NewHyperBlockFacade("url", nil, &apiMocks.MultiversxHyperBlockEndPointStub{}, &mock.HyperBlockProcessorStub{})
+		args := createMockHyperBlockFacadeArgs()
+		args.AvroEncoder = nil
+		facade, err := NewHyperBlockFacade(args)
 		require.Nil(t, facade)
 		require.Equal(t, errNilAvroEncoder, err)
 	})
@@ -48,7 +71,9 @@ func TestNewHyperBlockFacade(t *testing.T) {
 	t.Run("nil multiversx endpoint, should return error", func(t *testing.T) {
 		t.Parallel()
 
-		facade, err := NewHyperBlockFacade("url", &mock.AvroEncoderStub{}, nil, &mock.HyperBlockProcessorStub{})
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = nil
+		facade, err := NewHyperBlockFacade(args)
 		require.Nil(t, facade)
 		require.Equal(t, errNilHyperBlockEndpointHandler, err)
 	})
@@ -56,16 +81,107 @@ func TestNewHyperBlockFacade(t *testing.T) {
 	t.Run("nil processor, should return error", func(t *testing.T) {
 		t.Parallel()
 
-		facade, err := NewHyperBlockFacade("url", &mock.AvroEncoderStub{}, &apiMocks.MultiversxHyperBlockEndPointStub{}, nil)
+		args := createMockHyperBlockFacadeArgs()
+		args.HyperBlockProcessor = nil
+		facade, err := NewHyperBlockFacade(args)
 		require.Nil(t, facade)
 		require.Equal(t, errNilHyperBlockProcessor, err)
 	})
+
+	t.Run("nil hyper blocks cache, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockFacadeArgs()
+		args.HyperBlocksCache = nil
+		facade, err := NewHyperBlockFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errNilHyperBlocksCache, err)
+	})
+
+	t.Run("empty hyper block schema definition, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockFacadeArgs()
+		args.HyperBlockSchemaDefinition = ""
+		facade, err := NewHyperBlockFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errEmptyHyperBlockSchemaDefinition, err)
+	})
+
+	t.Run("nil metrics handler, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockFacadeArgs()
+		args.Metrics = nil
+		facade, err := NewHyperBlockFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errNilMetricsHandler, err)
+	})
+
+	t.Run("nil chain validator, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockFacadeArgs()
+		args.ChainValidator = nil
+		facade, err := NewHyperBlockFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errNilChainValidator, err)
+	})
+
+	t.Run("nil reorgs tracker, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockFacadeArgs()
+		args.ReorgsTracker = nil
+		facade, err := NewHyperBlockFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errNilReorgsTracker, err)
+	})
+
+	t.Run("nil address converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockFacadeArgs()
+		args.AddressConverter = nil
+		facade, err := NewHyperBlockFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errNilAddressConverter, err)
+	})
+
+	t.Run("nil epochs index, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockFacadeArgs()
+		args.EpochsIndex = nil
+		facade, err := NewHyperBlockFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errNilEpochsIndex, err)
+	})
+
+	t.Run("nil balance updates encoder, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockFacadeArgs()
+		args.BalanceUpdatesEncoder = nil
+		facade, err := NewHyperBlockFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errNilBalanceUpdatesEncoder, err)
+	})
+
+	t.Run("invalid retry policy, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockFacadeArgs()
+		args.RetryPolicy.MaxAttempts = 0
+		facade, err := NewHyperBlockFacade(args)
+		require.Nil(t, facade)
+		require.True(t, errors.Is(err, errInvalidRetryMaxAttempts))
+	})
 }
 
 func TestHyperBlockFacade_GetHyperBlockByNonce(t *testing.T) {
 	t.Parallel()
 
-	multiversxProxyUrl := "url"
 	requestedNonce := uint64(4)
 
 	multiversxApiResponse := &api.MultiversxHyperBlockApiResponse{
@@ -76,8 +192,8 @@ func TestHyperBlockFacade_GetHyperBlockByNonce(t *testing.T) {
 		Code:  api.ReturnCodeSuccess,
 	}
 	multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
-		GetHyperBlockCalled: func(path string) (*api.MultiversxHyperBlockApiResponse, error) {
-			require.Equal(t, fmt.Sprintf("%s%s/%d", multiversxProxyUrl, hyperBlockPathByNonce, requestedNonce), path)
+		GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+			require.Equal(t, fmt.Sprintf("%s/%d", hyperBlockPathByNonce, requestedNonce), path)
 			return multiversxApiResponse, nil
 		},
 	}
@@ -100,9 +216,22 @@ func TestHyperBlockFacade_GetHyperBlockByNonce(t *testing.T) {
 		},
 	}
 
-	facade, _ := NewHyperBlockFacade(multiversxProxyUrl, encoder, multiversxEndPoint, processor)
+	facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+		AvroEncoder:                  encoder,
+		MultiversxHyperBlockEndpoint: multiversxEndPoint,
+		HyperBlockProcessor:          processor,
+		HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+		HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+		Metrics:                      &mock.MetricsHandlerStub{},
+		RetryPolicy:                  createMockRetryPolicy(),
+		ChainValidator:               &mock.ChainValidatorStub{},
+		ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+		AddressConverter:             &mock.AddressConverterStub{},
+		EpochsIndex:                  &mock.EpochsIndexStub{},
+		BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+	})
 
-	block, err := facade.GetHyperBlockByNonce(4, config.HyperBlockQueryOptions{})
+	block, err := facade.GetHyperBlockByNonce(context.Background(), 4, config.HyperBlockQueryOptions{})
 	require.Nil(t, err)
 	require.Equal(t, &api.CovalentHyperBlockApiResponse{
 		Data:  encodedBlock,
@@ -114,7 +243,6 @@ func TestHyperBlockFacade_GetHyperBlockByNonce(t *testing.T) {
 func TestHyperBlockFacade_GetHyperBlockByHash(t *testing.T) {
 	t.Parallel()
 
-	multiversxProxyUrl := "url"
 	requestedHash := "hash"
 
 	multiversxApiResponse := &api.MultiversxHyperBlockApiResponse{
@@ -125,8 +253,8 @@ func TestHyperBlockFacade_GetHyperBlockByHash(t *testing.T) {
 		Code:  api.ReturnCodeSuccess,
 	}
 	multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
-		GetHyperBlockCalled: func(path string) (*api.MultiversxHyperBlockApiResponse, error) {
-			require.Equal(t, fmt.Sprintf("%s%s/%s", multiversxProxyUrl, hyperBlockPathByHash, requestedHash), path)
+		GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+			require.Equal(t, fmt.Sprintf("%s/%s", hyperBlockPathByHash, requestedHash), path)
 			return multiversxApiResponse, nil
 		},
 	}
@@ -149,9 +277,22 @@ func TestHyperBlockFacade_GetHyperBlockByHash(t *testing.T) {
 		},
 	}
 
-	facade, _ := NewHyperBlockFacade(multiversxProxyUrl, encoder, multiversxEndPoint, processor)
+	facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+		AvroEncoder:                  encoder,
+		MultiversxHyperBlockEndpoint: multiversxEndPoint,
+		HyperBlockProcessor:          processor,
+		HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+		HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+		Metrics:                      &mock.MetricsHandlerStub{},
+		RetryPolicy:                  createMockRetryPolicy(),
+		ChainValidator:               &mock.ChainValidatorStub{},
+		ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+		AddressConverter:             &mock.AddressConverterStub{},
+		EpochsIndex:                  &mock.EpochsIndexStub{},
+		BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+	})
 
-	block, err := facade.GetHyperBlockByHash(requestedHash, config.HyperBlockQueryOptions{})
+	block, err := facade.GetHyperBlockByHash(context.Background(), requestedHash, config.HyperBlockQueryOptions{})
 	require.Nil(t, err)
 	require.Equal(t, &api.CovalentHyperBlockApiResponse{
 		Data:  encodedBlock,
@@ -204,33 +345,69 @@ func TestHyperBlockFacade_buildUrlWithBlockQueryOptions(t *testing.T) {
 			api.UrlParameterWithLogs,
 		),
 		fullPath)
+
+	fullPath = buildUrlWithBlockQueryOptions(path, config.HyperBlockQueryOptions{
+		WithLogs:           true,
+		FinalOnly:          true,
+		TransactionsFilter: config.TransactionsFilter{Senders: []string{"erd1a"}},
+	})
+	require.Equal(t, fmt.Sprintf("%s?%s=true", path, api.UrlParameterWithLogs), fullPath)
 }
 
-func TestHyperBlockFacade_GetHyperBlock_ErrorCases(t *testing.T) {
+func TestHyperBlockFacade_getOptionsKey(t *testing.T) {
 	t.Parallel()
 
-	multiversxProxyUrl := "url"
+	require.Equal(t, "", getOptionsKey(config.HyperBlockQueryOptions{}))
+	require.Equal(t, "?withLogs=true", getOptionsKey(config.HyperBlockQueryOptions{WithLogs: true}))
+
+	options := config.HyperBlockQueryOptions{
+		WithLogs: true,
+		TransactionsFilter: config.TransactionsFilter{
+			Receivers:      []string{"erd1b", "erd1a"},
+			Functions:      []string{"claim"},
+			MiniBlockTypes: []string{"SmartContractResultBlock"},
+		},
+	}
+	optionsKey := getOptionsKey(options)
+	require.Equal(t, "?function=claim&miniBlockType=SmartContractResultBlock&receiver=erd1a%2Cerd1b&withLogs=true", optionsKey)
+	require.Equal(t, []string{"erd1b", "erd1a"}, options.TransactionsFilter.Receivers)
+
+	options.TransactionsFilter.Receivers = []string{"erd1a", "erd1b"}
+	require.Equal(t, optionsKey, getOptionsKey(options))
+}
+
+func TestHyperBlockFacade_GetHyperBlock_ErrorCases(t *testing.T) {
+	t.Parallel()
 
 	t.Run("cannot get hyper block from endpoint, expect error", func(t *testing.T) {
 		t.Parallel()
 
 		errGetHyperBlock := errors.New("error getting hyper block")
 		multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
-			GetHyperBlockCalled: func(path string) (*api.MultiversxHyperBlockApiResponse, error) {
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
 				return nil, errGetHyperBlock
 			},
 		}
 
-		facade, _ := NewHyperBlockFacade(
-			multiversxProxyUrl,
-			&mock.AvroEncoderStub{},
-			multiversxEndPoint,
-			&mock.HyperBlockProcessorStub{},
-		)
-
-		block, err := facade.getHyperBlock(multiversxProxyUrl)
+		facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+			AvroEncoder:                  &mock.AvroEncoderStub{},
+			MultiversxHyperBlockEndpoint: multiversxEndPoint,
+			HyperBlockProcessor:          &mock.HyperBlockProcessorStub{},
+			HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+			HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+			Metrics:                      &mock.MetricsHandlerStub{},
+			RetryPolicy:                  createMockRetryPolicy(),
+			ChainValidator:               &mock.ChainValidatorStub{},
+			ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+			AddressConverter:             &mock.AddressConverterStub{},
+			EpochsIndex:                  &mock.EpochsIndexStub{},
+			BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+		})
+
+		block, err := facade.getHyperBlock(context.Background(), "path", config.HyperBlockQueryOptions{})
 		require.Nil(t, block)
-		require.Equal(t, errGetHyperBlock, err)
+		require.True(t, errors.Is(err, errCouldNotGetHyperBlock))
+		require.True(t, strings.Contains(err.Error(), errGetHyperBlock.Error()))
 	})
 
 	t.Run("cannot process hyper block, expect error", func(t *testing.T) {
@@ -243,14 +420,22 @@ func TestHyperBlockFacade_GetHyperBlock_ErrorCases(t *testing.T) {
 			},
 		}
 
-		facade, _ := NewHyperBlockFacade(
-			multiversxProxyUrl,
-			&mock.AvroEncoderStub{},
-			&apiMocks.MultiversxHyperBlockEndPointStub{},
-			processor,
-		)
-
-		block, err := facade.getHyperBlock(multiversxProxyUrl)
+		facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+			AvroEncoder:                  &mock.AvroEncoderStub{},
+			MultiversxHyperBlockEndpoint: &apiMocks.MultiversxHyperBlockEndPointStub{},
+			HyperBlockProcessor:          processor,
+			HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+			HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+			Metrics:                      &mock.MetricsHandlerStub{},
+			RetryPolicy:                  createMockRetryPolicy(),
+			ChainValidator:               &mock.ChainValidatorStub{},
+			ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+			AddressConverter:             &mock.AddressConverterStub{},
+			EpochsIndex:                  &mock.EpochsIndexStub{},
+			BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+		})
+
+		block, err := facade.getHyperBlock(context.Background(), "path", config.HyperBlockQueryOptions{})
 		require.Nil(t, block)
 		require.Equal(t, errProcessor, err)
 	})
@@ -265,20 +450,29 @@ func TestHyperBlockFacade_GetHyperBlock_ErrorCases(t *testing.T) {
 			},
 		}
 
-		facade, _ := NewHyperBlockFacade(
-			multiversxProxyUrl,
-			encoder,
-			&apiMocks.MultiversxHyperBlockEndPointStub{},
-			&mock.HyperBlockProcessorStub{},
-		)
-
-		block, err := facade.getHyperBlock(multiversxProxyUrl)
+		facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+			AvroEncoder:                  encoder,
+			MultiversxHyperBlockEndpoint: &apiMocks.MultiversxHyperBlockEndPointStub{},
+			HyperBlockProcessor:          &mock.HyperBlockProcessorStub{},
+			HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+			HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+			Metrics:                      &mock.MetricsHandlerStub{},
+			RetryPolicy:                  createMockRetryPolicy(),
+			ChainValidator:               &mock.ChainValidatorStub{},
+			ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+			AddressConverter:             &mock.AddressConverterStub{},
+			EpochsIndex:                  &mock.EpochsIndexStub{},
+			BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+		})
+
+		block, err := facade.getHyperBlock(context.Background(), "path", config.HyperBlockQueryOptions{})
 		require.Nil(t, block)
 		require.Equal(t, errEncoder, err)
 	})
 }
 
 func getNonceFromRequest(t *testing.T, request string) uint64 {
+	request = strings.Split(request, "?")[0]
 	splits := strings.Split(request, "/")
 	numSplits := len(splits)
 	require.True(t, numSplits >= 1)
@@ -297,8 +491,6 @@ func requireNonceInInterval(t *testing.T, nonce uint64, interval *api.Interval)
 func TestHyperBlockFacade_GetHyperBlocksByInterval(t *testing.T) {
 	t.Parallel()
 
-	multiversxProxyUrl := "url"
-
 	interval := &api.Interval{
 		Start: 4,
 		End:   45,
@@ -310,12 +502,12 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval(t *testing.T) {
 	encodeCt := uint64(0)
 
 	multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
-		GetHyperBlockCalled: func(path string) (*api.MultiversxHyperBlockApiResponse, error) {
+		GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
 			atomic.AddUint64(&multiversxEndPointCallsCt, 1)
 
 			nonceFromRequest := getNonceFromRequest(t, path)
 			requireNonceInInterval(t, nonceFromRequest, interval)
-			require.Equal(t, fmt.Sprintf("%s%s/%d", multiversxProxyUrl, hyperBlockPathByNonce, nonceFromRequest), path)
+			require.Equal(t, fmt.Sprintf("%s/%d", hyperBlockPathByNonce, nonceFromRequest), path)
 
 			return &api.MultiversxHyperBlockApiResponse{
 				Data: api.MultiversxHyperBlockApiResponsePayload{
@@ -352,7 +544,20 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval(t *testing.T) {
 		},
 	}
 
-	facade, _ := NewHyperBlockFacade(multiversxProxyUrl, encoder, multiversxEndPoint, processor)
+	facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+		AvroEncoder:                  encoder,
+		MultiversxHyperBlockEndpoint: multiversxEndPoint,
+		HyperBlockProcessor:          processor,
+		HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+		HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+		Metrics:                      &mock.MetricsHandlerStub{},
+		RetryPolicy:                  createMockRetryPolicy(),
+		ChainValidator:               &mock.ChainValidatorStub{},
+		ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+		AddressConverter:             &mock.AddressConverterStub{},
+		EpochsIndex:                  &mock.EpochsIndexStub{},
+		BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+	})
 
 	expectedEncodedHyperBlocks := make([][]byte, 0)
 	for nonce := interval.Start; nonce <= interval.End; nonce++ {
@@ -362,7 +567,7 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval(t *testing.T) {
 	options := config.HyperBlocksQueryOptions{
 		BatchSize: 10,
 	}
-	blocks, err := facade.GetHyperBlocksByInterval(interval, options)
+	blocks, err := facade.GetHyperBlocksByInterval(context.Background(), interval, options)
 	require.Nil(t, err)
 	require.Equal(t, &api.CovalentHyperBlocksApiResponse{
 		Data:  expectedEncodedHyperBlocks,
@@ -378,8 +583,6 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval(t *testing.T) {
 func TestHyperBlockFacade_GetHyperBlocksByInterval_CouldNotFetchAllHyperBlocks_ExpectError(t *testing.T) {
 	t.Parallel()
 
-	multiversxProxyUrl := "url"
-
 	interval := &api.Interval{
 		Start: 4,
 		End:   45,
@@ -391,13 +594,14 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_CouldNotFetchAllHyperBlocks_E
 
 	expectedErr := errors.New("could not get hyper block")
 	invalidNonce := uint64(40)
+	maxAttempts := uint64(createMockRetryPolicy().MaxAttempts)
 	multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
-		GetHyperBlockCalled: func(path string) (*api.MultiversxHyperBlockApiResponse, error) {
+		GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
 			atomic.AddUint64(&multiversxEndPointCallsCt, 1)
 
 			nonceFromRequest := getNonceFromRequest(t, path)
 			requireNonceInInterval(t, nonceFromRequest, interval)
-			require.Equal(t, fmt.Sprintf("%s%s/%d", multiversxProxyUrl, hyperBlockPathByNonce, nonceFromRequest), path)
+			require.Equal(t, fmt.Sprintf("%s/%d", hyperBlockPathByNonce, nonceFromRequest), path)
 
 			if nonceFromRequest == invalidNonce {
 				return nil, expectedErr
@@ -438,7 +642,20 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_CouldNotFetchAllHyperBlocks_E
 		},
 	}
 
-	facade, _ := NewHyperBlockFacade(multiversxProxyUrl, encoder, multiversxEndPoint, processor)
+	facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+		AvroEncoder:                  encoder,
+		MultiversxHyperBlockEndpoint: multiversxEndPoint,
+		HyperBlockProcessor:          processor,
+		HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+		HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+		Metrics:                      &mock.MetricsHandlerStub{},
+		RetryPolicy:                  createMockRetryPolicy(),
+		ChainValidator:               &mock.ChainValidatorStub{},
+		ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+		AddressConverter:             &mock.AddressConverterStub{},
+		EpochsIndex:                  &mock.EpochsIndexStub{},
+		BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+	})
 
 	expectedEncodedHyperBlocks := make([][]byte, 0)
 	for nonce := interval.Start; nonce <= interval.End; nonce++ {
@@ -449,23 +666,21 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_CouldNotFetchAllHyperBlocks_E
 	options := config.HyperBlocksQueryOptions{
 		BatchSize: 10,
 	}
-	blocks, err := facade.GetHyperBlocksByInterval(interval, options)
+	blocks, err := facade.GetHyperBlocksByInterval(context.Background(), interval, options)
 	require.Nil(t, blocks)
 	require.True(t, strings.Contains(err.Error(), errCouldNotGetHyperBlock.Error()))
 	require.True(t, strings.Contains(err.Error(), expectedErr.Error()))
-	require.True(t, strings.Contains(err.Error(), fmt.Sprintf("%s%s/%d", multiversxProxyUrl, hyperBlockPathByNonce, invalidNonce)))
-	require.True(t, strings.Contains(err.Error(), fmt.Sprintf("%d", maxRequestsRetrial)))
+	require.True(t, strings.Contains(err.Error(), fmt.Sprintf("%s/%d", hyperBlockPathByNonce, invalidNonce)))
+	require.True(t, strings.Contains(err.Error(), fmt.Sprintf("%d", maxAttempts)))
 
-	require.Equal(t, uint64(41)+maxRequestsRetrial, multiversxEndPointCallsCt) // 41 calls in [4,40] + maxRequestsRetrial
-	require.Equal(t, uint64(41), processHyperBlocksCt)                         // 41 calls in [4,40]
-	require.Equal(t, uint64(41), encodeCt)                                     // 41 calls in [4,40]
+	require.Equal(t, uint64(41)+maxAttempts, multiversxEndPointCallsCt) // 41 calls in [4,40] + maxAttempts
+	require.Equal(t, uint64(41), processHyperBlocksCt)                  // 41 calls in [4,40]
+	require.Equal(t, uint64(41), encodeCt)                              // 41 calls in [4,40]
 }
 
 func TestHyperBlockFacade_GetHyperBlocksByInterval_GetHyperBlockAfterNumRetrials(t *testing.T) {
 	t.Parallel()
 
-	multiversxProxyUrl := "url"
-
 	interval := &api.Interval{
 		Start: 4,
 		End:   45,
@@ -479,14 +694,14 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_GetHyperBlockAfterNumRetrials
 	expectedErr := errors.New("could not get hyper block")
 	invalidNonce := uint64(40)
 	numRetrials := 0
-	maxNumRetrials := maxRequestsRetrial / 2
+	maxNumRetrials := int(createMockRetryPolicy().MaxAttempts / 2)
 	multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
-		GetHyperBlockCalled: func(path string) (*api.MultiversxHyperBlockApiResponse, error) {
+		GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
 			atomic.AddUint64(&multiversxEndPointCallsCt, 1)
 
 			nonceFromRequest := getNonceFromRequest(t, path)
 			requireNonceInInterval(t, nonceFromRequest, interval)
-			require.Equal(t, fmt.Sprintf("%s%s/%d", multiversxProxyUrl, hyperBlockPathByNonce, nonceFromRequest), path)
+			require.Equal(t, fmt.Sprintf("%s/%d", hyperBlockPathByNonce, nonceFromRequest), path)
 
 			if nonceFromRequest == invalidNonce && numRetrials < maxNumRetrials {
 				numRetrials++
@@ -528,7 +743,20 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_GetHyperBlockAfterNumRetrials
 		},
 	}
 
-	facade, _ := NewHyperBlockFacade(multiversxProxyUrl, encoder, multiversxEndPoint, processor)
+	facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+		AvroEncoder:                  encoder,
+		MultiversxHyperBlockEndpoint: multiversxEndPoint,
+		HyperBlockProcessor:          processor,
+		HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+		HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+		Metrics:                      &mock.MetricsHandlerStub{},
+		RetryPolicy:                  createMockRetryPolicy(),
+		ChainValidator:               &mock.ChainValidatorStub{},
+		ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+		AddressConverter:             &mock.AddressConverterStub{},
+		EpochsIndex:                  &mock.EpochsIndexStub{},
+		BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+	})
 
 	expectedEncodedHyperBlocks := make([][]byte, 0)
 	for nonce := interval.Start; nonce <= interval.End; nonce++ {
@@ -538,7 +766,7 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_GetHyperBlockAfterNumRetrials
 	options := config.HyperBlocksQueryOptions{
 		BatchSize: 10,
 	}
-	blocks, err := facade.GetHyperBlocksByInterval(interval, options)
+	blocks, err := facade.GetHyperBlocksByInterval(context.Background(), interval, options)
 	require.Nil(t, err)
 	require.Equal(t, &api.CovalentHyperBlocksApiResponse{
 		Data:  expectedEncodedHyperBlocks,
@@ -551,17 +779,134 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_GetHyperBlockAfterNumRetrials
 	require.Equal(t, numNonces, encodeCt)
 }
 
+func TestHyperBlockFacade_GetPartialHyperBlocksByInterval(t *testing.T) {
+	t.Parallel()
+
+	interval := &api.Interval{
+		Start: 4,
+		End:   9,
+	}
+	errNotFound := fmt.Errorf("%w: 404 Not Found", api.ErrNonRetryableResponse)
+	createArgs := func(invalidNonces map[uint64]struct{}) *HyperBlockFacadeArgs {
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				nonce := getNonceFromRequest(t, path)
+				if _, isInvalid := invalidNonces[nonce]; isInvalid {
+					return nil, errNotFound
+				}
+
+				return &api.MultiversxHyperBlockApiResponse{
+					Data: api.MultiversxHyperBlockApiResponsePayload{
+						HyperBlock: hyperBlock.HyperBlock{Nonce: nonce},
+					},
+				}, nil
+			},
+		}
+		args.HyperBlockProcessor = &mock.HyperBlockProcessorStub{
+			ProcessCalled: func(hyperBlock *hyperBlock.HyperBlock) (*schema.HyperBlock, error) {
+				return &schema.HyperBlock{Nonce: int64(hyperBlock.Nonce)}, nil
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return []byte(fmt.Sprintf("encodedBlock%d", record.(*schema.HyperBlock).Nonce)), nil
+			},
+		}
+
+		return args
+	}
+	options := config.HyperBlocksQueryOptions{
+		BatchSize: 2,
+	}
+
+	t.Run("all hyper blocks fetched, should return success", func(t *testing.T) {
+		t.Parallel()
+
+		facade, _ := NewHyperBlockFacade(createArgs(nil))
+		blocks, err := facade.GetPartialHyperBlocksByInterval(context.Background(), interval, options)
+		require.Nil(t, err)
+		require.Equal(t, api.ReturnCodeSuccess, blocks.Code)
+		require.Empty(t, blocks.Error)
+		require.Len(t, blocks.Data, 6)
+		for idx, block := range blocks.Data {
+			nonce := interval.Start + uint64(idx)
+			require.Equal(t, api.CovalentHyperBlockResult{
+				Nonce: nonce,
+				Data:  []byte(fmt.Sprintf("encodedBlock%d", nonce)),
+				Error: "",
+				Code:  api.ReturnCodeSuccess,
+			}, block)
+		}
+	})
+
+	t.Run("some hyper blocks failed, should return the other ones", func(t *testing.T) {
+		t.Parallel()
+
+		facade, _ := NewHyperBlockFacade(createArgs(map[uint64]struct{}{5: {}, 8: {}}))
+		blocks, err := facade.GetPartialHyperBlocksByInterval(context.Background(), interval, options)
+		require.Nil(t, err)
+		require.Equal(t, api.ReturnCodePartialSuccess, blocks.Code)
+		require.True(t, strings.Contains(blocks.Error, errCouldNotGetAllHyperBlocks.Error()))
+		require.True(t, strings.Contains(blocks.Error, "2 out of 6"))
+		require.Len(t, blocks.Data, 6)
+		for idx, block := range blocks.Data {
+			nonce := interval.Start + uint64(idx)
+			require.Equal(t, nonce, block.Nonce)
+			if nonce == 5 || nonce == 8 {
+				require.Nil(t, block.Data)
+				require.Equal(t, errNotFound.Error(), block.Error)
+				require.Equal(t, api.ReturnCodeInternalError, block.Code)
+				continue
+			}
+
+			require.Equal(t, []byte(fmt.Sprintf("encodedBlock%d", nonce)), block.Data)
+			require.Equal(t, api.ReturnCodeSuccess, block.Code)
+		}
+	})
+
+	t.Run("invalid interval, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		facade, _ := NewHyperBlockFacade(createArgs(nil))
+		blocks, err := facade.GetPartialHyperBlocksByInterval(context.Background(), &api.Interval{Start: 5, End: 4}, options)
+		require.Nil(t, blocks)
+		require.Equal(t, errInvalidNoncesInterval, err)
+	})
+
+	t.Run("cancelled context, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		facade, _ := NewHyperBlockFacade(createArgs(nil))
+		blocks, err := facade.GetPartialHyperBlocksByInterval(ctx, interval, options)
+		require.Nil(t, blocks)
+		require.Equal(t, context.Canceled, err)
+	})
+}
+
 func TestHyperBlockFacade_GetHyperBlocksByInterval_IntervalEdgeCases(t *testing.T) {
 	t.Parallel()
 
 	t.Run("invalid nonces interval, should return error", func(t *testing.T) {
 		t.Parallel()
 
-		facade, _ := NewHyperBlockFacade("url",
-			&mock.AvroEncoderStub{},
-			&apiMocks.MultiversxHyperBlockEndPointStub{},
-			&mock.HyperBlockProcessorStub{},
-		)
+		facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+			AvroEncoder:                  &mock.AvroEncoderStub{},
+			MultiversxHyperBlockEndpoint: &apiMocks.MultiversxHyperBlockEndPointStub{},
+			HyperBlockProcessor:          &mock.HyperBlockProcessorStub{},
+			HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+			HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+			Metrics:                      &mock.MetricsHandlerStub{},
+			RetryPolicy:                  createMockRetryPolicy(),
+			ChainValidator:               &mock.ChainValidatorStub{},
+			ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+			AddressConverter:             &mock.AddressConverterStub{},
+			EpochsIndex:                  &mock.EpochsIndexStub{},
+			BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+		})
 
 		interval := &api.Interval{
 			Start: 10,
@@ -570,7 +915,7 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_IntervalEdgeCases(t *testing.
 		options := config.HyperBlocksQueryOptions{
 			BatchSize: 10,
 		}
-		blocks, err := facade.GetHyperBlocksByInterval(interval, options)
+		blocks, err := facade.GetHyperBlocksByInterval(context.Background(), interval, options)
 		require.Nil(t, blocks)
 		require.Equal(t, errInvalidNoncesInterval, err)
 	})
@@ -578,11 +923,20 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_IntervalEdgeCases(t *testing.
 	t.Run("invalid batch size, should return error", func(t *testing.T) {
 		t.Parallel()
 
-		facade, _ := NewHyperBlockFacade("url",
-			&mock.AvroEncoderStub{},
-			&apiMocks.MultiversxHyperBlockEndPointStub{},
-			&mock.HyperBlockProcessorStub{},
-		)
+		facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+			AvroEncoder:                  &mock.AvroEncoderStub{},
+			MultiversxHyperBlockEndpoint: &apiMocks.MultiversxHyperBlockEndPointStub{},
+			HyperBlockProcessor:          &mock.HyperBlockProcessorStub{},
+			HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+			HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+			Metrics:                      &mock.MetricsHandlerStub{},
+			RetryPolicy:                  createMockRetryPolicy(),
+			ChainValidator:               &mock.ChainValidatorStub{},
+			ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+			AddressConverter:             &mock.AddressConverterStub{},
+			EpochsIndex:                  &mock.EpochsIndexStub{},
+			BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+		})
 
 		interval := &api.Interval{
 			Start: 10,
@@ -591,7 +945,7 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_IntervalEdgeCases(t *testing.
 		options := config.HyperBlocksQueryOptions{
 			BatchSize: 0,
 		}
-		blocks, err := facade.GetHyperBlocksByInterval(interval, options)
+		blocks, err := facade.GetHyperBlocksByInterval(context.Background(), interval, options)
 		require.Nil(t, blocks)
 		require.Equal(t, errInvalidBatchSize, err)
 	})
@@ -605,11 +959,20 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_IntervalEdgeCases(t *testing.
 				return encodedHyperBlock, nil
 			},
 		}
-		facade, _ := NewHyperBlockFacade("url",
-			encoder,
-			&apiMocks.MultiversxHyperBlockEndPointStub{},
-			&mock.HyperBlockProcessorStub{},
-		)
+		facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+			AvroEncoder:                  encoder,
+			MultiversxHyperBlockEndpoint: &apiMocks.MultiversxHyperBlockEndPointStub{},
+			HyperBlockProcessor:          &mock.HyperBlockProcessorStub{},
+			HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+			HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+			Metrics:                      &mock.MetricsHandlerStub{},
+			RetryPolicy:                  createMockRetryPolicy(),
+			ChainValidator:               &mock.ChainValidatorStub{},
+			ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+			AddressConverter:             &mock.AddressConverterStub{},
+			EpochsIndex:                  &mock.EpochsIndexStub{},
+			BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+		})
 
 		interval := &api.Interval{
 			Start: 10,
@@ -618,7 +981,7 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_IntervalEdgeCases(t *testing.
 		options := config.HyperBlocksQueryOptions{
 			BatchSize: 10,
 		}
-		blocks, err := facade.GetHyperBlocksByInterval(interval, options)
+		blocks, err := facade.GetHyperBlocksByInterval(context.Background(), interval, options)
 		require.Nil(t, err)
 		require.Equal(t, &api.CovalentHyperBlocksApiResponse{
 			Data:  [][]byte{encodedHyperBlock},
@@ -627,3 +990,1678 @@ func TestHyperBlockFacade_GetHyperBlocksByInterval_IntervalEdgeCases(t *testing.
 		}, blocks)
 	})
 }
+
+func TestHyperBlockFacade_GetLatestHyperBlockNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				require.Equal(t, fmt.Sprintf("%s/%d", networkStatusPath, core.MetachainShardId), path)
+				return &api.MultiversxNetworkStatusApiResponse{
+					Data: api.MultiversxNetworkStatusApiResponsePayload{
+						Status: api.NetworkStatus{
+							Nonce:             44,
+							HighestFinalNonce: 43,
+						},
+					},
+				}, nil
+			},
+		}
+
+		facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+			AvroEncoder:                  &mock.AvroEncoderStub{},
+			MultiversxHyperBlockEndpoint: multiversxEndPoint,
+			HyperBlockProcessor:          &mock.HyperBlockProcessorStub{},
+			HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+			HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+			Metrics:                      &mock.MetricsHandlerStub{},
+			RetryPolicy:                  createMockRetryPolicy(),
+			ChainValidator:               &mock.ChainValidatorStub{},
+			ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+			AddressConverter:             &mock.AddressConverterStub{},
+			EpochsIndex:                  &mock.EpochsIndexStub{},
+			BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+		})
+		nonce, err := facade.GetLatestHyperBlockNonce(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, uint64(44), nonce)
+	})
+
+	t.Run("cannot get network status from endpoint, expect error", func(t *testing.T) {
+		t.Parallel()
+
+		errGetNetworkStatus := errors.New("error getting network status")
+		multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				return nil, errGetNetworkStatus
+			},
+		}
+
+		facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+			AvroEncoder:                  &mock.AvroEncoderStub{},
+			MultiversxHyperBlockEndpoint: multiversxEndPoint,
+			HyperBlockProcessor:          &mock.HyperBlockProcessorStub{},
+			HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+			HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+			Metrics:                      &mock.MetricsHandlerStub{},
+			RetryPolicy:                  createMockRetryPolicy(),
+			ChainValidator:               &mock.ChainValidatorStub{},
+			ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+			AddressConverter:             &mock.AddressConverterStub{},
+			EpochsIndex:                  &mock.EpochsIndexStub{},
+			BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+		})
+		nonce, err := facade.GetLatestHyperBlockNonce(context.Background())
+		require.Equal(t, errGetNetworkStatus, err)
+		require.Equal(t, uint64(0), nonce)
+	})
+}
+
+func TestHyperBlockFacade_GetHyperBlocksContainerByInterval(t *testing.T) {
+	t.Parallel()
+
+	interval := &api.Interval{
+		Start: 4,
+		End:   6,
+	}
+	options := config.HyperBlocksQueryOptions{
+		BatchSize: 10,
+	}
+	processor := &mock.HyperBlockProcessorStub{
+		ProcessCalled: func(hyperBlock *hyperBlock.HyperBlock) (*schema.HyperBlock, error) {
+			return &schema.HyperBlock{
+				Nonce: int64(hyperBlock.Nonce),
+			}, nil
+		},
+	}
+	multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
+		GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+			return &api.MultiversxHyperBlockApiResponse{
+				Data: api.MultiversxHyperBlockApiResponsePayload{
+					HyperBlock: hyperBlock.HyperBlock{
+						Nonce: getNonceFromRequest(t, path),
+					}},
+			}, nil
+		},
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		container := []byte("container")
+		encoder := &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				hyperBlockRecord := record.(*schema.HyperBlock)
+				return []byte(fmt.Sprintf("encodedBlock%d", hyperBlockRecord.Nonce)), nil
+			},
+			EncodeContainerCalled: func(schemaDefinition string, codec string, encodedRecords [][]byte) ([]byte, error) {
+				require.Equal(t, schema.HyperBlockSchemaDefinition, schemaDefinition)
+				require.Equal(t, "deflate", codec)
+				require.Equal(t, [][]byte{
+					[]byte("encodedBlock4"),
+					[]byte("encodedBlock5"),
+					[]byte("encodedBlock6"),
+				}, encodedRecords)
+				return container, nil
+			},
+		}
+
+		facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+			AvroEncoder:                  encoder,
+			MultiversxHyperBlockEndpoint: multiversxEndPoint,
+			HyperBlockProcessor:          processor,
+			HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+			HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+			Metrics:                      &mock.MetricsHandlerStub{},
+			RetryPolicy:                  createMockRetryPolicy(),
+			ChainValidator:               &mock.ChainValidatorStub{},
+			ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+			AddressConverter:             &mock.AddressConverterStub{},
+			EpochsIndex:                  &mock.EpochsIndexStub{},
+			BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+		})
+		ret, err := facade.GetHyperBlocksContainerByInterval(context.Background(), interval, options, "deflate")
+		require.Nil(t, err)
+		require.Equal(t, container, ret)
+	})
+
+	t.Run("invalid nonces interval, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		encodeContainerCalled := false
+		encoder := &mock.AvroEncoderStub{
+			EncodeContainerCalled: func(schemaDefinition string, codec string, encodedRecords [][]byte) ([]byte, error) {
+				encodeContainerCalled = true
+				return nil, nil
+			},
+		}
+
+		facade, _ := NewHyperBlockFacade(&HyperBlockFacadeArgs{
+			AvroEncoder:                  encoder,
+			MultiversxHyperBlockEndpoint: multiversxEndPoint,
+			HyperBlockProcessor:          processor,
+			HyperBlocksCache:             &mock.HyperBlocksCacheStub{},
+			HyperBlockSchemaDefinition:   schema.HyperBlockSchemaDefinition,
+			Metrics:                      &mock.MetricsHandlerStub{},
+			RetryPolicy:                  createMockRetryPolicy(),
+			ChainValidator:               &mock.ChainValidatorStub{},
+			ReorgsTracker:                &apiMocks.ReorgsTrackerStub{},
+			AddressConverter:             &mock.AddressConverterStub{},
+			EpochsIndex:                  &mock.EpochsIndexStub{},
+			BalanceUpdatesEncoder:        &mock.AvroEncoderStub{},
+		})
+		ret, err := facade.GetHyperBlocksContainerByInterval(context.Background(), &api.Interval{Start: 5, End: 4}, options, "deflate")
+		require.Nil(t, ret)
+		require.Equal(t, errInvalidNoncesInterval, err)
+		require.False(t, encodeContainerCalled)
+	})
+}
+
+func TestHyperBlockFacade_HyperBlocksCache(t *testing.T) {
+	t.Parallel()
+
+	options := config.HyperBlockQueryOptions{WithLogs: true}
+	expectedOptionsKey := "?withLogs=true"
+
+	t.Run("hyper block found in cache by nonce, should not request it", func(t *testing.T) {
+		t.Parallel()
+
+		cachedHyperBlock := []byte("cachedHyperBlock")
+		hyperBlocksCache := &mock.HyperBlocksCacheStub{
+			GetByNonceCalled: func(nonce uint64, optionsKey string) ([]byte, bool) {
+				require.Equal(t, uint64(4), nonce)
+				require.Equal(t, expectedOptionsKey, optionsKey)
+				return cachedHyperBlock, true
+			},
+		}
+		multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				require.Fail(t, "should not request hyper block")
+				return nil, nil
+			},
+		}
+
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = multiversxEndPoint
+		args.HyperBlocksCache = hyperBlocksCache
+		facade, _ := NewHyperBlockFacade(args)
+
+		block, err := facade.GetHyperBlockByNonce(context.Background(), 4, options)
+		require.Nil(t, err)
+		require.Equal(t, cachedHyperBlock, block.Data)
+	})
+
+	t.Run("hyper block found in cache by hash, should not request it", func(t *testing.T) {
+		t.Parallel()
+
+		cachedHyperBlock := []byte("cachedHyperBlock")
+		hyperBlocksCache := &mock.HyperBlocksCacheStub{
+			GetByHashCalled: func(hash string, optionsKey string) ([]byte, bool) {
+				require.Equal(t, "abcd", hash)
+				require.Equal(t, expectedOptionsKey, optionsKey)
+				return cachedHyperBlock, true
+			},
+		}
+		multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				require.Fail(t, "should not request hyper block")
+				return nil, nil
+			},
+		}
+
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = multiversxEndPoint
+		args.HyperBlocksCache = hyperBlocksCache
+		facade, _ := NewHyperBlockFacade(args)
+
+		block, err := facade.GetHyperBlockByHash(context.Background(), "abcd", options)
+		require.Nil(t, err)
+		require.Equal(t, cachedHyperBlock, block.Data)
+	})
+
+	t.Run("only final hyper blocks should be cached", func(t *testing.T) {
+		t.Parallel()
+
+		highestFinalNonce := uint64(5)
+		networkStatusCalledCt := uint32(0)
+		multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				nonce := getNonceFromRequest(t, path)
+				return &api.MultiversxHyperBlockApiResponse{
+					Data: api.MultiversxHyperBlockApiResponsePayload{
+						HyperBlock: hyperBlock.HyperBlock{
+							Nonce: nonce,
+							Hash:  fmt.Sprintf("hash%d", nonce),
+						}},
+				}, nil
+			},
+			GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				atomic.AddUint32(&networkStatusCalledCt, 1)
+				require.Equal(t, fmt.Sprintf("%s/%d", networkStatusPath, core.MetachainShardId), path)
+				return &api.MultiversxNetworkStatusApiResponse{
+					Data: api.MultiversxNetworkStatusApiResponsePayload{
+						Status: api.NetworkStatus{
+							Nonce:             highestFinalNonce + 1,
+							HighestFinalNonce: highestFinalNonce,
+						},
+					},
+				}, nil
+			},
+		}
+		encoder := &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return []byte(fmt.Sprintf("encodedBlock%d", record.(*schema.HyperBlock).Nonce)), nil
+			},
+		}
+		processor := &mock.HyperBlockProcessorStub{
+			ProcessCalled: func(hyperBlock *hyperBlock.HyperBlock) (*schema.HyperBlock, error) {
+				return &schema.HyperBlock{
+					Nonce: int64(hyperBlock.Nonce),
+				}, nil
+			},
+		}
+
+		cachedHyperBlocks := make(map[uint64][]byte)
+		hyperBlocksCache := &mock.HyperBlocksCacheStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			PutCalled: func(nonce uint64, hash string, optionsKey string, encodedHyperBlock []byte) error {
+				require.Equal(t, fmt.Sprintf("hash%d", nonce), hash)
+				require.Equal(t, expectedOptionsKey, optionsKey)
+				cachedHyperBlocks[nonce] = encodedHyperBlock
+				return nil
+			},
+		}
+
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = multiversxEndPoint
+		args.AvroEncoder = encoder
+		args.HyperBlockProcessor = processor
+		args.HyperBlocksCache = hyperBlocksCache
+		facade, _ := NewHyperBlockFacade(args)
+
+		for nonce := uint64(3); nonce <= 6; nonce++ {
+			_, err := facade.GetHyperBlockByNonce(context.Background(), nonce, options)
+			require.Nil(t, err)
+		}
+
+		require.Equal(t, map[uint64][]byte{
+			3: []byte("encodedBlock3"),
+			4: []byte("encodedBlock4"),
+			5: []byte("encodedBlock5"),
+		}, cachedHyperBlocks)
+		// nonce 3 fetches the highest final nonce, nonces 4 and 5 reuse it, nonce 6 is above it and refreshes it
+		require.Equal(t, uint32(2), networkStatusCalledCt)
+	})
+
+	t.Run("reverted hyper block should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				return &api.MultiversxHyperBlockApiResponse{
+					Data: api.MultiversxHyperBlockApiResponsePayload{
+						HyperBlock: hyperBlock.HyperBlock{
+							Nonce:  4,
+							Status: hyperBlockStatusReverted,
+						}},
+				}, nil
+			},
+		}
+		hyperBlocksCache := &mock.HyperBlocksCacheStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			PutCalled: func(nonce uint64, hash string, optionsKey string, encodedHyperBlock []byte) error {
+				require.Fail(t, "should not cache reverted hyper block")
+				return nil
+			},
+		}
+
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = multiversxEndPoint
+		args.HyperBlocksCache = hyperBlocksCache
+		facade, _ := NewHyperBlockFacade(args)
+
+		_, err := facade.GetHyperBlockByHash(context.Background(), "abcd", options)
+		require.Nil(t, err)
+	})
+
+	t.Run("interval should use cached hyper blocks and only request missing ones", func(t *testing.T) {
+		t.Parallel()
+
+		requestedNonces := make([]uint64, 0)
+		mut := sync.Mutex{}
+		multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				nonce := getNonceFromRequest(t, path)
+				mut.Lock()
+				requestedNonces = append(requestedNonces, nonce)
+				mut.Unlock()
+
+				return &api.MultiversxHyperBlockApiResponse{
+					Data: api.MultiversxHyperBlockApiResponsePayload{
+						HyperBlock: hyperBlock.HyperBlock{
+							Nonce: nonce,
+						}},
+				}, nil
+			},
+		}
+		encoder := &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return []byte(fmt.Sprintf("encodedBlock%d", record.(*schema.HyperBlock).Nonce)), nil
+			},
+		}
+		processor := &mock.HyperBlockProcessorStub{
+			ProcessCalled: func(hyperBlock *hyperBlock.HyperBlock) (*schema.HyperBlock, error) {
+				return &schema.HyperBlock{
+					Nonce: int64(hyperBlock.Nonce),
+				}, nil
+			},
+		}
+		hyperBlocksCache := &mock.HyperBlocksCacheStub{
+			GetByNonceCalled: func(nonce uint64, optionsKey string) ([]byte, bool) {
+				require.Equal(t, expectedOptionsKey, optionsKey)
+				if nonce%2 == 0 {
+					return []byte(fmt.Sprintf("cachedBlock%d", nonce)), true
+				}
+				return nil, false
+			},
+		}
+
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = multiversxEndPoint
+		args.AvroEncoder = encoder
+		args.HyperBlockProcessor = processor
+		args.HyperBlocksCache = hyperBlocksCache
+		facade, _ := NewHyperBlockFacade(args)
+
+		blocks, err := facade.GetHyperBlocksByInterval(context.Background(), &api.Interval{Start: 4, End: 7}, config.HyperBlocksQueryOptions{
+			QueryOptions: options,
+			BatchSize:    10,
+		})
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{
+			[]byte("cachedBlock4"),
+			[]byte("encodedBlock5"),
+			[]byte("cachedBlock6"),
+			[]byte("encodedBlock7"),
+		}, blocks.Data)
+		require.ElementsMatch(t, []uint64{5, 7}, requestedNonces)
+	})
+}
+
+type metricsRecorder struct {
+	mutex                 sync.Mutex
+	upstreamRequests      map[string]int
+	upstreamErrors        int
+	encodedSizes          []int
+	retries               map[string]int
+	failures              map[string]int
+	inFlightBatchRequests int
+	maxInFlightRequests   int
+}
+
+func newMetricsRecorder() *metricsRecorder {
+	return &metricsRecorder{
+		upstreamRequests: make(map[string]int),
+		retries:          make(map[string]int),
+		failures:         make(map[string]int),
+	}
+}
+
+func (mr *metricsRecorder) createMetricsHandlerStub() *mock.MetricsHandlerStub {
+	return &mock.MetricsHandlerStub{
+		ObserveUpstreamRequestCalled: func(endpoint string, duration time.Duration, err error) {
+			mr.mutex.Lock()
+			defer mr.mutex.Unlock()
+
+			mr.upstreamRequests[endpoint]++
+			if err != nil {
+				mr.upstreamErrors++
+			}
+		},
+		ObserveEncodedHyperBlockSizeCalled: func(size int) {
+			mr.mutex.Lock()
+			defer mr.mutex.Unlock()
+
+			mr.encodedSizes = append(mr.encodedSizes, size)
+		},
+		IncRetriesCalled: func(reason string) {
+			mr.mutex.Lock()
+			defer mr.mutex.Unlock()
+
+			mr.retries[reason]++
+		},
+		IncFailuresCalled: func(reason string) {
+			mr.mutex.Lock()
+			defer mr.mutex.Unlock()
+
+			mr.failures[reason]++
+		},
+		IncInFlightBatchRequestsCalled: func() {
+			mr.mutex.Lock()
+			defer mr.mutex.Unlock()
+
+			mr.inFlightBatchRequests++
+			if mr.inFlightBatchRequests > mr.maxInFlightRequests {
+				mr.maxInFlightRequests = mr.inFlightBatchRequests
+			}
+		},
+		DecInFlightBatchRequestsCalled: func() {
+			mr.mutex.Lock()
+			defer mr.mutex.Unlock()
+
+			mr.inFlightBatchRequests--
+		},
+	}
+}
+
+func TestHyperBlockFacade_Metrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("upstream error, should record upstream failure", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newMetricsRecorder()
+		args := createMockHyperBlockFacadeArgs()
+		args.Metrics = recorder.createMetricsHandlerStub()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				return nil, errors.New("error getting hyper block")
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		maxAttempts := int(args.RetryPolicy.MaxAttempts)
+		_, err := facade.GetHyperBlockByNonce(context.Background(), 4, config.HyperBlockQueryOptions{})
+		require.NotNil(t, err)
+		require.Equal(t, map[string]int{upstreamEndpointHyperBlock: maxAttempts}, recorder.upstreamRequests)
+		require.Equal(t, maxAttempts, recorder.upstreamErrors)
+		require.Equal(t, map[string]int{failureReasonUpstream: maxAttempts - 1}, recorder.retries)
+		require.Equal(t, map[string]int{failureReasonUpstream: 1}, recorder.failures)
+		require.Empty(t, recorder.encodedSizes)
+	})
+
+	t.Run("process error, should record process failure", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newMetricsRecorder()
+		args := createMockHyperBlockFacadeArgs()
+		args.Metrics = recorder.createMetricsHandlerStub()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				return &api.MultiversxHyperBlockApiResponse{}, nil
+			},
+		}
+		args.HyperBlockProcessor = &mock.HyperBlockProcessorStub{
+			ProcessCalled: func(hyperBlock *hyperBlock.HyperBlock) (*schema.HyperBlock, error) {
+				return nil, errors.New("error processing hyper block")
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		_, err := facade.GetHyperBlockByHash(context.Background(), "hash", config.HyperBlockQueryOptions{})
+		require.NotNil(t, err)
+		require.Equal(t, 0, recorder.upstreamErrors)
+		require.Equal(t, map[string]int{failureReasonProcess: 1}, recorder.failures)
+	})
+
+	t.Run("encode error, should record encode failure", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newMetricsRecorder()
+		args := createMockHyperBlockFacadeArgs()
+		args.Metrics = recorder.createMetricsHandlerStub()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				return &api.MultiversxHyperBlockApiResponse{}, nil
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return nil, errors.New("error encoding hyper block")
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		_, err := facade.GetHyperBlockByNonce(context.Background(), 4, config.HyperBlockQueryOptions{})
+		require.NotNil(t, err)
+		require.Equal(t, map[string]int{failureReasonEncode: 1}, recorder.failures)
+	})
+
+	t.Run("hyper blocks by interval with one retrial, should record retries, sizes and in flight requests", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newMetricsRecorder()
+		args := createMockHyperBlockFacadeArgs()
+		args.Metrics = recorder.createMetricsHandlerStub()
+
+		failedOnce := int32(0)
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				nonce := getNonceFromRequest(t, path)
+				if nonce == 5 && atomic.CompareAndSwapInt32(&failedOnce, 0, 1) {
+					return nil, errors.New("error getting hyper block")
+				}
+
+				return &api.MultiversxHyperBlockApiResponse{
+					Data: api.MultiversxHyperBlockApiResponsePayload{
+						HyperBlock: hyperBlock.HyperBlock{Nonce: nonce},
+					},
+				}, nil
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return []byte("encoded"), nil
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		ret, err := facade.GetHyperBlocksByInterval(context.Background(), &api.Interval{Start: 4, End: 6}, config.HyperBlocksQueryOptions{BatchSize: 2})
+		require.Nil(t, err)
+		require.Len(t, ret.Data, 3)
+
+		require.Equal(t, map[string]int{upstreamEndpointHyperBlock: 4}, recorder.upstreamRequests)
+		require.Equal(t, 1, recorder.upstreamErrors)
+		require.Equal(t, map[string]int{failureReasonUpstream: 1}, recorder.retries)
+		require.Empty(t, recorder.failures)
+		require.Equal(t, []int{7, 7, 7}, recorder.encodedSizes)
+		require.Equal(t, 0, recorder.inFlightBatchRequests)
+		require.LessOrEqual(t, recorder.maxInFlightRequests, 2)
+		require.GreaterOrEqual(t, recorder.maxInFlightRequests, 1)
+	})
+
+	t.Run("latest hyper block nonce, should record network status upstream request", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newMetricsRecorder()
+		args := createMockHyperBlockFacadeArgs()
+		args.Metrics = recorder.createMetricsHandlerStub()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				return &api.MultiversxNetworkStatusApiResponse{}, nil
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		_, err := facade.GetLatestHyperBlockNonce(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, map[string]int{upstreamEndpointNetworkStatus: 1}, recorder.upstreamRequests)
+		require.Equal(t, 0, recorder.upstreamErrors)
+	})
+}
+
+func TestHyperBlockFacade_ContextCancellation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should pass the request context to the endpoint", func(t *testing.T) {
+		t.Parallel()
+
+		type ctxKey struct{}
+		ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+		numCalls := int32(0)
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(receivedCtx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				require.Equal(t, "value", receivedCtx.Value(ctxKey{}))
+				atomic.AddInt32(&numCalls, 1)
+				return &api.MultiversxHyperBlockApiResponse{}, nil
+			},
+			GetNetworkStatusCalled: func(receivedCtx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				require.Equal(t, "value", receivedCtx.Value(ctxKey{}))
+				atomic.AddInt32(&numCalls, 1)
+				return &api.MultiversxNetworkStatusApiResponse{}, nil
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return []byte("encoded"), nil
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		_, err := facade.GetHyperBlockByNonce(ctx, 4, config.HyperBlockQueryOptions{})
+		require.Nil(t, err)
+		_, err = facade.GetHyperBlockByHash(ctx, "abcd", config.HyperBlockQueryOptions{})
+		require.Nil(t, err)
+		_, err = facade.GetHyperBlocksByInterval(ctx, &api.Interval{Start: 1, End: 2}, config.HyperBlocksQueryOptions{BatchSize: 2})
+		require.Nil(t, err)
+		_, err = facade.GetLatestHyperBlockNonce(ctx)
+		require.Nil(t, err)
+		require.Equal(t, int32(5), atomic.LoadInt32(&numCalls))
+	})
+
+	t.Run("cancelled request should stop retrials immediately", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		numCalls := int32(0)
+		recorder := newMetricsRecorder()
+		args := createMockHyperBlockFacadeArgs()
+		args.Metrics = recorder.createMetricsHandlerStub()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				if atomic.AddInt32(&numCalls, 1) == 3 {
+					cancel()
+				}
+				return nil, errors.New("error getting hyper block")
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		start := time.Now()
+		ret, err := facade.GetHyperBlocksByInterval(ctx, &api.Interval{Start: 1, End: 100}, config.HyperBlocksQueryOptions{BatchSize: 3})
+		require.Nil(t, ret)
+		require.Equal(t, context.Canceled, err)
+		require.Less(t, time.Since(start), time.Second)
+		require.Empty(t, recorder.failures)
+
+		numCallsAfterCancel := atomic.LoadInt32(&numCalls)
+		time.Sleep(200 * time.Millisecond)
+		require.Equal(t, numCallsAfterCancel, atomic.LoadInt32(&numCalls))
+	})
+
+	t.Run("expired deadline, should not request any hyper block", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		ret, err := facade.GetHyperBlocksByInterval(ctx, &api.Interval{Start: 1, End: 10}, config.HyperBlocksQueryOptions{BatchSize: 2})
+		require.Nil(t, ret)
+		require.Equal(t, context.DeadlineExceeded, err)
+	})
+}
+
+func TestHyperBlockFacade_RetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("non retryable upstream response, should not retry", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		errNotFound := fmt.Errorf("%w: 404 Not Found", api.ErrNonRetryableResponse)
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				atomic.AddUint32(&numCalls, 1)
+				return nil, errNotFound
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		_, err := facade.GetHyperBlockByNonce(context.Background(), 4, config.HyperBlockQueryOptions{})
+		require.Equal(t, errNotFound, err)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+
+	t.Run("process error, should not retry", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		errProcessor := errors.New("error processing hyper block")
+		args := createMockHyperBlockFacadeArgs()
+		args.HyperBlockProcessor = &mock.HyperBlockProcessorStub{
+			ProcessCalled: func(hyperBlock *hyperBlock.HyperBlock) (*schema.HyperBlock, error) {
+				atomic.AddUint32(&numCalls, 1)
+				return nil, errProcessor
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		interval := &api.Interval{Start: 4, End: 4}
+		_, err := facade.GetHyperBlocksByInterval(context.Background(), interval, config.HyperBlocksQueryOptions{BatchSize: 1})
+		require.True(t, errors.Is(err, errProcessor))
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+
+	t.Run("transient upstream error, should retry single hyper block request", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				if atomic.AddUint32(&numCalls, 1) < 3 {
+					return nil, errors.New("503 Service Unavailable")
+				}
+
+				return &api.MultiversxHyperBlockApiResponse{}, nil
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return []byte("encoded"), nil
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		block, err := facade.GetHyperBlockByHash(context.Background(), "hash", config.HyperBlockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, []byte("encoded"), block.Data)
+		require.Equal(t, uint32(3), atomic.LoadUint32(&numCalls))
+	})
+
+	t.Run("total budget exceeded, should stop retrying", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		args := createMockHyperBlockFacadeArgs()
+		args.RetryPolicy = config.RetryPolicy{
+			MaxAttempts:   1000,
+			BaseDelayMs:   10,
+			MaxDelayMs:    10,
+			TotalBudgetMs: 50,
+		}
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				atomic.AddUint32(&numCalls, 1)
+				time.Sleep(5 * time.Millisecond)
+				return nil, errors.New("503 Service Unavailable")
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		start := time.Now()
+		_, err := facade.GetHyperBlockByNonce(context.Background(), 4, config.HyperBlockQueryOptions{})
+		require.True(t, errors.Is(err, errCouldNotGetHyperBlock))
+		require.Less(t, time.Since(start), time.Second)
+		require.Less(t, atomic.LoadUint32(&numCalls), uint32(11))
+	})
+}
+
+func TestHyperBlockFacade_ChainValidation(t *testing.T) {
+	t.Parallel()
+
+	interval := &api.Interval{
+		Start: 4,
+		End:   9,
+	}
+	options := config.HyperBlocksQueryOptions{
+		BatchSize: 2,
+	}
+	errBrokenLink := errors.New("broken link")
+	createArgs := func() *HyperBlockFacadeArgs {
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				return &api.MultiversxHyperBlockApiResponse{
+					Data: api.MultiversxHyperBlockApiResponsePayload{
+						HyperBlock: hyperBlock.HyperBlock{Nonce: getNonceFromRequest(t, path)},
+					},
+				}, nil
+			},
+		}
+		args.HyperBlockProcessor = &mock.HyperBlockProcessorStub{
+			ProcessCalled: func(hyperBlock *hyperBlock.HyperBlock) (*schema.HyperBlock, error) {
+				return &schema.HyperBlock{Nonce: int64(hyperBlock.Nonce)}, nil
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return []byte(strconv.Itoa(int(record.(*schema.HyperBlock).Nonce))), nil
+			},
+			DecodeCalled: func(record avro.AvroRecord, buffer []byte) error {
+				nonce, err := strconv.Atoi(string(buffer))
+				record.(*schema.HyperBlock).Nonce = int64(nonce)
+				return err
+			},
+		}
+		args.ChainValidator = &mock.ChainValidatorStub{
+			ValidateLinkCalled: func(previous *schema.HyperBlock, next *schema.HyperBlock) error {
+				require.Equal(t, previous.Nonce+1, next.Nonce)
+				if next.Nonce == 7 {
+					return errBrokenLink
+				}
+
+				return nil
+			},
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}
+
+		return args
+	}
+
+	t.Run("invalid hyper block, should return error without retrying", func(t *testing.T) {
+		t.Parallel()
+
+		errInvalidHyperBlock := errors.New("invalid hyper block")
+		numValidations := uint32(0)
+		recorder := newMetricsRecorder()
+		args := createArgs()
+		args.Metrics = recorder.createMetricsHandlerStub()
+		args.ChainValidator = &mock.ChainValidatorStub{
+			ValidateHyperBlockCalled: func(hyperBlock *schema.HyperBlock) error {
+				atomic.AddUint32(&numValidations, 1)
+				return errInvalidHyperBlock
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		block, err := facade.GetHyperBlockByNonce(context.Background(), 4, config.HyperBlockQueryOptions{})
+		require.Nil(t, block)
+		require.Equal(t, errInvalidHyperBlock, err)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numValidations))
+		require.Equal(t, map[string]int{failureReasonValidation: 1}, recorder.failures)
+	})
+
+	t.Run("linked hyper blocks, should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.ChainValidator = &mock.ChainValidatorStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		blocks, err := facade.GetHyperBlocksByInterval(context.Background(), interval, options)
+		require.Nil(t, err)
+		require.Len(t, blocks.Data, 6)
+	})
+
+	t.Run("broken link, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := newMetricsRecorder()
+		args := createArgs()
+		args.Metrics = recorder.createMetricsHandlerStub()
+		facade, _ := NewHyperBlockFacade(args)
+
+		blocks, err := facade.GetHyperBlocksByInterval(context.Background(), interval, options)
+		require.Nil(t, blocks)
+		require.Equal(t, errBrokenLink, err)
+		require.Equal(t, map[string]int{failureReasonValidation: 1}, recorder.failures)
+
+		container, err := facade.GetHyperBlocksContainerByInterval(context.Background(), interval, options, "null")
+		require.Nil(t, container)
+		require.Equal(t, errBrokenLink, err)
+	})
+
+	t.Run("broken link in partial results mode, should mark unlinked hyper block as failed", func(t *testing.T) {
+		t.Parallel()
+
+		facade, _ := NewHyperBlockFacade(createArgs())
+
+		blocks, err := facade.GetPartialHyperBlocksByInterval(context.Background(), interval, options)
+		require.Nil(t, err)
+		require.Equal(t, api.ReturnCodePartialSuccess, blocks.Code)
+		for _, block := range blocks.Data {
+			if block.Nonce == 7 {
+				require.Nil(t, block.Data)
+				require.Equal(t, errBrokenLink.Error(), block.Error)
+				require.Equal(t, api.ReturnCodeInternalError, block.Code)
+				continue
+			}
+
+			require.Equal(t, api.ReturnCodeSuccess, block.Code)
+		}
+	})
+
+	t.Run("disabled validation, should not decode hyper blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.ChainValidator = &mock.ChainValidatorStub{}
+		args.AvroEncoder.(*mock.AvroEncoderStub).DecodeCalled = func(record avro.AvroRecord, buffer []byte) error {
+			require.Fail(t, "should not decode hyper blocks")
+			return nil
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		blocks, err := facade.GetHyperBlocksByInterval(context.Background(), interval, options)
+		require.Nil(t, err)
+		require.Len(t, blocks.Data, 6)
+	})
+}
+
+func TestHyperBlockFacade_FinalOnly(t *testing.T) {
+	t.Parallel()
+
+	highestFinalNonce := uint64(6)
+	finalOnlyOptions := config.HyperBlockQueryOptions{
+		FinalOnly: true,
+	}
+	createArgs := func(numHyperBlockRequests *uint32) *HyperBlockFacadeArgs {
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				atomic.AddUint32(numHyperBlockRequests, 1)
+
+				nonce := uint64(8)
+				if strings.HasPrefix(path, hyperBlockPathByNonce) {
+					nonce = getNonceFromRequest(t, path)
+				}
+				return &api.MultiversxHyperBlockApiResponse{
+					Data: api.MultiversxHyperBlockApiResponsePayload{
+						HyperBlock: hyperBlock.HyperBlock{Nonce: nonce},
+					},
+				}, nil
+			},
+			GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				return &api.MultiversxNetworkStatusApiResponse{
+					Data: api.MultiversxNetworkStatusApiResponsePayload{
+						Status: api.NetworkStatus{
+							Nonce:             highestFinalNonce + 2,
+							HighestFinalNonce: highestFinalNonce,
+						},
+					},
+				}, nil
+			},
+		}
+		args.HyperBlockProcessor = &mock.HyperBlockProcessorStub{
+			ProcessCalled: func(hyperBlock *hyperBlock.HyperBlock) (*schema.HyperBlock, error) {
+				return &schema.HyperBlock{Nonce: int64(hyperBlock.Nonce)}, nil
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return []byte(strconv.Itoa(int(record.(*schema.HyperBlock).Nonce))), nil
+			},
+			DecodeCalled: func(record avro.AvroRecord, buffer []byte) error {
+				nonce, err := strconv.Atoi(string(buffer))
+				record.(*schema.HyperBlock).Nonce = int64(nonce)
+				return err
+			},
+		}
+
+		return args
+	}
+
+	t.Run("final hyper block by nonce, should work", func(t *testing.T) {
+		t.Parallel()
+
+		numHyperBlockRequests := uint32(0)
+		facade, _ := NewHyperBlockFacade(createArgs(&numHyperBlockRequests))
+
+		block, err := facade.GetHyperBlockByNonce(context.Background(), highestFinalNonce, finalOnlyOptions)
+		require.Nil(t, err)
+		require.Equal(t, []byte("6"), block.Data)
+	})
+
+	t.Run("hyper block by nonce above highest final nonce, should not fetch it", func(t *testing.T) {
+		t.Parallel()
+
+		numHyperBlockRequests := uint32(0)
+		facade, _ := NewHyperBlockFacade(createArgs(&numHyperBlockRequests))
+
+		block, err := facade.GetHyperBlockByNonce(context.Background(), highestFinalNonce+1, finalOnlyOptions)
+		require.Nil(t, block)
+		require.True(t, errors.Is(err, api.ErrHyperBlockNotFinal))
+		require.True(t, strings.Contains(err.Error(), "nonce: 7, highest final nonce: 6"))
+		require.Zero(t, atomic.LoadUint32(&numHyperBlockRequests))
+
+		block, err = facade.GetHyperBlockByNonce(context.Background(), highestFinalNonce+1, config.HyperBlockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, []byte("7"), block.Data)
+	})
+
+	t.Run("hyper block by hash above highest final nonce, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		numHyperBlockRequests := uint32(0)
+		facade, _ := NewHyperBlockFacade(createArgs(&numHyperBlockRequests))
+
+		block, err := facade.GetHyperBlockByHash(context.Background(), "hash", finalOnlyOptions)
+		require.Nil(t, block)
+		require.True(t, errors.Is(err, api.ErrHyperBlockNotFinal))
+
+		block, err = facade.GetHyperBlockByHash(context.Background(), "hash", config.HyperBlockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, []byte("8"), block.Data)
+	})
+
+	t.Run("hyper blocks interval above highest final nonce, should not fetch any of them", func(t *testing.T) {
+		t.Parallel()
+
+		numHyperBlockRequests := uint32(0)
+		facade, _ := NewHyperBlockFacade(createArgs(&numHyperBlockRequests))
+
+		options := config.HyperBlocksQueryOptions{
+			QueryOptions: finalOnlyOptions,
+			BatchSize:    2,
+		}
+		interval := &api.Interval{Start: 4, End: highestFinalNonce + 1}
+		blocks, err := facade.GetHyperBlocksByInterval(context.Background(), interval, options)
+		require.Nil(t, blocks)
+		require.True(t, errors.Is(err, api.ErrHyperBlockNotFinal))
+
+		partialBlocks, err := facade.GetPartialHyperBlocksByInterval(context.Background(), interval, options)
+		require.Nil(t, partialBlocks)
+		require.True(t, errors.Is(err, api.ErrHyperBlockNotFinal))
+
+		container, err := facade.GetHyperBlocksContainerByInterval(context.Background(), interval, options, "null")
+		require.Nil(t, container)
+		require.True(t, errors.Is(err, api.ErrHyperBlockNotFinal))
+		require.Zero(t, atomic.LoadUint32(&numHyperBlockRequests))
+
+		interval.End = highestFinalNonce
+		blocks, err = facade.GetHyperBlocksByInterval(context.Background(), interval, options)
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{[]byte("4"), []byte("5"), []byte("6")}, blocks.Data)
+	})
+
+	t.Run("could not get highest final nonce, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errNetworkStatus := errors.New("network status error")
+		numHyperBlockRequests := uint32(0)
+		args := createArgs(&numHyperBlockRequests)
+		args.MultiversxHyperBlockEndpoint.(*apiMocks.MultiversxHyperBlockEndPointStub).GetNetworkStatusCalled = func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+			return nil, errNetworkStatus
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		block, err := facade.GetHyperBlockByNonce(context.Background(), 4, finalOnlyOptions)
+		require.Nil(t, block)
+		require.Equal(t, errNetworkStatus, err)
+		require.Zero(t, atomic.LoadUint32(&numHyperBlockRequests))
+	})
+}
+
+func TestHyperBlockFacade_ReorgsTracker(t *testing.T) {
+	t.Parallel()
+
+	highestFinalNonce := uint64(5)
+	multiversxEndPoint := &apiMocks.MultiversxHyperBlockEndPointStub{
+		GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+			nonce := getNonceFromRequest(t, path)
+			status := ""
+			if nonce == 8 {
+				status = hyperBlockStatusReverted
+			}
+
+			return &api.MultiversxHyperBlockApiResponse{
+				Data: api.MultiversxHyperBlockApiResponsePayload{
+					HyperBlock: hyperBlock.HyperBlock{
+						Nonce:  nonce,
+						Hash:   fmt.Sprintf("hash%d", nonce),
+						Status: status,
+					}},
+			}, nil
+		},
+		GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+			return &api.MultiversxNetworkStatusApiResponse{
+				Data: api.MultiversxNetworkStatusApiResponsePayload{
+					Status: api.NetworkStatus{
+						Nonce:             highestFinalNonce + 1,
+						HighestFinalNonce: highestFinalNonce,
+					},
+				},
+			}, nil
+		},
+	}
+
+	t.Run("only non final and non reverted hyper blocks should be tracked", func(t *testing.T) {
+		t.Parallel()
+
+		trackedHashes := make(map[uint64]string)
+		reorgsTracker := &apiMocks.ReorgsTrackerStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			TrackCalled: func(nonce uint64, hash string) {
+				trackedHashes[nonce] = hash
+			},
+		}
+
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = multiversxEndPoint
+		args.ReorgsTracker = reorgsTracker
+		facade, _ := NewHyperBlockFacade(args)
+
+		for nonce := uint64(4); nonce <= 8; nonce++ {
+			_, err := facade.GetHyperBlockByNonce(context.Background(), nonce, config.HyperBlockQueryOptions{})
+			require.Nil(t, err)
+		}
+
+		require.Equal(t, map[uint64]string{6: "hash6", 7: "hash7"}, trackedHashes)
+	})
+
+	t.Run("disabled reorgs tracker, should not track anything", func(t *testing.T) {
+		t.Parallel()
+
+		reorgsTracker := &apiMocks.ReorgsTrackerStub{
+			TrackCalled: func(nonce uint64, hash string) {
+				require.Fail(t, "should not track hyper block")
+			},
+		}
+
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = multiversxEndPoint
+		args.ReorgsTracker = reorgsTracker
+		facade, _ := NewHyperBlockFacade(args)
+
+		_, err := facade.GetHyperBlockByNonce(context.Background(), 7, config.HyperBlockQueryOptions{})
+		require.Nil(t, err)
+	})
+}
+
+func TestHyperBlockFacade_TransactionsFilter(t *testing.T) {
+	t.Parallel()
+
+	createTransaction := func(sender string, function string) *schema.Transaction {
+		return &schema.Transaction{Sender: []byte(sender), Function: function}
+	}
+
+	var encodedHyperBlock *schema.HyperBlock
+	args := createMockHyperBlockFacadeArgs()
+	args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+		GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+			require.NotContains(t, path, api.UrlParameterSender)
+			return &api.MultiversxHyperBlockApiResponse{}, nil
+		},
+	}
+	args.HyperBlockProcessor = &mock.HyperBlockProcessorStub{
+		ProcessCalled: func(hyperBlock *hyperBlock.HyperBlock) (*schema.HyperBlock, error) {
+			return &schema.HyperBlock{
+				NumTxs: 3,
+				Transactions: []*schema.Transaction{
+					createTransaction("erd1a", "claim"),
+					createTransaction("erd1b", "claim"),
+					createTransaction("erd1a", "stake"),
+				},
+			}, nil
+		},
+	}
+	args.ChainValidator = &mock.ChainValidatorStub{
+		ValidateHyperBlockCalled: func(hyperBlock *schema.HyperBlock) error {
+			require.Len(t, hyperBlock.Transactions, 3)
+			return nil
+		},
+	}
+	args.AddressConverter = &mock.AddressConverterStub{
+		DecodeAddressCalled: func(address []byte) string {
+			return string(address)
+		},
+	}
+	args.AvroEncoder = &mock.AvroEncoderStub{
+		EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+			encodedHyperBlock = record.(*schema.HyperBlock)
+			return []byte("encoded"), nil
+		},
+	}
+	facade, _ := NewHyperBlockFacade(args)
+
+	options := config.HyperBlockQueryOptions{
+		TransactionsFilter: config.TransactionsFilter{
+			Senders:   []string{"erd1a"},
+			Functions: []string{"claim", "unstake"},
+		},
+	}
+	block, err := facade.GetHyperBlockByNonce(context.Background(), 4, options)
+	require.Nil(t, err)
+	require.Equal(t, []byte("encoded"), block.Data)
+	require.Equal(t, int32(1), encodedHyperBlock.NumTxs)
+	require.Equal(t, []*schema.Transaction{createTransaction("erd1a", "claim")}, encodedHyperBlock.Transactions)
+}
+
+func TestHyperBlockFacade_Lookups(t *testing.T) {
+	t.Parallel()
+
+	// hyper block with nonce n has timestamp 1000 + 6n and is in epoch n / 10
+	latestNonce := uint64(35)
+	highestFinalNonce := uint64(30)
+	createSchemaHyperBlock := func(nonce int64) *schema.HyperBlock {
+		return &schema.HyperBlock{
+			Nonce:     nonce,
+			Epoch:     int32(nonce / 10),
+			Timestamp: 1000 + 6*nonce,
+		}
+	}
+	createArgs := func(numHyperBlockRequests *uint32) *HyperBlockFacadeArgs {
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				atomic.AddUint32(numHyperBlockRequests, 1)
+
+				nonce := getNonceFromRequest(t, path)
+				require.LessOrEqual(t, nonce, latestNonce)
+				return &api.MultiversxHyperBlockApiResponse{
+					Data: api.MultiversxHyperBlockApiResponsePayload{
+						HyperBlock: hyperBlock.HyperBlock{Nonce: nonce},
+					},
+				}, nil
+			},
+			GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				return &api.MultiversxNetworkStatusApiResponse{
+					Data: api.MultiversxNetworkStatusApiResponsePayload{
+						Status: api.NetworkStatus{
+							Nonce:             latestNonce,
+							HighestFinalNonce: highestFinalNonce,
+						},
+					},
+				}, nil
+			},
+		}
+		args.HyperBlockProcessor = &mock.HyperBlockProcessorStub{
+			ProcessCalled: func(hyperBlock *hyperBlock.HyperBlock) (*schema.HyperBlock, error) {
+				return createSchemaHyperBlock(int64(hyperBlock.Nonce)), nil
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return []byte(strconv.Itoa(int(record.(*schema.HyperBlock).Nonce))), nil
+			},
+			DecodeCalled: func(record avro.AvroRecord, buffer []byte) error {
+				nonce, err := strconv.Atoi(string(buffer))
+				*record.(*schema.HyperBlock) = *createSchemaHyperBlock(int64(nonce))
+				return err
+			},
+		}
+
+		return args
+	}
+
+	t.Run("hyper block nonce by timestamp, should work", func(t *testing.T) {
+		t.Parallel()
+
+		numHyperBlockRequests := uint32(0)
+		facade, _ := NewHyperBlockFacade(createArgs(&numHyperBlockRequests))
+
+		nonce, err := facade.GetHyperBlockNonceByTimestamp(context.Background(), 1000)
+		require.Nil(t, err)
+		require.Zero(t, nonce)
+
+		nonce, err = facade.GetHyperBlockNonceByTimestamp(context.Background(), 1000+6*17)
+		require.Nil(t, err)
+		require.Equal(t, uint64(17), nonce)
+
+		nonce, err = facade.GetHyperBlockNonceByTimestamp(context.Background(), 1000+6*17+5)
+		require.Nil(t, err)
+		require.Equal(t, uint64(17), nonce)
+
+		nonce, err = facade.GetHyperBlockNonceByTimestamp(context.Background(), 1000000)
+		require.Nil(t, err)
+		require.Equal(t, latestNonce, nonce)
+		require.LessOrEqual(t, atomic.LoadUint32(&numHyperBlockRequests), uint32(4*6))
+	})
+
+	t.Run("hyper block nonce by timestamp before genesis, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		numHyperBlockRequests := uint32(0)
+		facade, _ := NewHyperBlockFacade(createArgs(&numHyperBlockRequests))
+
+		nonce, err := facade.GetHyperBlockNonceByTimestamp(context.Background(), 999)
+		require.Zero(t, nonce)
+		require.True(t, errors.Is(err, api.ErrHyperBlockNotFound))
+	})
+
+	t.Run("complete epoch nonces interval, should work", func(t *testing.T) {
+		t.Parallel()
+
+		numHyperBlockRequests := uint32(0)
+		facade, _ := NewHyperBlockFacade(createArgs(&numHyperBlockRequests))
+
+		for epoch := uint32(0); epoch < 3; epoch++ {
+			interval, err := facade.GetEpochNoncesInterval(context.Background(), epoch)
+			require.Nil(t, err)
+			require.Equal(t, &api.EpochNoncesInterval{
+				Epoch:      epoch,
+				StartNonce: uint64(epoch * 10),
+				EndNonce:   uint64(epoch*10 + 9),
+				IsComplete: true,
+			}, interval)
+		}
+	})
+
+	t.Run("ongoing epoch nonces interval, should work", func(t *testing.T) {
+		t.Parallel()
+
+		numHyperBlockRequests := uint32(0)
+		facade, _ := NewHyperBlockFacade(createArgs(&numHyperBlockRequests))
+
+		interval, err := facade.GetEpochNoncesInterval(context.Background(), 3)
+		require.Nil(t, err)
+		require.Equal(t, &api.EpochNoncesInterval{
+			Epoch:      3,
+			StartNonce: 30,
+			EndNonce:   latestNonce,
+			IsComplete: false,
+		}, interval)
+	})
+
+	t.Run("epoch not started yet, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		numHyperBlockRequests := uint32(0)
+		facade, _ := NewHyperBlockFacade(createArgs(&numHyperBlockRequests))
+
+		interval, err := facade.GetEpochNoncesInterval(context.Background(), 4)
+		require.Nil(t, interval)
+		require.True(t, errors.Is(err, api.ErrHyperBlockNotFound))
+		require.True(t, strings.Contains(err.Error(), "epoch 4 has not started yet, current epoch: 3"))
+	})
+
+	t.Run("epochs index should store final epoch start nonces and be used for lookups", func(t *testing.T) {
+		t.Parallel()
+
+		indexedEpochs := make(map[uint32]uint64)
+		numHyperBlockRequests := uint32(0)
+		args := createArgs(&numHyperBlockRequests)
+		args.EpochsIndex = &mock.EpochsIndexStub{
+			GetEpochStartNonceCalled: func(epoch uint32) (uint64, bool) {
+				nonce, found := indexedEpochs[epoch]
+				return nonce, found
+			},
+			PutEpochStartNonceCalled: func(epoch uint32, nonce uint64) error {
+				indexedEpochs[epoch] = nonce
+				return nil
+			},
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		_, err := facade.GetEpochNoncesInterval(context.Background(), 1)
+		require.Nil(t, err)
+		require.Equal(t, map[uint32]uint64{1: 10, 2: 20}, indexedEpochs)
+
+		_, err = facade.GetEpochNoncesInterval(context.Background(), 3)
+		require.Nil(t, err)
+		require.Equal(t, map[uint32]uint64{1: 10, 2: 20, 3: 30}, indexedEpochs)
+
+		atomic.StoreUint32(&numHyperBlockRequests, 0)
+		interval, err := facade.GetEpochNoncesInterval(context.Background(), 2)
+		require.Nil(t, err)
+		require.Equal(t, &api.EpochNoncesInterval{
+			Epoch:      2,
+			StartNonce: 20,
+			EndNonce:   29,
+			IsComplete: true,
+		}, interval)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numHyperBlockRequests))
+	})
+
+	t.Run("non final epoch start nonce, should not be indexed", func(t *testing.T) {
+		t.Parallel()
+
+		numHyperBlockRequests := uint32(0)
+		args := createArgs(&numHyperBlockRequests)
+		args.MultiversxHyperBlockEndpoint.(*apiMocks.MultiversxHyperBlockEndPointStub).GetNetworkStatusCalled = func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+			return &api.MultiversxNetworkStatusApiResponse{
+				Data: api.MultiversxNetworkStatusApiResponsePayload{
+					Status: api.NetworkStatus{
+						Nonce:             latestNonce,
+						HighestFinalNonce: 25,
+					},
+				},
+			}, nil
+		}
+		args.EpochsIndex = &mock.EpochsIndexStub{
+			PutEpochStartNonceCalled: func(epoch uint32, nonce uint64) error {
+				require.Fail(t, "should not index non final epoch start nonce")
+				return nil
+			},
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		interval, err := facade.GetEpochNoncesInterval(context.Background(), 3)
+		require.Nil(t, err)
+		require.Equal(t, uint64(30), interval.StartNonce)
+	})
+
+	t.Run("probed hyper blocks, should neither be cached nor tracked for reorgs", func(t *testing.T) {
+		t.Parallel()
+
+		numHyperBlockRequests := uint32(0)
+		args := createArgs(&numHyperBlockRequests)
+		args.HyperBlocksCache = &mock.HyperBlocksCacheStub{
+			GetByNonceCalled: func(nonce uint64, optionsKey string) ([]byte, bool) {
+				require.Fail(t, "should not read the cache")
+				return nil, false
+			},
+			PutCalled: func(nonce uint64, hash string, optionsKey string, encodedHyperBlock []byte) error {
+				require.Fail(t, "should not cache probed hyper blocks")
+				return nil
+			},
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}
+		args.ReorgsTracker = &apiMocks.ReorgsTrackerStub{
+			TrackCalled: func(nonce uint64, hash string) {
+				require.Fail(t, "should not track probed hyper blocks")
+			},
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				require.Fail(t, "should not encode probed hyper blocks")
+				return nil, nil
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		nonce, err := facade.GetHyperBlockNonceByTimestamp(context.Background(), 1000+6*17)
+		require.Nil(t, err)
+		require.Equal(t, uint64(17), nonce)
+
+		interval, err := facade.GetEpochNoncesInterval(context.Background(), 3)
+		require.Nil(t, err)
+		require.Equal(t, uint64(30), interval.StartNonce)
+	})
+}
+
+func TestHyperBlockFacade_AccountBalanceUpdates(t *testing.T) {
+	t.Parallel()
+
+	// hyper block with nonce n notarizes two shard blocks, with nonces 10n and 10n + 1, each changing both accounts
+	createShardBlock := func(nonce int64) *schema.ShardBlocks {
+		return &schema.ShardBlocks{
+			Hash:  []byte(fmt.Sprintf("hash%d", nonce)),
+			Nonce: nonce,
+			StateChanges: []*schema.AccountBalanceUpdate{
+				{Address: []byte("erd1a"), Balance: []byte{byte(nonce)}},
+				{
+					Address: []byte("erd1b"),
+					Balance: []byte{byte(nonce)},
+					Tokens:  []*schema.AccountTokenData{{Identifier: "TKN-abcdef", Balance: []byte{1}}},
+				},
+			},
+		}
+	}
+	createSchemaHyperBlock := func(nonce int64) *schema.HyperBlock {
+		return &schema.HyperBlock{
+			Nonce:       nonce,
+			ShardBlocks: []*schema.ShardBlocks{createShardBlock(10 * nonce), createShardBlock(10*nonce + 1)},
+		}
+	}
+	createArgs := func() *HyperBlockFacadeArgs {
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				require.Contains(t, path, api.UrlParameterWithAlteredAccounts+"=true")
+				require.Contains(t, path, api.UrlParameterTokens+"=all")
+				return &api.MultiversxHyperBlockApiResponse{
+					Data: api.MultiversxHyperBlockApiResponsePayload{
+						HyperBlock: hyperBlock.HyperBlock{Nonce: getNonceFromRequest(t, path)},
+					},
+				}, nil
+			},
+		}
+		args.HyperBlockProcessor = &mock.HyperBlockProcessorStub{
+			ProcessCalled: func(hyperBlock *hyperBlock.HyperBlock) (*schema.HyperBlock, error) {
+				return createSchemaHyperBlock(int64(hyperBlock.Nonce)), nil
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return []byte(strconv.Itoa(int(record.(*schema.HyperBlock).Nonce))), nil
+			},
+			DecodeCalled: func(record avro.AvroRecord, buffer []byte) error {
+				nonce, err := strconv.Atoi(string(buffer))
+				*record.(*schema.HyperBlock) = *createSchemaHyperBlock(int64(nonce))
+				return err
+			},
+		}
+		args.AddressConverter = &mock.AddressConverterStub{
+			DecodeAddressCalled: func(address []byte) string {
+				return string(address)
+			},
+		}
+
+		return args
+	}
+	options := config.HyperBlocksQueryOptions{
+		QueryOptions: config.HyperBlockQueryOptions{
+			TransactionsFilter: config.TransactionsFilter{Senders: []string{"erd1c"}},
+		},
+		BatchSize: 2,
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var encodedBalanceUpdates schema.AccountBalanceUpdates
+		args := createArgs()
+		args.BalanceUpdatesEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				encodedBalanceUpdates = record.(schema.AccountBalanceUpdates)
+				return []byte("encoded"), nil
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		noncesInterval := &api.Interval{Start: 4, End: 5}
+		balanceUpdatesApiResponse, err := facade.GetAccountBalanceUpdates(context.Background(), "erd1b", noncesInterval, options)
+		require.Nil(t, err)
+		require.Equal(t, &api.CovalentHyperBlockApiResponse{
+			Data:  []byte("encoded"),
+			Error: "",
+			Code:  api.ReturnCodeSuccess,
+		}, balanceUpdatesApiResponse)
+
+		require.Len(t, encodedBalanceUpdates, 4)
+		for i, shardBlockNonce := range []int64{40, 41, 50, 51} {
+			require.Equal(t, shardBlockNonce, encodedBalanceUpdates[i].ShardBlockNonce)
+			require.Equal(t, []byte(fmt.Sprintf("hash%d", shardBlockNonce)), encodedBalanceUpdates[i].ShardBlockHash)
+			require.Equal(t, createShardBlock(shardBlockNonce).StateChanges[1], encodedBalanceUpdates[i].BalanceUpdate)
+		}
+	})
+
+	t.Run("no balance updates of account, should encode empty array", func(t *testing.T) {
+		t.Parallel()
+
+		var encodedBalanceUpdates schema.AccountBalanceUpdates
+		args := createArgs()
+		args.BalanceUpdatesEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				encodedBalanceUpdates = record.(schema.AccountBalanceUpdates)
+				return []byte("encoded"), nil
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		noncesInterval := &api.Interval{Start: 4, End: 5}
+		_, err := facade.GetAccountBalanceUpdates(context.Background(), "erd1c", noncesInterval, options)
+		require.Nil(t, err)
+		require.NotNil(t, encodedBalanceUpdates)
+		require.Empty(t, encodedBalanceUpdates)
+	})
+
+	t.Run("could not encode balance updates, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errEncode := errors.New("error encoding balance updates")
+		failures := make(map[string]int)
+		args := createArgs()
+		args.BalanceUpdatesEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return nil, errEncode
+			},
+		}
+		args.Metrics = &mock.MetricsHandlerStub{
+			IncFailuresCalled: func(reason string) {
+				failures[reason]++
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		noncesInterval := &api.Interval{Start: 4, End: 5}
+		balanceUpdatesApiResponse, err := facade.GetAccountBalanceUpdates(context.Background(), "erd1a", noncesInterval, options)
+		require.Nil(t, balanceUpdatesApiResponse)
+		require.Equal(t, errEncode, err)
+		require.Equal(t, map[string]int{failureReasonEncode: 1}, failures)
+	})
+
+	t.Run("invalid nonces interval, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		facade, _ := NewHyperBlockFacade(createArgs())
+
+		noncesInterval := &api.Interval{Start: 5, End: 4}
+		balanceUpdatesApiResponse, err := facade.GetAccountBalanceUpdates(context.Background(), "erd1a", noncesInterval, options)
+		require.Nil(t, balanceUpdatesApiResponse)
+		require.NotNil(t, err)
+	})
+}
+
+func TestHyperBlockFacade_GetHighestFinalHyperBlockNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				return &api.MultiversxNetworkStatusApiResponse{
+					Data: api.MultiversxNetworkStatusApiResponsePayload{
+						Status: api.NetworkStatus{
+							Nonce:             10,
+							HighestFinalNonce: 8,
+						},
+					},
+				}, nil
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		nonce, err := facade.GetHighestFinalHyperBlockNonce(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, uint64(8), nonce)
+	})
+
+	t.Run("could not get network status, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errNetworkStatus := errors.New("error getting network status")
+		args := createMockHyperBlockFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				return nil, errNetworkStatus
+			},
+		}
+		facade, _ := NewHyperBlockFacade(args)
+
+		nonce, err := facade.GetHighestFinalHyperBlockNonce(context.Background())
+		require.Zero(t, nonce)
+		require.True(t, errors.Is(err, errNetworkStatus))
+	})
+}
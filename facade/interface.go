@@ -1,8 +1,68 @@
 package facade
 
-import "github.com/elodina/go-avro"
+import (
+	"time"
 
-// AvroEncoder should be able to encode any avro schema in a byte array
+	"github.com/elodina/go-avro"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+)
+
+// AvroEncoder should be able to encode any avro schema in a byte array, and decode it back
 type AvroEncoder interface {
 	Encode(record avro.AvroRecord) ([]byte, error)
+	Decode(record avro.AvroRecord, buffer []byte) error
+	EncodeContainer(schemaDefinition string, codec string, encodedRecords [][]byte) ([]byte, error)
+}
+
+// ChainValidator should check that processed hyper blocks are consistent on their own and that consecutive hyper
+// blocks are linked
+type ChainValidator interface {
+	ValidateHyperBlock(hyperBlock *schema.HyperBlock) error
+	ValidateLink(previous *schema.HyperBlock, next *schema.HyperBlock) error
+	IsEnabled() bool
+}
+
+// ReorgsTracker should keep track of the hashes of served hyper blocks which are not final yet, to detect reorgs
+type ReorgsTracker interface {
+	Track(nonce uint64, hash string)
+	IsEnabled() bool
+}
+
+// HyperBlocksCache should store and provide avro encoded hyper blocks, indexed by nonce and by hash, for each
+// set of hyper block query options
+type HyperBlocksCache interface {
+	GetByNonce(nonce uint64, optionsKey string) ([]byte, bool)
+	GetByHash(hash string, optionsKey string) ([]byte, bool)
+	Put(nonce uint64, hash string, optionsKey string, encodedHyperBlock []byte) error
+	IsEnabled() bool
+}
+
+// MetricsHandler should record metrics about hyper blocks requests sent to Multiversx proxy and their processing
+type MetricsHandler interface {
+	ObserveUpstreamRequest(endpoint string, duration time.Duration, err error)
+	ObserveEncodedHyperBlockSize(size int)
+	IncRetries(reason string)
+	IncFailures(reason string)
+	IncTransactionRetries(reason string)
+	IncTransactionFailures(reason string)
+	IncInFlightBatchRequests()
+	DecInFlightBatchRequests()
+}
+
+// AddressConverter should provide the bech32 address of an address written in a processed hyper block
+type AddressConverter interface {
+	DecodeAddress(address []byte) string
+}
+
+// EpochsIndex should store and provide the nonces of the first hyper blocks of epochs
+type EpochsIndex interface {
+	GetEpochStartNonce(epoch uint32) (uint64, bool)
+	PutEpochStartNonce(epoch uint32, nonce uint64) error
+	IsEnabled() bool
+}
+
+// TransactionProcessor should convert transactions fetched from Multiversx proxy to avro schema transactions
+type TransactionProcessor interface {
+	ProcessTransactions(apiTransactions []*transaction.ApiTransactionResult) ([]*schema.Transaction, error)
 }
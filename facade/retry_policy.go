@@ -0,0 +1,129 @@
+package facade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+)
+
+// retryPolicy computes the back off delays between attempts of a failed request, as exponential delays with full
+// jitter, and decides whether a failure is worth retrying
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	totalBudget time.Duration
+	mutex       sync.Mutex
+	randomizer  *rand.Rand
+}
+
+func newRetryPolicy(cfg config.RetryPolicy) (*retryPolicy, error) {
+	if cfg.MaxAttempts == 0 {
+		return nil, fmt.Errorf("%w; expected non zero value", errInvalidRetryMaxAttempts)
+	}
+	if cfg.BaseDelayMs == 0 || cfg.MaxDelayMs < cfg.BaseDelayMs {
+		return nil, fmt.Errorf("%w: base delay: %d ms, max delay: %d ms; expected 0 < base delay <= max delay",
+			errInvalidRetryDelay, cfg.BaseDelayMs, cfg.MaxDelayMs)
+	}
+
+	return &retryPolicy{
+		maxAttempts: int(cfg.MaxAttempts),
+		baseDelay:   time.Duration(cfg.BaseDelayMs) * time.Millisecond,
+		maxDelay:    time.Duration(cfg.MaxDelayMs) * time.Millisecond,
+		totalBudget: time.Duration(cfg.TotalBudgetMs) * time.Millisecond,
+		randomizer:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}, nil
+}
+
+// backOffDelay returns a random delay in [0, min(maxDelay, baseDelay * 2^attempt)]
+func (rp *retryPolicy) backOffDelay(attempt int) time.Duration {
+	delayCap := rp.baseDelay
+	for i := 0; i < attempt && delayCap < rp.maxDelay; i++ {
+		delayCap *= 2
+	}
+	if delayCap > rp.maxDelay {
+		delayCap = rp.maxDelay
+	}
+
+	rp.mutex.Lock()
+	defer rp.mutex.Unlock()
+
+	return time.Duration(rp.randomizer.Int63n(int64(delayCap) + 1))
+}
+
+// canRetry returns true if another attempt can be started after the provided number of attempts, waiting the
+// provided delay, without exceeding the total budget
+func (rp *retryPolicy) canRetry(attempt int, elapsed time.Duration, delay time.Duration) bool {
+	if attempt >= rp.maxAttempts {
+		return false
+	}
+
+	return rp.totalBudget == 0 || elapsed+delay <= rp.totalBudget
+}
+
+// retriedRequest describes a request retried by the retry policy. Each attempt returns the failure reason along with
+// the error, which are recorded by the provided metrics callbacks
+type retriedRequest struct {
+	name         string
+	request      string
+	errExhausted error
+	attempt      func() (string, error)
+	incRetries   func(reason string)
+	incFailures  func(reason string)
+}
+
+// retry runs the attempts of the request until one succeeds, fails with a non retryable error, the attempts or the
+// time budget are exhausted or the context is done. A cancelled request is not recorded as a failure
+func (rp *retryPolicy) retry(ctx context.Context, rr retriedRequest) error {
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		failureReason, err := rr.attempt()
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !isRetryable(failureReason, err) {
+			rr.incFailures(failureReason)
+			return err
+		}
+
+		sleepDuration := rp.backOffDelay(attempt)
+		if !rp.canRetry(attempt, time.Since(start), sleepDuration) {
+			rr.incFailures(failureReason)
+			return fmt.Errorf("%w from request = %s after num of attempts = %d in %v, last error: %v",
+				rr.errExhausted, rr.request, attempt, time.Since(start), err)
+		}
+
+		rr.incRetries(failureReason)
+		log.Warn(fmt.Sprintf("could not get %s; retrying...", rr.name),
+			"request", rr.request,
+			"error", err,
+			"num attempts", attempt,
+			"sleep duration", sleepDuration,
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(sleepDuration):
+		}
+	}
+}
+
+// isRetryable returns true for transient upstream failures. Deterministic upstream responses(e.g. 404, unknown
+// transaction) and hyper block processing or encoding failures would fail the same way if retried
+func isRetryable(failureReason string, err error) bool {
+	if failureReason != failureReasonUpstream {
+		return false
+	}
+
+	return !errors.Is(err, api.ErrNonRetryableResponse) && !errors.Is(err, api.ErrTransactionNotFound)
+}
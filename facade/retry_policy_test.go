@@ -0,0 +1,190 @@
+package facade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rp, err := newRetryPolicy(createMockRetryPolicy())
+		require.Nil(t, err)
+		require.NotNil(t, rp)
+	})
+
+	t.Run("zero max attempts, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockRetryPolicy()
+		cfg.MaxAttempts = 0
+		rp, err := newRetryPolicy(cfg)
+		require.Nil(t, rp)
+		require.True(t, errors.Is(err, errInvalidRetryMaxAttempts))
+	})
+
+	t.Run("invalid delays, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockRetryPolicy()
+		cfg.BaseDelayMs = 0
+		rp, err := newRetryPolicy(cfg)
+		require.Nil(t, rp)
+		require.True(t, errors.Is(err, errInvalidRetryDelay))
+
+		cfg = createMockRetryPolicy()
+		cfg.MaxDelayMs = cfg.BaseDelayMs - 1
+		rp, err = newRetryPolicy(cfg)
+		require.Nil(t, rp)
+		require.True(t, errors.Is(err, errInvalidRetryDelay))
+	})
+}
+
+func TestRetryPolicy_BackOffDelay(t *testing.T) {
+	t.Parallel()
+
+	rp, _ := newRetryPolicy(config.RetryPolicy{
+		MaxAttempts: 10,
+		BaseDelayMs: 10,
+		MaxDelayMs:  100,
+	})
+
+	for i := 0; i < 100; i++ {
+		require.LessOrEqual(t, rp.backOffDelay(0), 10*time.Millisecond)
+		require.LessOrEqual(t, rp.backOffDelay(1), 20*time.Millisecond)
+		require.LessOrEqual(t, rp.backOffDelay(2), 40*time.Millisecond)
+		require.LessOrEqual(t, rp.backOffDelay(5), 100*time.Millisecond)
+		require.LessOrEqual(t, rp.backOffDelay(1000), 100*time.Millisecond)
+		require.GreaterOrEqual(t, rp.backOffDelay(5), time.Duration(0))
+	}
+}
+
+func TestRetryPolicy_CanRetry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without budget, should only limit the number of attempts", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := newRetryPolicy(createMockRetryPolicy())
+		require.True(t, rp.canRetry(1, time.Hour, time.Hour))
+		require.True(t, rp.canRetry(9, 0, 0))
+		require.False(t, rp.canRetry(10, 0, 0))
+	})
+
+	t.Run("with budget, should not exceed it", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockRetryPolicy()
+		cfg.TotalBudgetMs = 100
+		rp, _ := newRetryPolicy(cfg)
+		require.True(t, rp.canRetry(1, 50*time.Millisecond, 50*time.Millisecond))
+		require.False(t, rp.canRetry(1, 50*time.Millisecond, 51*time.Millisecond))
+		require.False(t, rp.canRetry(10, 0, 0))
+	})
+}
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, isRetryable(failureReasonUpstream, errors.New("503 Service Unavailable")))
+	require.False(t, isRetryable(failureReasonUpstream, fmt.Errorf("%w: 404 Not Found", api.ErrNonRetryableResponse)))
+	require.False(t, isRetryable(failureReasonUpstream, fmt.Errorf("%w: status code: 500", api.ErrTransactionNotFound)))
+	require.False(t, isRetryable(failureReasonProcess, errors.New("process error")))
+	require.False(t, isRetryable(failureReasonEncode, errors.New("encode error")))
+}
+
+func TestRetryPolicy_Retry(t *testing.T) {
+	t.Parallel()
+
+	createRetriedRequest := func(attempt func() (string, error), retries *[]string, failures *[]string) retriedRequest {
+		return retriedRequest{
+			name:         "resource",
+			request:      "/resource",
+			errExhausted: errCouldNotGetHyperBlock,
+			attempt:      attempt,
+			incRetries: func(reason string) {
+				*retries = append(*retries, reason)
+			},
+			incFailures: func(reason string) {
+				*failures = append(*failures, reason)
+			},
+		}
+	}
+
+	t.Run("transient failures, should retry until success", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := newRetryPolicy(createMockRetryPolicy())
+		numAttempts := 0
+		retries, failures := make([]string, 0), make([]string, 0)
+		err := rp.retry(context.Background(), createRetriedRequest(func() (string, error) {
+			numAttempts++
+			if numAttempts < 3 {
+				return failureReasonUpstream, errors.New("status code: 502")
+			}
+			return "", nil
+		}, &retries, &failures))
+		require.Nil(t, err)
+		require.Equal(t, 3, numAttempts)
+		require.Equal(t, []string{failureReasonUpstream, failureReasonUpstream}, retries)
+		require.Empty(t, failures)
+	})
+
+	t.Run("non retryable failure, should record the failure without retrying", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := newRetryPolicy(createMockRetryPolicy())
+		numAttempts := 0
+		errProcess := errors.New("process error")
+		retries, failures := make([]string, 0), make([]string, 0)
+		err := rp.retry(context.Background(), createRetriedRequest(func() (string, error) {
+			numAttempts++
+			return failureReasonProcess, errProcess
+		}, &retries, &failures))
+		require.Equal(t, errProcess, err)
+		require.Equal(t, 1, numAttempts)
+		require.Empty(t, retries)
+		require.Equal(t, []string{failureReasonProcess}, failures)
+	})
+
+	t.Run("attempts exhausted, should return the provided error", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := newRetryPolicy(createMockRetryPolicy())
+		numAttempts := 0
+		retries, failures := make([]string, 0), make([]string, 0)
+		err := rp.retry(context.Background(), createRetriedRequest(func() (string, error) {
+			numAttempts++
+			return failureReasonUpstream, errors.New("status code: 502")
+		}, &retries, &failures))
+		require.True(t, errors.Is(err, errCouldNotGetHyperBlock))
+		require.Equal(t, rp.maxAttempts, numAttempts)
+		require.Len(t, retries, rp.maxAttempts-1)
+		require.Equal(t, []string{failureReasonUpstream}, failures)
+	})
+
+	t.Run("cancelled context, should not record a failure", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := newRetryPolicy(createMockRetryPolicy())
+		ctx, cancel := context.WithCancel(context.Background())
+		retries, failures := make([]string, 0), make([]string, 0)
+		err := rp.retry(ctx, createRetriedRequest(func() (string, error) {
+			cancel()
+			return failureReasonUpstream, errors.New("status code: 502")
+		}, &retries, &failures))
+		require.Equal(t, context.Canceled, err)
+		require.Empty(t, retries)
+		require.Empty(t, failures)
+	})
+}
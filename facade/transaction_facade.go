@@ -0,0 +1,144 @@
+package facade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+)
+
+const transactionPath = "/transaction"
+
+const urlParameterWithResults = "withResults"
+
+const upstreamEndpointTransaction = "transaction"
+
+// TransactionFacadeArgs holds all input dependencies required by transaction facade
+type TransactionFacadeArgs struct {
+	AvroEncoder                  AvroEncoder
+	MultiversxHyperBlockEndpoint api.MultiversxHyperBlockEndpointHandler
+	TransactionProcessor         TransactionProcessor
+	Metrics                      MetricsHandler
+	RetryPolicy                  config.RetryPolicy
+}
+
+type transactionFacade struct {
+	encoder            AvroEncoder
+	multiversxEndpoint api.MultiversxHyperBlockEndpointHandler
+	processor          TransactionProcessor
+	metrics            MetricsHandler
+	retryPolicy        *retryPolicy
+}
+
+// NewTransactionFacade will create a transaction facade, which can fetch single transactions from Multiversx proxy.
+// The avro encoder should encode records with the Transaction schema
+func NewTransactionFacade(args TransactionFacadeArgs) (*transactionFacade, error) {
+	if args.AvroEncoder == nil {
+		return nil, errNilAvroEncoder
+	}
+	if args.MultiversxHyperBlockEndpoint == nil {
+		return nil, errNilHyperBlockEndpointHandler
+	}
+	if args.TransactionProcessor == nil {
+		return nil, errNilTransactionProcessor
+	}
+	if args.Metrics == nil {
+		return nil, errNilMetricsHandler
+	}
+	retryPolicy, err := newRetryPolicy(args.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transactionFacade{
+		encoder:            args.AvroEncoder,
+		multiversxEndpoint: args.MultiversxHyperBlockEndpoint,
+		processor:          args.TransactionProcessor,
+		metrics:            args.Metrics,
+		retryPolicy:        retryPolicy,
+	}, nil
+}
+
+// GetTransactionByHash will fetch the transaction with provided hash from Multiversx proxy, along with its results and
+// logs, in covalent format
+func (tf *transactionFacade) GetTransactionByHash(ctx context.Context, hash string) (*api.CovalentHyperBlockApiResponse, error) {
+	multiversxTransaction, err := tf.getTransactionWithRetrials(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionSchema, err := tf.processTransaction(&multiversxTransaction.Data.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionSchemaAvroBytes, err := tf.encoder.Encode(transactionSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CovalentHyperBlockApiResponse{
+		Data:  transactionSchemaAvroBytes,
+		Error: "",
+		Code:  api.ReturnCodeSuccess,
+	}, nil
+}
+
+// getTransactionWithRetrials retries fetching the transaction, as defined by the retry policy. An unknown transaction
+// is not retried and is reported as api.ErrTransactionNotFound
+func (tf *transactionFacade) getTransactionWithRetrials(ctx context.Context, hash string) (*api.MultiversxTransactionApiResponse, error) {
+	request := getTransactionByHashPath(hash)
+	var multiversxTransaction *api.MultiversxTransactionApiResponse
+	err := tf.retryPolicy.retry(ctx, retriedRequest{
+		name:         "transaction",
+		request:      request,
+		errExhausted: errCouldNotGetTransaction,
+		attempt: func() (string, error) {
+			requestStart := time.Now()
+			var err error
+			multiversxTransaction, err = tf.multiversxEndpoint.GetTransaction(ctx, request)
+			tf.metrics.ObserveUpstreamRequest(upstreamEndpointTransaction, time.Since(requestStart), err)
+			return failureReasonUpstream, err
+		},
+		incRetries:  tf.metrics.IncTransactionRetries,
+		incFailures: tf.metrics.IncTransactionFailures,
+	})
+	if errors.Is(err, api.ErrTransactionNotFound) {
+		return nil, fmt.Errorf("%w: hash %s", err, hash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return multiversxTransaction, nil
+}
+
+func (tf *transactionFacade) processTransaction(apiTransaction *transaction.ApiTransactionResult) (*schema.Transaction, error) {
+	transactions, err := tf.processor.ProcessTransactions([]*transaction.ApiTransactionResult{apiTransaction})
+	if err != nil {
+		return nil, err
+	}
+	if len(transactions) != 1 {
+		return nil, fmt.Errorf("%w: expected one processed transaction, got %d", errCouldNotGetTransaction, len(transactions))
+	}
+
+	return transactions[0], nil
+}
+
+func getTransactionByHashPath(hash string) string {
+	query := url.Values{}
+	query.Set(urlParameterWithResults, "true")
+
+	u := url.URL{
+		Path:     fmt.Sprintf("%s/%s", transactionPath, hash),
+		RawQuery: query.Encode(),
+	}
+
+	return u.String()
+}
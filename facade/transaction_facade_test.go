@@ -0,0 +1,299 @@
+package facade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elodina/go-avro"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock/apiMocks"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/processMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockTransactionFacadeArgs() TransactionFacadeArgs {
+	return TransactionFacadeArgs{
+		AvroEncoder:                  &mock.AvroEncoderStub{},
+		MultiversxHyperBlockEndpoint: &apiMocks.MultiversxHyperBlockEndPointStub{},
+		TransactionProcessor:         &processMocks.TransactionHandlerStub{},
+		Metrics:                      &mock.MetricsHandlerStub{},
+		RetryPolicy:                  createMockRetryPolicy(),
+	}
+}
+
+func TestNewTransactionFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade, err := NewTransactionFacade(createMockTransactionFacadeArgs())
+		require.NotNil(t, facade)
+		require.Nil(t, err)
+	})
+
+	t.Run("nil encoder, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTransactionFacadeArgs()
+		args.AvroEncoder = nil
+		facade, err := NewTransactionFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errNilAvroEncoder, err)
+	})
+
+	t.Run("nil multiversx endpoint, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTransactionFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = nil
+		facade, err := NewTransactionFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errNilHyperBlockEndpointHandler, err)
+	})
+
+	t.Run("nil transaction processor, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTransactionFacadeArgs()
+		args.TransactionProcessor = nil
+		facade, err := NewTransactionFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errNilTransactionProcessor, err)
+	})
+
+	t.Run("nil metrics handler, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTransactionFacadeArgs()
+		args.Metrics = nil
+		facade, err := NewTransactionFacade(args)
+		require.Nil(t, facade)
+		require.Equal(t, errNilMetricsHandler, err)
+	})
+
+	t.Run("invalid retry policy, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTransactionFacadeArgs()
+		args.RetryPolicy.MaxAttempts = 0
+		facade, err := NewTransactionFacade(args)
+		require.Nil(t, facade)
+		require.True(t, errors.Is(err, errInvalidRetryMaxAttempts))
+	})
+}
+
+func TestTransactionFacade_GetTransactionByHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		observedEndpoint := ""
+		processedTransaction := &schema.Transaction{Function: "claim"}
+		args := createMockTransactionFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetTransactionCalled: func(ctx context.Context, path string) (*api.MultiversxTransactionApiResponse, error) {
+				require.Equal(t, "/transaction/ff?withResults=true", path)
+				return &api.MultiversxTransactionApiResponse{
+					Data: api.MultiversxTransactionApiResponsePayload{
+						Transaction: transaction.ApiTransactionResult{Hash: "ff"},
+					},
+				}, nil
+			},
+		}
+		args.TransactionProcessor = &processMocks.TransactionHandlerStub{
+			ProcessTransactionsCalled: func(apiTransactions []*transaction.ApiTransactionResult) ([]*schema.Transaction, error) {
+				require.Len(t, apiTransactions, 1)
+				require.Equal(t, "ff", apiTransactions[0].Hash)
+				return []*schema.Transaction{processedTransaction}, nil
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				require.Equal(t, processedTransaction, record)
+				return []byte("encoded"), nil
+			},
+		}
+		args.Metrics = &mock.MetricsHandlerStub{
+			ObserveUpstreamRequestCalled: func(endpoint string, duration time.Duration, err error) {
+				observedEndpoint = endpoint
+			},
+		}
+		facade, _ := NewTransactionFacade(args)
+
+		transactionApiResponse, err := facade.GetTransactionByHash(context.Background(), "ff")
+		require.Nil(t, err)
+		require.Equal(t, &api.CovalentHyperBlockApiResponse{
+			Data:  []byte("encoded"),
+			Error: "",
+			Code:  api.ReturnCodeSuccess,
+		}, transactionApiResponse)
+		require.Equal(t, upstreamEndpointTransaction, observedEndpoint)
+	})
+
+	t.Run("transient multiversx proxy error, should retry", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		numRetries := 0
+		args := createMockTransactionFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetTransactionCalled: func(ctx context.Context, path string) (*api.MultiversxTransactionApiResponse, error) {
+				numCalls++
+				if numCalls < 3 {
+					return nil, errors.New("status code: 502")
+				}
+				return &api.MultiversxTransactionApiResponse{}, nil
+			},
+		}
+		args.TransactionProcessor = &processMocks.TransactionHandlerStub{
+			ProcessTransactionsCalled: func(apiTransactions []*transaction.ApiTransactionResult) ([]*schema.Transaction, error) {
+				return []*schema.Transaction{{}}, nil
+			},
+		}
+		args.Metrics = &mock.MetricsHandlerStub{
+			IncTransactionRetriesCalled: func(reason string) {
+				require.Equal(t, failureReasonUpstream, reason)
+				numRetries++
+			},
+			IncRetriesCalled: func(reason string) {
+				require.Fail(t, "should not record hyper block retries")
+			},
+		}
+		facade, _ := NewTransactionFacade(args)
+
+		transactionApiResponse, err := facade.GetTransactionByHash(context.Background(), "ff")
+		require.Nil(t, err)
+		require.NotNil(t, transactionApiResponse)
+		require.Equal(t, 3, numCalls)
+		require.Equal(t, 2, numRetries)
+	})
+
+	t.Run("multiversx proxy keeps failing, should return error after max attempts", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		numFailures := 0
+		args := createMockTransactionFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetTransactionCalled: func(ctx context.Context, path string) (*api.MultiversxTransactionApiResponse, error) {
+				numCalls++
+				return nil, errors.New("upstream error")
+			},
+		}
+		args.Metrics = &mock.MetricsHandlerStub{
+			IncTransactionFailuresCalled: func(reason string) {
+				require.Equal(t, failureReasonUpstream, reason)
+				numFailures++
+			},
+			IncFailuresCalled: func(reason string) {
+				require.Fail(t, "should not record hyper block failures")
+			},
+		}
+		facade, _ := NewTransactionFacade(args)
+
+		transactionApiResponse, err := facade.GetTransactionByHash(context.Background(), "ff")
+		require.Nil(t, transactionApiResponse)
+		require.True(t, errors.Is(err, errCouldNotGetTransaction))
+		require.Equal(t, int(createMockRetryPolicy().MaxAttempts), numCalls)
+		require.Equal(t, 1, numFailures)
+	})
+
+	t.Run("non retryable multiversx proxy error, should not retry", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		errUpstream := fmt.Errorf("%w: status code: 400", api.ErrNonRetryableResponse)
+		args := createMockTransactionFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetTransactionCalled: func(ctx context.Context, path string) (*api.MultiversxTransactionApiResponse, error) {
+				numCalls++
+				return nil, errUpstream
+			},
+		}
+		facade, _ := NewTransactionFacade(args)
+
+		transactionApiResponse, err := facade.GetTransactionByHash(context.Background(), "ff")
+		require.Nil(t, transactionApiResponse)
+		require.Equal(t, errUpstream, err)
+		require.Equal(t, 1, numCalls)
+	})
+
+	t.Run("transaction not found, should return not found error without retrying", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		errUpstream := fmt.Errorf("%w, status code: 500, multiversx proxy response error: transaction not found", api.ErrTransactionNotFound)
+		args := createMockTransactionFacadeArgs()
+		args.MultiversxHyperBlockEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetTransactionCalled: func(ctx context.Context, path string) (*api.MultiversxTransactionApiResponse, error) {
+				numCalls++
+				return nil, errUpstream
+			},
+		}
+		facade, _ := NewTransactionFacade(args)
+
+		transactionApiResponse, err := facade.GetTransactionByHash(context.Background(), "ff")
+		require.Nil(t, transactionApiResponse)
+		require.True(t, errors.Is(err, api.ErrTransactionNotFound))
+		require.True(t, strings.Contains(err.Error(), "ff"))
+		require.Equal(t, 1, numCalls)
+	})
+
+	t.Run("could not process transaction, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errProcess := errors.New("process error")
+		args := createMockTransactionFacadeArgs()
+		args.TransactionProcessor = &processMocks.TransactionHandlerStub{
+			ProcessTransactionsCalled: func(apiTransactions []*transaction.ApiTransactionResult) ([]*schema.Transaction, error) {
+				return nil, errProcess
+			},
+		}
+		facade, _ := NewTransactionFacade(args)
+
+		transactionApiResponse, err := facade.GetTransactionByHash(context.Background(), "ff")
+		require.Nil(t, transactionApiResponse)
+		require.Equal(t, errProcess, err)
+	})
+
+	t.Run("no processed transaction, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		facade, _ := NewTransactionFacade(createMockTransactionFacadeArgs())
+
+		transactionApiResponse, err := facade.GetTransactionByHash(context.Background(), "ff")
+		require.Nil(t, transactionApiResponse)
+		require.True(t, errors.Is(err, errCouldNotGetTransaction))
+	})
+
+	t.Run("could not encode transaction, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errEncode := errors.New("encode error")
+		args := createMockTransactionFacadeArgs()
+		args.TransactionProcessor = &processMocks.TransactionHandlerStub{
+			ProcessTransactionsCalled: func(apiTransactions []*transaction.ApiTransactionResult) ([]*schema.Transaction, error) {
+				return []*schema.Transaction{{}}, nil
+			},
+		}
+		args.AvroEncoder = &mock.AvroEncoderStub{
+			EncodeCalled: func(record avro.AvroRecord) ([]byte, error) {
+				return nil, errEncode
+			},
+		}
+		facade, _ := NewTransactionFacade(args)
+
+		transactionApiResponse, err := facade.GetTransactionByHash(context.Background(), "ff")
+		require.Nil(t, transactionApiResponse)
+		require.Equal(t, errEncode, err)
+	})
+}
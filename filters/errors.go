@@ -0,0 +1,5 @@
+package filters
+
+import "errors"
+
+var errNilAddressConverter = errors.New("nil address converter provided")
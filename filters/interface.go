@@ -0,0 +1,6 @@
+package filters
+
+// AddressConverter should provide the human readable form of addresses, as written in hyper blocks
+type AddressConverter interface {
+	DecodeAddress(address []byte) string
+}
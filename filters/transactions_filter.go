@@ -0,0 +1,116 @@
+package filters
+
+import (
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+)
+
+// transactionsFilter prunes the transactions of hyper blocks which do not match all the non empty criteria of the
+// provided filter. Each criterion holds its accepted values
+type transactionsFilter struct {
+	senders          map[string]struct{}
+	receivers        map[string]struct{}
+	functions        map[string]struct{}
+	tokens           map[string]struct{}
+	statuses         map[string]struct{}
+	miniBlockTypes   map[string]struct{}
+	addressConverter AddressConverter
+}
+
+// NewTransactionsFilter creates a transactions filter, matching addresses in the encoding of the address converter
+func NewTransactionsFilter(cfg config.TransactionsFilter, addressConverter AddressConverter) (*transactionsFilter, error) {
+	if addressConverter == nil {
+		return nil, errNilAddressConverter
+	}
+
+	return &transactionsFilter{
+		senders:          toSet(cfg.Senders),
+		receivers:        toSet(cfg.Receivers),
+		functions:        toSet(cfg.Functions),
+		tokens:           toSet(cfg.Tokens),
+		statuses:         toSet(cfg.Statuses),
+		miniBlockTypes:   toSet(cfg.MiniBlockTypes),
+		addressConverter: addressConverter,
+	}, nil
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
+
+// IsEmpty returns true if the filter has no criteria, such that all transactions are kept
+func (tf *transactionsFilter) IsEmpty() bool {
+	return len(tf.senders) == 0 &&
+		len(tf.receivers) == 0 &&
+		len(tf.functions) == 0 &&
+		len(tf.tokens) == 0 &&
+		len(tf.statuses) == 0 &&
+		len(tf.miniBlockTypes) == 0
+}
+
+// Filter keeps the matching transactions of the provided hyper block and updates its number of transactions
+func (tf *transactionsFilter) Filter(hyperBlock *schema.HyperBlock) {
+	if tf.IsEmpty() {
+		return
+	}
+
+	filteredTransactions := make([]*schema.Transaction, 0)
+	for _, tx := range hyperBlock.Transactions {
+		if tf.isMatching(tx) {
+			filteredTransactions = append(filteredTransactions, tx)
+		}
+	}
+
+	hyperBlock.Transactions = filteredTransactions
+	hyperBlock.NumTxs = int32(len(filteredTransactions))
+}
+
+func (tf *transactionsFilter) isMatching(tx *schema.Transaction) bool {
+	if tx == nil {
+		return false
+	}
+
+	return tf.isAddressMatching(tf.senders, tx.Sender) &&
+		tf.isAddressMatching(tf.receivers, tx.Receiver) &&
+		isValueMatching(tf.functions, tx.Function) &&
+		isAnyValueMatching(tf.tokens, tx.Tokens) &&
+		isValueMatching(tf.statuses, tx.Status) &&
+		isValueMatching(tf.miniBlockTypes, tx.MiniBlockType)
+}
+
+func (tf *transactionsFilter) isAddressMatching(set map[string]struct{}, address []byte) bool {
+	if len(set) == 0 {
+		return true
+	}
+
+	return isValueMatching(set, tf.addressConverter.DecodeAddress(address))
+}
+
+func isValueMatching(set map[string]struct{}, value string) bool {
+	if len(set) == 0 {
+		return true
+	}
+
+	_, found := set[value]
+	return found
+}
+
+func isAnyValueMatching(set map[string]struct{}, values []string) bool {
+	if len(set) == 0 {
+		return true
+	}
+
+	for _, value := range values {
+		_, found := set[value]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,137 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createFilteredHyperBlock() *schema.HyperBlock {
+	return &schema.HyperBlock{
+		NumTxs: 4,
+		Transactions: []*schema.Transaction{
+			{
+				Sender:        []byte("erd1a"),
+				Receiver:      []byte("erd1sc"),
+				Function:      "claim",
+				Status:        "success",
+				MiniBlockType: "TxBlock",
+			},
+			{
+				Sender:        []byte("erd1b"),
+				Receiver:      []byte("erd1sc"),
+				Function:      "ESDTTransfer",
+				Tokens:        []string{"WEGLD-abcdef"},
+				Status:        "fail",
+				MiniBlockType: "TxBlock",
+			},
+			{
+				Sender:        []byte("erd1sc"),
+				Receiver:      []byte("erd1b"),
+				Tokens:        []string{"MEX-abcdef", "WEGLD-abcdef"},
+				Status:        "success",
+				MiniBlockType: "SmartContractResultBlock",
+			},
+			nil,
+		},
+	}
+}
+
+func createAddressConverterStub() *mock.AddressConverterStub {
+	return &mock.AddressConverterStub{
+		DecodeAddressCalled: func(address []byte) string {
+			return string(address)
+		},
+	}
+}
+
+func getFilteredSenders(hyperBlock *schema.HyperBlock) []string {
+	senders := make([]string, 0, len(hyperBlock.Transactions))
+	for _, tx := range hyperBlock.Transactions {
+		senders = append(senders, string(tx.Sender))
+	}
+
+	return senders
+}
+
+func filterHyperBlock(t *testing.T, cfg config.TransactionsFilter, hyperBlock *schema.HyperBlock) {
+	tf, err := NewTransactionsFilter(cfg, createAddressConverterStub())
+	require.Nil(t, err)
+
+	tf.Filter(hyperBlock)
+}
+
+func TestNewTransactionsFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tf, err := NewTransactionsFilter(config.TransactionsFilter{}, createAddressConverterStub())
+		require.Nil(t, err)
+		require.True(t, tf.IsEmpty())
+
+		tf, err = NewTransactionsFilter(config.TransactionsFilter{Statuses: []string{"success"}}, createAddressConverterStub())
+		require.Nil(t, err)
+		require.False(t, tf.IsEmpty())
+	})
+
+	t.Run("nil address converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		tf, err := NewTransactionsFilter(config.TransactionsFilter{}, nil)
+		require.Nil(t, tf)
+		require.Equal(t, errNilAddressConverter, err)
+	})
+}
+
+func TestTransactionsFilter_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty filter, should keep all transactions", func(t *testing.T) {
+		t.Parallel()
+
+		hyperBlock := createFilteredHyperBlock()
+		filterHyperBlock(t, config.TransactionsFilter{Senders: []string{}}, hyperBlock)
+		require.Equal(t, createFilteredHyperBlock(), hyperBlock)
+	})
+
+	t.Run("single criterion, should keep matching transactions", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			filter          config.TransactionsFilter
+			expectedSenders []string
+		}{
+			{filter: config.TransactionsFilter{Senders: []string{"erd1a", "erd1sc"}}, expectedSenders: []string{"erd1a", "erd1sc"}},
+			{filter: config.TransactionsFilter{Receivers: []string{"erd1sc"}}, expectedSenders: []string{"erd1a", "erd1b"}},
+			{filter: config.TransactionsFilter{Functions: []string{"claim"}}, expectedSenders: []string{"erd1a"}},
+			{filter: config.TransactionsFilter{Tokens: []string{"WEGLD-abcdef"}}, expectedSenders: []string{"erd1b", "erd1sc"}},
+			{filter: config.TransactionsFilter{Statuses: []string{"fail"}}, expectedSenders: []string{"erd1b"}},
+			{filter: config.TransactionsFilter{MiniBlockTypes: []string{"SmartContractResultBlock"}}, expectedSenders: []string{"erd1sc"}},
+			{filter: config.TransactionsFilter{Functions: []string{"unknown"}}, expectedSenders: []string{}},
+		}
+		for _, testCase := range testCases {
+			hyperBlock := createFilteredHyperBlock()
+			filterHyperBlock(t, testCase.filter, hyperBlock)
+			require.Equal(t, testCase.expectedSenders, getFilteredSenders(hyperBlock))
+			require.Equal(t, int32(len(testCase.expectedSenders)), hyperBlock.NumTxs)
+		}
+	})
+
+	t.Run("multiple criteria, should keep transactions matching all of them", func(t *testing.T) {
+		t.Parallel()
+
+		hyperBlock := createFilteredHyperBlock()
+		filter := config.TransactionsFilter{
+			Receivers: []string{"erd1sc", "erd1b"},
+			Statuses:  []string{"success"},
+		}
+		filterHyperBlock(t, filter, hyperBlock)
+		require.Equal(t, []string{"erd1a", "erd1sc"}, getFilteredSenders(hyperBlock))
+		require.Equal(t, int32(2), hyperBlock.NumTxs)
+	})
+}
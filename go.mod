@@ -5,9 +5,14 @@ go 1.16
 require (
 	github.com/elodina/go-avro v0.0.0-20160406082632-0c8185d9a3ba
 	github.com/gin-gonic/gin v1.8.1
+	github.com/golang/snappy v0.0.4
+	github.com/gorilla/websocket v1.5.0
 	github.com/multiversx/mx-chain-core-go v1.1.30
 	github.com/multiversx/mx-chain-logger-go v1.0.11
 	github.com/pelletier/go-toml v1.9.3
-	github.com/stretchr/testify v1.7.1
+	github.com/prometheus/client_golang v1.12.2
+	github.com/segmentio/kafka-go v0.4.47
+	github.com/stretchr/testify v1.8.0
+	github.com/syndtr/goleveldb v1.0.1-0.20210819022825-2ae1ddf74ef7
 	github.com/urfave/cli v1.22.10
 )
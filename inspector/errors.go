@@ -0,0 +1,15 @@
+package inspector
+
+import "errors"
+
+var errNilAvroMarshaller = errors.New("nil avro marshaller provided")
+
+var errNilJsonConverter = errors.New("nil json converter provided")
+
+var errNilAddressConverter = errors.New("nil address converter provided")
+
+var errEmptyPayload = errors.New("empty payload")
+
+var errInvalidApiResponse = errors.New("invalid hyper block api response")
+
+var errNoHyperBlockInResponse = errors.New("no hyper block found in response")
@@ -0,0 +1,19 @@
+package inspector
+
+// ErrNilAvroMarshaller -
+var ErrNilAvroMarshaller = errNilAvroMarshaller
+
+// ErrNilJsonConverter -
+var ErrNilJsonConverter = errNilJsonConverter
+
+// ErrNilAddressConverter -
+var ErrNilAddressConverter = errNilAddressConverter
+
+// ErrEmptyPayload -
+var ErrEmptyPayload = errEmptyPayload
+
+// ErrInvalidApiResponse -
+var ErrInvalidApiResponse = errInvalidApiResponse
+
+// ErrNoHyperBlockInResponse -
+var ErrNoHyperBlockInResponse = errNoHyperBlockInResponse
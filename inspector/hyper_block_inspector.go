@@ -0,0 +1,199 @@
+package inspector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/filters"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+)
+
+// ArgsHyperBlockInspector holds all input dependencies required by hyper block inspector
+type ArgsHyperBlockInspector struct {
+	AvroMarshaller   AvroMarshaller
+	JsonConverter    JsonConverter
+	AddressConverter AddressConverter
+}
+
+type hyperBlockInspector struct {
+	avroMarshaller   AvroMarshaller
+	jsonConverter    JsonConverter
+	addressConverter AddressConverter
+}
+
+// NewHyperBlockInspector creates an inspector of avro encoded hyper blocks, which writes them as human readable json:
+// hashes as hex, big numbers as decimals and addresses in their configured encoding
+func NewHyperBlockInspector(args ArgsHyperBlockInspector) (*hyperBlockInspector, error) {
+	if args.AvroMarshaller == nil {
+		return nil, errNilAvroMarshaller
+	}
+	if args.JsonConverter == nil {
+		return nil, errNilJsonConverter
+	}
+	if args.AddressConverter == nil {
+		return nil, errNilAddressConverter
+	}
+
+	return &hyperBlockInspector{
+		avroMarshaller:   args.AvroMarshaller,
+		jsonConverter:    args.JsonConverter,
+		addressConverter: args.AddressConverter,
+	}, nil
+}
+
+// Inspect returns the indented human readable json of the provided avro encoded hyper block, holding only the
+// transactions matching the provided filter
+func (hbi *hyperBlockInspector) Inspect(encodedHyperBlock []byte, filter config.TransactionsFilter) ([]byte, error) {
+	readableJson, err := hbi.toReadableJson(encodedHyperBlock, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	indentedJson := &bytes.Buffer{}
+	err = json.Indent(indentedJson, readableJson, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return indentedJson.Bytes(), nil
+}
+
+// Diff returns the differences between the two provided avro encoded hyper blocks, one per line, ordered by their
+// field path. Each difference is written as "~ path: first -> second" for changed values, "- path: first" for values
+// found only in the first hyper block and "+ path: second" for values found only in the second one
+func (hbi *hyperBlockInspector) Diff(firstEncodedHyperBlock []byte, secondEncodedHyperBlock []byte) ([]string, error) {
+	first, err := hbi.toGenericJson(firstEncodedHyperBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode first hyper block: %w", err)
+	}
+	second, err := hbi.toGenericJson(secondEncodedHyperBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode second hyper block: %w", err)
+	}
+
+	differences := make([]string, 0)
+	diffValues("", first, second, &differences)
+
+	return differences, nil
+}
+
+func (hbi *hyperBlockInspector) toGenericJson(encodedHyperBlock []byte) (interface{}, error) {
+	readableJson, err := hbi.toReadableJson(encodedHyperBlock, config.TransactionsFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	var genericJson interface{}
+	decoder := json.NewDecoder(bytes.NewReader(readableJson))
+	decoder.UseNumber()
+	err = decoder.Decode(&genericJson)
+
+	return genericJson, err
+}
+
+func (hbi *hyperBlockInspector) toReadableJson(encodedHyperBlock []byte, filter config.TransactionsFilter) (json.RawMessage, error) {
+	if len(encodedHyperBlock) == 0 {
+		return nil, errEmptyPayload
+	}
+
+	transactionsFilter, err := filters.NewTransactionsFilter(filter, hbi.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+	if transactionsFilter.IsEmpty() {
+		return hbi.jsonConverter.ToReadableJson(encodedHyperBlock)
+	}
+
+	hyperBlock := schema.NewHyperBlock()
+	err = hbi.avroMarshaller.Decode(hyperBlock, encodedHyperBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionsFilter.Filter(hyperBlock)
+	filteredHyperBlock, err := hbi.avroMarshaller.Encode(hyperBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return hbi.jsonConverter.ToReadableJson(filteredHyperBlock)
+}
+
+func diffValues(path string, first interface{}, second interface{}, differences *[]string) {
+	firstMap, isFirstMap := first.(map[string]interface{})
+	secondMap, isSecondMap := second.(map[string]interface{})
+	if isFirstMap && isSecondMap {
+		diffMaps(path, firstMap, secondMap, differences)
+		return
+	}
+
+	firstArray, isFirstArray := first.([]interface{})
+	secondArray, isSecondArray := second.([]interface{})
+	if isFirstArray && isSecondArray {
+		diffArrays(path, firstArray, secondArray, differences)
+		return
+	}
+
+	firstValue, secondValue := toCompactJson(first), toCompactJson(second)
+	if firstValue != secondValue {
+		*differences = append(*differences, fmt.Sprintf("~ %s: %s -> %s", path, firstValue, secondValue))
+	}
+}
+
+func diffMaps(path string, first map[string]interface{}, second map[string]interface{}, differences *[]string) {
+	keys := make([]string, 0, len(first)+len(second))
+	for key := range first {
+		keys = append(keys, key)
+	}
+	for key := range second {
+		_, found := first[key]
+		if !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+
+		firstValue, isInFirst := first[key]
+		secondValue, isInSecond := second[key]
+		switch {
+		case !isInSecond:
+			*differences = append(*differences, fmt.Sprintf("- %s: %s", fieldPath, toCompactJson(firstValue)))
+		case !isInFirst:
+			*differences = append(*differences, fmt.Sprintf("+ %s: %s", fieldPath, toCompactJson(secondValue)))
+		default:
+			diffValues(fieldPath, firstValue, secondValue, differences)
+		}
+	}
+}
+
+func diffArrays(path string, first []interface{}, second []interface{}, differences *[]string) {
+	for idx := 0; idx < len(first) || idx < len(second); idx++ {
+		elementPath := fmt.Sprintf("%s[%d]", path, idx)
+		switch {
+		case idx >= len(second):
+			*differences = append(*differences, fmt.Sprintf("- %s: %s", elementPath, toCompactJson(first[idx])))
+		case idx >= len(first):
+			*differences = append(*differences, fmt.Sprintf("+ %s: %s", elementPath, toCompactJson(second[idx])))
+		default:
+			diffValues(elementPath, first[idx], second[idx], differences)
+		}
+	}
+}
+
+func toCompactJson(value interface{}) string {
+	compactJson, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(compactJson)
+}
@@ -0,0 +1,240 @@
+package inspector_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/inspector"
+	"github.com/multiversx/mx-chain-covalent-go/jsonConverter"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	firstAddress  = "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+	secondAddress = "erd1wh9c0sjr2xn8hzf02lwwcr4jk2s84tat9ud2kaq6zr7xzpvl9l5q8awmex"
+)
+
+func createMockArgsHyperBlockInspector() inspector.ArgsHyperBlockInspector {
+	avroMarshaller, _ := utility.NewAvroMarshallerWithSchema(schema.HyperBlockSchemaDefinition)
+	addressConverter := utility.NewBech32AddressConverter()
+	converter, _ := jsonConverter.NewHyperBlockJsonConverter(jsonConverter.ArgsHyperBlockJsonConverter{
+		SchemaDefinition: schema.HyperBlockSchemaDefinition,
+		AvroDecoder:      avroMarshaller,
+		AddressConverter: addressConverter,
+	})
+
+	return inspector.ArgsHyperBlockInspector{
+		AvroMarshaller:   avroMarshaller,
+		JsonConverter:    converter,
+		AddressConverter: addressConverter,
+	}
+}
+
+func createTransaction(sender string, receiver string, value int64) *schema.Transaction {
+	tx := schema.NewTransaction()
+	tx.Hash = testscommon.GenerateRandomFixedBytes(32)
+	tx.Sender = utility.GetAddressOrMetachainAddr(sender)
+	tx.Receiver = utility.GetAddressOrMetachainAddr(receiver)
+	tx.Value = big.NewInt(value).Bytes()
+
+	return tx
+}
+
+func createHyperBlock() *schema.HyperBlock {
+	hyperBlock := schema.NewHyperBlock()
+	hyperBlock.Hash = bytes.Repeat([]byte{0xaa}, 32)
+	hyperBlock.Nonce = 4
+	hyperBlock.AccumulatedFees = big.NewInt(5000).Bytes()
+	hyperBlock.Transactions = []*schema.Transaction{
+		createTransaction(firstAddress, secondAddress, 1),
+		createTransaction(secondAddress, firstAddress, 2),
+		createTransaction(firstAddress, utility.MetachainShardName, 3),
+	}
+	hyperBlock.Status = "on-chain"
+
+	return hyperBlock
+}
+
+func encodeHyperBlock(t *testing.T, hyperBlock *schema.HyperBlock) []byte {
+	avroMarshaller, _ := utility.NewAvroMarshallerWithSchema(schema.HyperBlockSchemaDefinition)
+	encodedHyperBlock, err := avroMarshaller.Encode(hyperBlock)
+	require.Nil(t, err)
+
+	return encodedHyperBlock
+}
+
+func getTransactionValues(t *testing.T, inspectedHyperBlock []byte) []string {
+	hyperBlock := &struct {
+		Transactions []struct {
+			Value string
+		}
+	}{}
+	err := json.Unmarshal(inspectedHyperBlock, hyperBlock)
+	require.Nil(t, err)
+
+	values := make([]string, 0, len(hyperBlock.Transactions))
+	for _, tx := range hyperBlock.Transactions {
+		values = append(values, tx.Value)
+	}
+
+	return values
+}
+
+func TestNewHyperBlockInspector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hbi, err := inspector.NewHyperBlockInspector(createMockArgsHyperBlockInspector())
+		require.Nil(t, err)
+		require.NotNil(t, hbi)
+	})
+
+	t.Run("nil avro marshaller, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockInspector()
+		args.AvroMarshaller = nil
+		hbi, err := inspector.NewHyperBlockInspector(args)
+		require.Nil(t, hbi)
+		require.Equal(t, inspector.ErrNilAvroMarshaller, err)
+	})
+
+	t.Run("nil json converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockInspector()
+		args.JsonConverter = nil
+		hbi, err := inspector.NewHyperBlockInspector(args)
+		require.Nil(t, hbi)
+		require.Equal(t, inspector.ErrNilJsonConverter, err)
+	})
+
+	t.Run("nil address converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockInspector()
+		args.AddressConverter = nil
+		hbi, err := inspector.NewHyperBlockInspector(args)
+		require.Nil(t, hbi)
+		require.Equal(t, inspector.ErrNilAddressConverter, err)
+	})
+}
+
+func TestHyperBlockInspector_Inspect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no filter, should write the whole hyper block as readable json", func(t *testing.T) {
+		t.Parallel()
+
+		hbi, _ := inspector.NewHyperBlockInspector(createMockArgsHyperBlockInspector())
+		inspectedHyperBlock, err := hbi.Inspect(encodeHyperBlock(t, createHyperBlock()), config.TransactionsFilter{})
+		require.Nil(t, err)
+		require.Contains(t, string(inspectedHyperBlock), "\n  \"Hash\": \""+strings.Repeat("aa", 32)+"\"")
+		require.Contains(t, string(inspectedHyperBlock), "\"AccumulatedFees\": \"5000\"")
+		require.Equal(t, []string{"1", "2", "3"}, getTransactionValues(t, inspectedHyperBlock))
+	})
+
+	t.Run("sender filter, should only keep transactions from sender", func(t *testing.T) {
+		t.Parallel()
+
+		hbi, _ := inspector.NewHyperBlockInspector(createMockArgsHyperBlockInspector())
+		filter := config.TransactionsFilter{Senders: []string{firstAddress}}
+		inspectedHyperBlock, err := hbi.Inspect(encodeHyperBlock(t, createHyperBlock()), filter)
+		require.Nil(t, err)
+		require.Equal(t, []string{"1", "3"}, getTransactionValues(t, inspectedHyperBlock))
+		require.Contains(t, string(inspectedHyperBlock), "\"NumTxs\": 2")
+	})
+
+	t.Run("sender and receiver filters, should only keep matching transactions", func(t *testing.T) {
+		t.Parallel()
+
+		hbi, _ := inspector.NewHyperBlockInspector(createMockArgsHyperBlockInspector())
+		filter := config.TransactionsFilter{
+			Senders:   []string{firstAddress},
+			Receivers: []string{utility.MetachainShardName},
+		}
+		inspectedHyperBlock, err := hbi.Inspect(encodeHyperBlock(t, createHyperBlock()), filter)
+		require.Nil(t, err)
+		require.Equal(t, []string{"3"}, getTransactionValues(t, inspectedHyperBlock))
+		require.Contains(t, string(inspectedHyperBlock), "\"NumTxs\": 1")
+	})
+
+	t.Run("empty payload, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		hbi, _ := inspector.NewHyperBlockInspector(createMockArgsHyperBlockInspector())
+		inspectedHyperBlock, err := hbi.Inspect(nil, config.TransactionsFilter{})
+		require.Nil(t, inspectedHyperBlock)
+		require.Equal(t, inspector.ErrEmptyPayload, err)
+	})
+
+	t.Run("could not convert hyper block, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errConvert := errors.New("error converting")
+		args := createMockArgsHyperBlockInspector()
+		args.JsonConverter = &mock.HyperBlockJsonConverterStub{
+			ToReadableJsonCalled: func(encodedHyperBlock []byte) (json.RawMessage, error) {
+				return nil, errConvert
+			},
+		}
+		hbi, _ := inspector.NewHyperBlockInspector(args)
+		inspectedHyperBlock, err := hbi.Inspect([]byte{0x1}, config.TransactionsFilter{})
+		require.Nil(t, inspectedHyperBlock)
+		require.Equal(t, errConvert, err)
+	})
+}
+
+func TestHyperBlockInspector_Diff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same hyper blocks, should return no differences", func(t *testing.T) {
+		t.Parallel()
+
+		encodedHyperBlock := encodeHyperBlock(t, createHyperBlock())
+		hbi, _ := inspector.NewHyperBlockInspector(createMockArgsHyperBlockInspector())
+		differences, err := hbi.Diff(encodedHyperBlock, encodedHyperBlock)
+		require.Nil(t, err)
+		require.Empty(t, differences)
+	})
+
+	t.Run("different hyper blocks, should return differences ordered by path", func(t *testing.T) {
+		t.Parallel()
+
+		firstHyperBlock := createHyperBlock()
+		secondHyperBlock := createHyperBlock()
+		secondHyperBlock.Hash = bytes.Repeat([]byte{0xbb}, 32)
+		secondHyperBlock.AccumulatedFees = big.NewInt(6000).Bytes()
+		secondHyperBlock.Transactions = []*schema.Transaction{firstHyperBlock.Transactions[0]}
+		firstHyperBlock.Transactions = []*schema.Transaction{firstHyperBlock.Transactions[0], firstHyperBlock.Transactions[1]}
+
+		hbi, _ := inspector.NewHyperBlockInspector(createMockArgsHyperBlockInspector())
+		differences, err := hbi.Diff(encodeHyperBlock(t, firstHyperBlock), encodeHyperBlock(t, secondHyperBlock))
+		require.Nil(t, err)
+		require.Len(t, differences, 3)
+		require.Equal(t, "~ AccumulatedFees: \"5000\" -> \"6000\"", differences[0])
+		require.Equal(t, "~ Hash: \""+strings.Repeat("aa", 32)+"\" -> \""+strings.Repeat("bb", 32)+"\"", differences[1])
+		require.Contains(t, differences[2], "- Transactions[1]: {")
+		require.Contains(t, differences[2], secondAddress)
+	})
+
+	t.Run("invalid second hyper block, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		hbi, _ := inspector.NewHyperBlockInspector(createMockArgsHyperBlockInspector())
+		differences, err := hbi.Diff(encodeHyperBlock(t, createHyperBlock()), nil)
+		require.Nil(t, differences)
+		require.True(t, errors.Is(err, inspector.ErrEmptyPayload))
+	})
+}
@@ -0,0 +1,31 @@
+package inspector
+
+import (
+	"encoding/json"
+
+	"github.com/elodina/go-avro"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+)
+
+// AvroMarshaller should encode and decode avro records
+type AvroMarshaller interface {
+	Encode(record avro.AvroRecord) ([]byte, error)
+	Decode(record avro.AvroRecord, buffer []byte) error
+}
+
+// JsonConverter should convert avro encoded hyper blocks to human readable json
+type JsonConverter interface {
+	ToReadableJson(encodedHyperBlock []byte) (json.RawMessage, error)
+}
+
+// AddressConverter should provide the human readable form of addresses, as written in hyper blocks
+type AddressConverter interface {
+	DecodeAddress(address []byte) string
+}
+
+// HyperBlockInspector should write avro encoded hyper blocks as human readable json and find the differences between
+// two avro encoded hyper blocks
+type HyperBlockInspector interface {
+	Inspect(encodedHyperBlock []byte, filter config.TransactionsFilter) ([]byte, error)
+	Diff(firstEncodedHyperBlock []byte, secondEncodedHyperBlock []byte) ([]string, error)
+}
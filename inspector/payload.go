@@ -0,0 +1,44 @@
+package inspector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+)
+
+type hyperBlockApiResponse struct {
+	Data  []byte `json:"data"`
+	Error string `json:"error"`
+}
+
+// GetEncodedHyperBlock returns the avro encoded hyper block held by the provided payload, which is either the avro
+// encoded hyper block itself or a covalent proxy hyper block response, holding it in its data field. If the hyper block
+// is encoded in the schema registry wire format, its header is removed
+func GetEncodedHyperBlock(payload []byte, isWireFormat bool) ([]byte, error) {
+	if len(payload) == 0 {
+		return nil, errEmptyPayload
+	}
+
+	encodedHyperBlock := payload
+	trimmedPayload := bytes.TrimSpace(payload)
+	if len(trimmedPayload) > 0 && trimmedPayload[0] == '{' {
+		response := &hyperBlockApiResponse{}
+		err := json.Unmarshal(trimmedPayload, response)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidApiResponse, err)
+		}
+		if len(response.Data) == 0 {
+			return nil, fmt.Errorf("%w: %s", errNoHyperBlockInResponse, response.Error)
+		}
+
+		encodedHyperBlock = response.Data
+	}
+	if !isWireFormat {
+		return encodedHyperBlock, nil
+	}
+
+	encodedHyperBlock, _, err := utility.RemoveWireFormatHeader(encodedHyperBlock)
+	return encodedHyperBlock, err
+}
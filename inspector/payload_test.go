@@ -0,0 +1,78 @@
+package inspector_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-covalent-go/inspector"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEncodedHyperBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("raw avro payload, should return it", func(t *testing.T) {
+		t.Parallel()
+
+		encodedHyperBlock, err := inspector.GetEncodedHyperBlock([]byte{0x4, 0x5}, false)
+		require.Nil(t, err)
+		require.Equal(t, []byte{0x4, 0x5}, encodedHyperBlock)
+	})
+
+	t.Run("api response payload, should return its data", func(t *testing.T) {
+		t.Parallel()
+
+		payload := []byte(` {"data":"BAU=","error":"","code":"successful"}`)
+		encodedHyperBlock, err := inspector.GetEncodedHyperBlock(payload, false)
+		require.Nil(t, err)
+		require.Equal(t, []byte{0x4, 0x5}, encodedHyperBlock)
+	})
+
+	t.Run("api response without data, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		payload := []byte(`{"data":null,"error":"block not found","code":"internal_issue"}`)
+		encodedHyperBlock, err := inspector.GetEncodedHyperBlock(payload, false)
+		require.Nil(t, encodedHyperBlock)
+		require.True(t, errors.Is(err, inspector.ErrNoHyperBlockInResponse))
+		require.Contains(t, err.Error(), "block not found")
+	})
+
+	t.Run("malformed api response payload, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		payload := []byte(`{"data":"not base64","error":"","code":"successful"}`)
+		encodedHyperBlock, err := inspector.GetEncodedHyperBlock(payload, false)
+		require.Nil(t, encodedHyperBlock)
+		require.True(t, errors.Is(err, inspector.ErrInvalidApiResponse))
+	})
+
+	t.Run("wire format payload, should remove its header", func(t *testing.T) {
+		t.Parallel()
+
+		encodedHyperBlock, err := inspector.GetEncodedHyperBlock([]byte{0x0, 0x0, 0x0, 0x0, 0x7, 0x4, 0x5}, true)
+		require.Nil(t, err)
+		require.Equal(t, []byte{0x4, 0x5}, encodedHyperBlock)
+	})
+
+	t.Run("invalid wire format payload, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		encodedHyperBlock, err := inspector.GetEncodedHyperBlock([]byte{0x1, 0x0, 0x0, 0x0, 0x7, 0x4}, true)
+		require.Nil(t, encodedHyperBlock)
+		require.Equal(t, utility.ErrInvalidWireFormat, err)
+
+		encodedHyperBlock, err = inspector.GetEncodedHyperBlock([]byte{0x0, 0x0}, true)
+		require.Nil(t, encodedHyperBlock)
+		require.Equal(t, utility.ErrInvalidWireFormat, err)
+	})
+
+	t.Run("empty payload, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		encodedHyperBlock, err := inspector.GetEncodedHyperBlock(nil, false)
+		require.Nil(t, encodedHyperBlock)
+		require.Equal(t, inspector.ErrEmptyPayload, err)
+	})
+}
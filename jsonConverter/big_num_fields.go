@@ -0,0 +1,76 @@
+package jsonConverter
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	logicalTypeBigNum = "bignum"
+	// addressTypeName is the name of the fixed avro type holding addresses
+	addressTypeName = "address"
+)
+
+// getBigNumFields returns the record fields holding big integers(bytes having the bignum logical type, possibly in
+// arrays or unions), keyed by record name and field name. Logical types are not kept by parsed avro schemas, so they
+// are collected from the schema definition
+func getBigNumFields(schemaDefinition string) (map[string]struct{}, error) {
+	var definition interface{}
+	err := json.Unmarshal([]byte(schemaDefinition), &definition)
+	if err != nil {
+		return nil, err
+	}
+
+	bigNumFields := make(map[string]struct{})
+	collectBigNumFields(definition, bigNumFields)
+
+	return bigNumFields, nil
+}
+
+func collectBigNumFields(definition interface{}, bigNumFields map[string]struct{}) {
+	switch value := definition.(type) {
+	case []interface{}:
+		for _, item := range value {
+			collectBigNumFields(item, bigNumFields)
+		}
+	case map[string]interface{}:
+		recordName, _ := value["name"].(string)
+		fields, isRecord := value["fields"].([]interface{})
+		if isRecord {
+			for _, field := range fields {
+				fieldDefinition, _ := field.(map[string]interface{})
+				fieldName, _ := fieldDefinition["name"].(string)
+				if isBigNum(fieldDefinition["type"]) {
+					bigNumFields[getBigNumFieldKey(recordName, fieldName)] = struct{}{}
+				}
+			}
+		}
+
+		for _, item := range value {
+			collectBigNumFields(item, bigNumFields)
+		}
+	}
+}
+
+func isBigNum(fieldType interface{}) bool {
+	switch value := fieldType.(type) {
+	case []interface{}:
+		for _, branch := range value {
+			if isBigNum(branch) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		if value["type"] == "bytes" && value["logicalType"] == logicalTypeBigNum {
+			return true
+		}
+
+		return isBigNum(value["items"]) || isBigNum(value["type"])
+	}
+
+	return false
+}
+
+func getBigNumFieldKey(recordName string, fieldName string) string {
+	return fmt.Sprintf("%s.%s", recordName, fieldName)
+}
@@ -0,0 +1,13 @@
+package jsonConverter
+
+import "errors"
+
+var errNilAvroDecoder = errors.New("nil avro decoder provided")
+
+var errNilAddressConverter = errors.New("nil address converter provided")
+
+var errInvalidRecordSchema = errors.New("invalid record schema")
+
+var errMissingField = errors.New("record field not found")
+
+var errUnexpectedValue = errors.New("unexpected value for avro type")
@@ -0,0 +1,10 @@
+package jsonConverter
+
+// ErrNilAvroDecoder -
+var ErrNilAvroDecoder = errNilAvroDecoder
+
+// ErrNilAddressConverter -
+var ErrNilAddressConverter = errNilAddressConverter
+
+// ErrInvalidRecordSchema -
+var ErrInvalidRecordSchema = errInvalidRecordSchema
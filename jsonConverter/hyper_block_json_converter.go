@@ -0,0 +1,91 @@
+package jsonConverter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elodina/go-avro"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+)
+
+// ArgsHyperBlockJsonConverter holds all input dependencies required by hyper block json converter
+type ArgsHyperBlockJsonConverter struct {
+	SchemaDefinition string
+	AvroDecoder      AvroDecoder
+	AddressConverter AddressConverter
+}
+
+type hyperBlockJsonConverter struct {
+	schema           *avro.RecordSchema
+	bigNumFields     map[string]struct{}
+	avroDecoder      AvroDecoder
+	addressConverter AddressConverter
+}
+
+// NewHyperBlockJsonConverter creates a converter of avro encoded hyper blocks to json. Hyper blocks are decoded with
+// the provided avro decoder and written following the provided hyper block schema definition, so that all encodings
+// hold the same processed hyper block
+func NewHyperBlockJsonConverter(args ArgsHyperBlockJsonConverter) (*hyperBlockJsonConverter, error) {
+	if args.AvroDecoder == nil {
+		return nil, errNilAvroDecoder
+	}
+	if args.AddressConverter == nil {
+		return nil, errNilAddressConverter
+	}
+
+	parsedSchema, err := avro.ParseSchema(args.SchemaDefinition)
+	if err != nil {
+		return nil, err
+	}
+	recordSchema, isRecord := parsedSchema.(*avro.RecordSchema)
+	if !isRecord {
+		return nil, fmt.Errorf("%w: expected record, got %s", errInvalidRecordSchema, parsedSchema.GetName())
+	}
+
+	bigNumFields, err := getBigNumFields(args.SchemaDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hyperBlockJsonConverter{
+		schema:           recordSchema,
+		bigNumFields:     bigNumFields,
+		avroDecoder:      args.AvroDecoder,
+		addressConverter: args.AddressConverter,
+	}, nil
+}
+
+// ToAvroJson returns the avro json encoding of the provided avro encoded hyper block, as defined by the avro
+// specification: bytes and fixed values are written as strings holding one code point per byte, while non null union
+// values are wrapped in an object keyed by their type name
+func (hbjc *hyperBlockJsonConverter) ToAvroJson(encodedHyperBlock []byte) (json.RawMessage, error) {
+	return hbjc.convert(encodedHyperBlock, false)
+}
+
+// ToReadableJson returns a human readable json of the provided avro encoded hyper block: hashes and other bytes are
+// written as hex strings, big integers as decimal strings and addresses in bech32
+func (hbjc *hyperBlockJsonConverter) ToReadableJson(encodedHyperBlock []byte) (json.RawMessage, error) {
+	return hbjc.convert(encodedHyperBlock, true)
+}
+
+func (hbjc *hyperBlockJsonConverter) convert(encodedHyperBlock []byte, isReadable bool) (json.RawMessage, error) {
+	hyperBlock := schema.NewHyperBlock()
+	err := hbjc.avroDecoder.Decode(hyperBlock, encodedHyperBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &jsonWriter{
+		buffer:           &bytes.Buffer{},
+		isReadable:       isReadable,
+		bigNumFields:     hbjc.bigNumFields,
+		addressConverter: hbjc.addressConverter,
+	}
+	err = writer.writeRecord(hbjc.schema, hyperBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return writer.buffer.Bytes(), nil
+}
@@ -0,0 +1,237 @@
+package jsonConverter_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/elodina/go-avro"
+	"github.com/multiversx/mx-chain-covalent-go/jsonConverter"
+	"github.com/multiversx/mx-chain-covalent-go/process/factory"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	senderAddress   = "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+	receiverAddress = "erd1wh9c0sjr2xn8hzf02lwwcr4jk2s84tat9ud2kaq6zr7xzpvl9l5q8awmex"
+)
+
+func createMockArgsHyperBlockJsonConverter() jsonConverter.ArgsHyperBlockJsonConverter {
+	avroMarshaller, _ := utility.NewAvroMarshallerWithSchema(schema.HyperBlockSchemaDefinition)
+
+	return jsonConverter.ArgsHyperBlockJsonConverter{
+		SchemaDefinition: schema.HyperBlockSchemaDefinition,
+		AvroDecoder:      avroMarshaller,
+		AddressConverter: utility.NewBech32AddressConverter(),
+	}
+}
+
+func createEncodedHyperBlock(t *testing.T) (*schema.HyperBlock, []byte) {
+	tx := schema.NewTransaction()
+	tx.Hash = testscommon.GenerateRandomFixedBytes(32)
+	tx.Sender = utility.GetAddressOrMetachainAddr(senderAddress)
+	tx.Receiver = utility.GetAddressOrMetachainAddr(utility.MetachainShardName)
+	tx.Value = big.NewInt(123456789).Bytes()
+	tx.Data = []byte("claim")
+	tx.ESDTValues = [][]byte{big.NewInt(1000).Bytes(), big.NewInt(2000).Bytes()}
+	tx.Receivers = [][]byte{utility.GetAddressOrMetachainAddr(receiverAddress)}
+
+	accountBalanceUpdate := schema.NewAccountBalanceUpdate()
+	accountBalanceUpdate.Address = utility.GetAddressOrMetachainAddr(receiverAddress)
+	accountBalanceUpdate.Balance = big.NewInt(999).Bytes()
+
+	shardBlock := schema.NewShardBlocks()
+	shardBlock.Hash = testscommon.GenerateRandomFixedBytes(32)
+	shardBlock.StateChanges = []*schema.AccountBalanceUpdate{accountBalanceUpdate}
+
+	hyperBlock := schema.NewHyperBlock()
+	hyperBlock.Hash = testscommon.GenerateRandomFixedBytes(32)
+	hyperBlock.StateRootHash = testscommon.GenerateRandomFixedBytes(32)
+	hyperBlock.Nonce = 4
+	hyperBlock.AccumulatedFees = big.NewInt(5000).Bytes()
+	hyperBlock.ShardBlocks = []*schema.ShardBlocks{shardBlock}
+	hyperBlock.Transactions = []*schema.Transaction{tx}
+	hyperBlock.Status = "on-chain"
+
+	avroMarshaller, _ := utility.NewAvroMarshallerWithSchema(schema.HyperBlockSchemaDefinition)
+	encodedHyperBlock, err := avroMarshaller.Encode(hyperBlock)
+	require.Nil(t, err)
+
+	return hyperBlock, encodedHyperBlock
+}
+
+func TestNewHyperBlockJsonConverter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := jsonConverter.NewHyperBlockJsonConverter(createMockArgsHyperBlockJsonConverter())
+		require.Nil(t, err)
+		require.NotNil(t, converter)
+	})
+
+	t.Run("nil avro decoder, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockJsonConverter()
+		args.AvroDecoder = nil
+		converter, err := jsonConverter.NewHyperBlockJsonConverter(args)
+		require.Nil(t, converter)
+		require.Equal(t, jsonConverter.ErrNilAvroDecoder, err)
+	})
+
+	t.Run("nil address converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockJsonConverter()
+		args.AddressConverter = nil
+		converter, err := jsonConverter.NewHyperBlockJsonConverter(args)
+		require.Nil(t, converter)
+		require.Equal(t, jsonConverter.ErrNilAddressConverter, err)
+	})
+
+	t.Run("invalid schema definition, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockJsonConverter()
+		args.SchemaDefinition = "invalid"
+		converter, err := jsonConverter.NewHyperBlockJsonConverter(args)
+		require.Nil(t, converter)
+		require.NotNil(t, err)
+
+		args.SchemaDefinition = `"string"`
+		converter, err = jsonConverter.NewHyperBlockJsonConverter(args)
+		require.Nil(t, converter)
+		require.True(t, errors.Is(err, jsonConverter.ErrInvalidRecordSchema))
+	})
+}
+
+func TestHyperBlockJsonConverter_ToReadableJson(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should write hex hashes, decimal big integers and bech32 addresses", func(t *testing.T) {
+		t.Parallel()
+
+		converter, _ := jsonConverter.NewHyperBlockJsonConverter(createMockArgsHyperBlockJsonConverter())
+		hyperBlock, encodedHyperBlock := createEncodedHyperBlock(t)
+
+		readableJson, err := converter.ToReadableJson(encodedHyperBlock)
+		require.Nil(t, err)
+
+		var readableHyperBlock map[string]interface{}
+		err = json.Unmarshal(readableJson, &readableHyperBlock)
+		require.Nil(t, err)
+
+		require.Equal(t, hex.EncodeToString(hyperBlock.Hash), readableHyperBlock["Hash"])
+		require.Nil(t, readableHyperBlock["PrevBlockHash"])
+		require.Equal(t, hex.EncodeToString(hyperBlock.StateRootHash), readableHyperBlock["StateRootHash"])
+		require.Equal(t, float64(4), readableHyperBlock["Nonce"])
+		require.Equal(t, "5000", readableHyperBlock["AccumulatedFees"])
+		require.Equal(t, "0", readableHyperBlock["DeveloperFees"])
+		require.Nil(t, readableHyperBlock["EpochStartInfo"])
+		require.Equal(t, "on-chain", readableHyperBlock["Status"])
+
+		stateChange := readableHyperBlock["ShardBlocks"].([]interface{})[0].(map[string]interface{})["StateChanges"].([]interface{})[0].(map[string]interface{})
+		require.Equal(t, receiverAddress, stateChange["Address"])
+		require.Equal(t, "999", stateChange["Balance"])
+
+		tx := readableHyperBlock["Transactions"].([]interface{})[0].(map[string]interface{})
+		require.Equal(t, hex.EncodeToString(hyperBlock.Transactions[0].Hash), tx["Hash"])
+		require.Equal(t, senderAddress, tx["Sender"])
+		require.Equal(t, utility.MetachainShardName, tx["Receiver"])
+		require.Equal(t, "123456789", tx["Value"])
+		require.Equal(t, hex.EncodeToString([]byte("claim")), tx["Data"])
+		require.Equal(t, []interface{}{"1000", "2000"}, tx["ESDTValues"])
+		require.Equal(t, []interface{}{receiverAddress}, tx["Receivers"])
+	})
+
+	t.Run("pub key address encoding, should write bech32 addresses", func(t *testing.T) {
+		t.Parallel()
+
+		addressConverter, err := factory.CreateAddressConverter(schema.AddressEncodingPubKey)
+		require.Nil(t, err)
+		avroMarshaller, _ := utility.NewAvroMarshallerWithSchema(schema.HyperBlockPubKeySchemaDefinition)
+		converter, _ := jsonConverter.NewHyperBlockJsonConverter(jsonConverter.ArgsHyperBlockJsonConverter{
+			SchemaDefinition: schema.HyperBlockPubKeySchemaDefinition,
+			AvroDecoder:      avroMarshaller,
+			AddressConverter: addressConverter,
+		})
+
+		tx := schema.NewTransaction()
+		tx.Sender, _ = addressConverter.ConvertAddress(senderAddress)
+		tx.Receiver, _ = addressConverter.ConvertAddress(utility.MetachainShardName)
+		hyperBlock := schema.NewHyperBlock()
+		hyperBlock.Transactions = []*schema.Transaction{tx}
+		encodedHyperBlock, err := avroMarshaller.Encode(hyperBlock)
+		require.Nil(t, err)
+
+		readableJson, err := converter.ToReadableJson(encodedHyperBlock)
+		require.Nil(t, err)
+
+		var readableHyperBlock map[string]interface{}
+		err = json.Unmarshal(readableJson, &readableHyperBlock)
+		require.Nil(t, err)
+
+		readableTx := readableHyperBlock["Transactions"].([]interface{})[0].(map[string]interface{})
+		require.Equal(t, senderAddress, readableTx["Sender"])
+		require.Equal(t, utility.MetachainShardName, readableTx["Receiver"])
+	})
+
+	t.Run("could not decode hyper block, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errDecode := errors.New("decode error")
+		args := createMockArgsHyperBlockJsonConverter()
+		args.AvroDecoder = &mock.AvroEncoderStub{
+			DecodeCalled: func(_ avro.AvroRecord, _ []byte) error {
+				return errDecode
+			},
+		}
+		converter, _ := jsonConverter.NewHyperBlockJsonConverter(args)
+
+		readableJson, err := converter.ToReadableJson([]byte("encoded"))
+		require.Nil(t, readableJson)
+		require.Equal(t, errDecode, err)
+	})
+}
+
+func TestHyperBlockJsonConverter_ToAvroJson(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := jsonConverter.NewHyperBlockJsonConverter(createMockArgsHyperBlockJsonConverter())
+	hyperBlock, encodedHyperBlock := createEncodedHyperBlock(t)
+
+	avroJson, err := converter.ToAvroJson(encodedHyperBlock)
+	require.Nil(t, err)
+
+	var avroJsonHyperBlock map[string]interface{}
+	err = json.Unmarshal(avroJson, &avroJsonHyperBlock)
+	require.Nil(t, err)
+
+	require.Equal(t, codePoints(hyperBlock.Hash), avroJsonHyperBlock["Hash"])
+	require.Nil(t, avroJsonHyperBlock["PrevBlockHash"])
+	require.Equal(t, map[string]interface{}{
+		"com.covalenthq.block.schema.hash": codePoints(hyperBlock.StateRootHash),
+	}, avroJsonHyperBlock["StateRootHash"])
+	require.Equal(t, codePoints(hyperBlock.AccumulatedFees), avroJsonHyperBlock["AccumulatedFees"])
+
+	tx := avroJsonHyperBlock["Transactions"].(map[string]interface{})["array"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, senderAddress, tx["Sender"])
+	require.Equal(t, codePoints(hyperBlock.Transactions[0].Value), tx["Value"])
+}
+
+func codePoints(buff []byte) string {
+	runes := make([]rune, 0, len(buff))
+	for _, b := range buff {
+		runes = append(runes, rune(b))
+	}
+
+	return string(runes)
+}
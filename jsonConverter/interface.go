@@ -0,0 +1,13 @@
+package jsonConverter
+
+import "github.com/elodina/go-avro"
+
+// AvroDecoder should decode avro encoded records
+type AvroDecoder interface {
+	Decode(record avro.AvroRecord, buffer []byte) error
+}
+
+// AddressConverter should provide the human readable form of addresses, as written in avro records
+type AddressConverter interface {
+	DecodeAddress(address []byte) string
+}
@@ -0,0 +1,281 @@
+package jsonConverter
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/elodina/go-avro"
+)
+
+// jsonWriter writes avro records, generated from the avro schema, as json objects having their fields in the schema
+// order
+type jsonWriter struct {
+	buffer           *bytes.Buffer
+	isReadable       bool
+	namespace        string
+	bigNumFields     map[string]struct{}
+	addressConverter AddressConverter
+}
+
+func (jw *jsonWriter) writeRecord(recordSchema *avro.RecordSchema, record interface{}) error {
+	return jw.writeRecordValue(recordSchema, reflect.ValueOf(record))
+}
+
+func (jw *jsonWriter) writeValue(valueSchema avro.Schema, value reflect.Value, isBigNum bool) error {
+	switch typedSchema := valueSchema.(type) {
+	case *avro.RecordSchema:
+		return jw.writeRecordValue(typedSchema, value)
+	case *avro.RecursiveSchema:
+		return jw.writeRecordValue(typedSchema.Actual, value)
+	case *avro.UnionSchema:
+		return jw.writeUnion(typedSchema, value, isBigNum)
+	case *avro.ArraySchema:
+		return jw.writeArray(typedSchema, value, isBigNum)
+	case *avro.MapSchema:
+		return jw.writeMap(typedSchema, value, isBigNum)
+	case *avro.FixedSchema:
+		return jw.writeBytes(value, typedSchema.Name == addressTypeName, isBigNum)
+	case *avro.BytesSchema:
+		return jw.writeBytes(value, false, isBigNum)
+	case *avro.EnumSchema:
+		return jw.writeEnum(value)
+	case *avro.NullSchema:
+		jw.buffer.WriteString("null")
+		return nil
+	default:
+		return jw.writeJson(dereference(value).Interface())
+	}
+}
+
+func (jw *jsonWriter) writeRecordValue(recordSchema *avro.RecordSchema, value reflect.Value) error {
+	value = dereference(value)
+	if value.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: %s expected for record %s", errUnexpectedValue, value.Kind(), recordSchema.Name)
+	}
+
+	// named types inherit the namespace of the enclosing record, unless they define their own
+	enclosingNamespace := jw.namespace
+	if len(recordSchema.Namespace) > 0 {
+		jw.namespace = recordSchema.Namespace
+	}
+	defer func() {
+		jw.namespace = enclosingNamespace
+	}()
+
+	jw.buffer.WriteByte('{')
+	for idx, field := range recordSchema.Fields {
+		if idx > 0 {
+			jw.buffer.WriteByte(',')
+		}
+
+		fieldValue := value.FieldByName(field.Name)
+		if !fieldValue.IsValid() {
+			return fmt.Errorf("%w: %s.%s", errMissingField, recordSchema.Name, field.Name)
+		}
+
+		err := jw.writeJson(field.Name)
+		if err != nil {
+			return err
+		}
+		jw.buffer.WriteByte(':')
+
+		_, isBigNum := jw.bigNumFields[getBigNumFieldKey(recordSchema.Name, field.Name)]
+		err = jw.writeValue(field.Type, fieldValue, isBigNum)
+		if err != nil {
+			return err
+		}
+	}
+	jw.buffer.WriteByte('}')
+
+	return nil
+}
+
+// writeUnion writes null values as null, if the union allows it, and other values as their first non null union type.
+// In avro json encoding, non null values are wrapped in an object keyed by the full name of their type
+func (jw *jsonWriter) writeUnion(unionSchema *avro.UnionSchema, value reflect.Value, isBigNum bool) error {
+	var valueSchema avro.Schema
+	for _, branchSchema := range unionSchema.Types {
+		if branchSchema.Type() == avro.Null {
+			if isNil(value) {
+				jw.buffer.WriteString("null")
+				return nil
+			}
+
+			continue
+		}
+		if valueSchema == nil {
+			valueSchema = branchSchema
+		}
+	}
+	if valueSchema == nil {
+		return fmt.Errorf("%w: non null value for null union", errUnexpectedValue)
+	}
+	if jw.isReadable {
+		return jw.writeValue(valueSchema, value, isBigNum)
+	}
+
+	jw.buffer.WriteByte('{')
+	err := jw.writeJson(jw.getTypeName(valueSchema))
+	if err != nil {
+		return err
+	}
+	jw.buffer.WriteByte(':')
+	err = jw.writeValue(valueSchema, value, isBigNum)
+	if err != nil {
+		return err
+	}
+	jw.buffer.WriteByte('}')
+
+	return nil
+}
+
+// getTypeName returns the full name of named types, or the type name otherwise
+func (jw *jsonWriter) getTypeName(valueSchema avro.Schema) string {
+	if recursiveSchema, isRecursive := valueSchema.(*avro.RecursiveSchema); isRecursive {
+		valueSchema = recursiveSchema.Actual
+	}
+
+	typeName := avro.GetFullName(valueSchema)
+	switch valueSchema.Type() {
+	case avro.Record, avro.Enum, avro.Fixed:
+		if !strings.Contains(typeName, ".") && len(jw.namespace) > 0 {
+			return jw.namespace + "." + typeName
+		}
+	}
+
+	return typeName
+}
+
+func (jw *jsonWriter) writeArray(arraySchema *avro.ArraySchema, value reflect.Value, isBigNum bool) error {
+	value = dereference(value)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return fmt.Errorf("%w: %s expected for array", errUnexpectedValue, value.Kind())
+	}
+
+	jw.buffer.WriteByte('[')
+	for idx := 0; idx < value.Len(); idx++ {
+		if idx > 0 {
+			jw.buffer.WriteByte(',')
+		}
+
+		err := jw.writeValue(arraySchema.Items, value.Index(idx), isBigNum)
+		if err != nil {
+			return err
+		}
+	}
+	jw.buffer.WriteByte(']')
+
+	return nil
+}
+
+func (jw *jsonWriter) writeMap(mapSchema *avro.MapSchema, value reflect.Value, isBigNum bool) error {
+	value = dereference(value)
+	if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("%w: %s expected for map", errUnexpectedValue, value.Kind())
+	}
+
+	keys := make([]string, 0, value.Len())
+	for _, key := range value.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+
+	jw.buffer.WriteByte('{')
+	for idx, key := range keys {
+		if idx > 0 {
+			jw.buffer.WriteByte(',')
+		}
+
+		err := jw.writeJson(key)
+		if err != nil {
+			return err
+		}
+		jw.buffer.WriteByte(':')
+
+		err = jw.writeValue(mapSchema.Values, value.MapIndex(reflect.ValueOf(key).Convert(value.Type().Key())), isBigNum)
+		if err != nil {
+			return err
+		}
+	}
+	jw.buffer.WriteByte('}')
+
+	return nil
+}
+
+// writeBytes writes bytes and fixed values. In avro json encoding, they are written as strings holding one code point
+// per byte, while in readable json, addresses are written in bech32, big integers as decimal strings and all other
+// bytes as hex strings
+func (jw *jsonWriter) writeBytes(value reflect.Value, isAddress bool, isBigNum bool) error {
+	value = dereference(value)
+	if value.Kind() != reflect.Slice || value.Type().Elem().Kind() != reflect.Uint8 {
+		return fmt.Errorf("%w: %s expected for bytes", errUnexpectedValue, value.Kind())
+	}
+
+	buff := value.Bytes()
+	switch {
+	case !jw.isReadable:
+		codePoints := make([]rune, 0, len(buff))
+		for _, b := range buff {
+			codePoints = append(codePoints, rune(b))
+		}
+
+		return jw.writeJson(string(codePoints))
+	case isAddress:
+		return jw.writeJson(jw.addressConverter.DecodeAddress(buff))
+	case isBigNum:
+		return jw.writeJson(big.NewInt(0).SetBytes(buff).String())
+	default:
+		return jw.writeJson(hex.EncodeToString(buff))
+	}
+}
+
+func (jw *jsonWriter) writeEnum(value reflect.Value) error {
+	enum, isEnum := value.Interface().(*avro.GenericEnum)
+	if isEnum {
+		return jw.writeJson(enum.Get())
+	}
+
+	value = dereference(value)
+	if value.Kind() != reflect.String {
+		return fmt.Errorf("%w: %s expected for enum", errUnexpectedValue, value.Kind())
+	}
+
+	return jw.writeJson(value.String())
+}
+
+func (jw *jsonWriter) writeJson(value interface{}) error {
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	jw.buffer.Write(encodedValue)
+	return nil
+}
+
+func dereference(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return value
+		}
+
+		value = value.Elem()
+	}
+
+	return value
+}
+
+func isNil(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		return value.IsNil()
+	default:
+		return !value.IsValid()
+	}
+}
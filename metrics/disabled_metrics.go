@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+)
+
+type disabledMetrics struct {
+}
+
+// NewDisabledMetrics creates a metrics handler which does not record anything
+func NewDisabledMetrics() *disabledMetrics {
+	return &disabledMetrics{}
+}
+
+// ObserveRequest does nothing
+func (dm *disabledMetrics) ObserveRequest(_ string, _ int, _ time.Duration, _ int) {
+}
+
+// ObserveStreamConnection does nothing
+func (dm *disabledMetrics) ObserveStreamConnection(_ string, _ time.Duration) {
+}
+
+// ObserveUpstreamRequest does nothing
+func (dm *disabledMetrics) ObserveUpstreamRequest(_ string, _ time.Duration, _ error) {
+}
+
+// ObserveEncodedHyperBlockSize does nothing
+func (dm *disabledMetrics) ObserveEncodedHyperBlockSize(_ int) {
+}
+
+// IncRetries does nothing
+func (dm *disabledMetrics) IncRetries(_ string) {
+}
+
+// IncFailures does nothing
+func (dm *disabledMetrics) IncFailures(_ string) {
+}
+
+// IncTransactionRetries does nothing
+func (dm *disabledMetrics) IncTransactionRetries(_ string) {
+}
+
+// IncTransactionFailures does nothing
+func (dm *disabledMetrics) IncTransactionFailures(_ string) {
+}
+
+// IncInFlightBatchRequests does nothing
+func (dm *disabledMetrics) IncInFlightBatchRequests() {
+}
+
+// DecInFlightBatchRequests does nothing
+func (dm *disabledMetrics) DecInFlightBatchRequests() {
+}
+
+// ObserveUpstreamResponse does nothing
+func (dm *disabledMetrics) ObserveUpstreamResponse(_ string, _ error) {
+}
+
+// IncFallbackResponses does nothing
+func (dm *disabledMetrics) IncFallbackResponses(_ string) {
+}
+
+// Handler returns a handler which responds with not found
+func (dm *disabledMetrics) Handler() http.Handler {
+	return http.NotFoundHandler()
+}
@@ -0,0 +1,5 @@
+package metrics
+
+import "errors"
+
+var errNilRequestsMetricsHandler = errors.New("nil requests metrics handler provided")
@@ -0,0 +1,216 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "covalent_proxy"
+
+const (
+	labelRoute    = "route"
+	labelCode     = "code"
+	labelEndpoint = "endpoint"
+	labelOutcome  = "outcome"
+	labelReason   = "reason"
+	labelUpstream = "upstream"
+)
+
+const (
+	outcomeSuccess = "success"
+	outcomeError   = "error"
+)
+
+type prometheusMetrics struct {
+	registry                *prometheus.Registry
+	requestDuration         *prometheus.HistogramVec
+	responseSize            *prometheus.HistogramVec
+	streamDuration          *prometheus.HistogramVec
+	upstreamRequestDuration *prometheus.HistogramVec
+	encodedHyperBlockSize   prometheus.Histogram
+	retries                 *prometheus.CounterVec
+	failures                *prometheus.CounterVec
+	transactionRetries      *prometheus.CounterVec
+	transactionFailures     *prometheus.CounterVec
+	inFlightBatchRequests   prometheus.Gauge
+	upstreamResponses       *prometheus.CounterVec
+	fallbackResponses       *prometheus.CounterVec
+}
+
+// NewPrometheusMetrics creates a metrics handler which records all metrics in a dedicated prometheus registry,
+// exposed by Handler
+func NewPrometheusMetrics() (*prometheusMetrics, error) {
+	pm := &prometheusMetrics{
+		registry: prometheus.NewRegistry(),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Duration of the requests served by the proxy, per route and response code",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{labelRoute, labelCode}),
+		responseSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_response_size_bytes",
+			Help:      "Size of the responses sent by the proxy, per route",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 10),
+		}, []string{labelRoute}),
+		streamDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "stream_connection_duration_seconds",
+			Help:      "Duration of the websocket stream connections served by the proxy, per route",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+		}, []string{labelRoute}),
+		upstreamRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "upstream_request_duration_seconds",
+			Help:      "Duration of the requests sent to the Multiversx proxy, per endpoint and outcome",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{labelEndpoint, labelOutcome}),
+		encodedHyperBlockSize: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "encoded_hyper_block_size_bytes",
+			Help:      "Size of the avro encoded hyper blocks",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 10),
+		}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "hyper_block_retries_total",
+			Help:      "Number of retried hyper block requests, per reason of the failed attempt",
+		}, []string{labelReason}),
+		failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "hyper_block_failures_total",
+			Help:      "Number of failed hyper block requests, per reason",
+		}, []string{labelReason}),
+		transactionRetries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "transaction_retries_total",
+			Help:      "Number of retried transaction requests, per reason of the failed attempt",
+		}, []string{labelReason}),
+		transactionFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "transaction_failures_total",
+			Help:      "Number of failed transaction requests, per reason",
+		}, []string{labelReason}),
+		inFlightBatchRequests: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "in_flight_batch_requests",
+			Help:      "Number of in-flight hyper block requests of hyper blocks batches",
+		}),
+		upstreamResponses: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_responses_total",
+			Help:      "Number of responses of each upstream Multiversx proxy, per outcome",
+		}, []string{labelUpstream, labelOutcome}),
+		fallbackResponses: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_fallback_responses_total",
+			Help:      "Number of requests served by a fallback upstream Multiversx proxy, after the preferred ones failed",
+		}, []string{labelUpstream}),
+	}
+
+	collectors := []prometheus.Collector{
+		pm.requestDuration,
+		pm.responseSize,
+		pm.streamDuration,
+		pm.upstreamRequestDuration,
+		pm.encodedHyperBlockSize,
+		pm.retries,
+		pm.failures,
+		pm.transactionRetries,
+		pm.transactionFailures,
+		pm.inFlightBatchRequests,
+		pm.upstreamResponses,
+		pm.fallbackResponses,
+	}
+	for _, collector := range collectors {
+		err := pm.registry.Register(collector)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pm, nil
+}
+
+// ObserveRequest records the duration and the response size of a request served by the proxy
+func (pm *prometheusMetrics) ObserveRequest(route string, code int, duration time.Duration, responseSize int) {
+	pm.requestDuration.WithLabelValues(route, strconv.Itoa(code)).Observe(duration.Seconds())
+	if responseSize >= 0 {
+		pm.responseSize.WithLabelValues(route).Observe(float64(responseSize))
+	}
+}
+
+// ObserveStreamConnection records the duration of a websocket stream connection served by the proxy
+func (pm *prometheusMetrics) ObserveStreamConnection(route string, duration time.Duration) {
+	pm.streamDuration.WithLabelValues(route).Observe(duration.Seconds())
+}
+
+// ObserveUpstreamRequest records the duration of a request sent to the Multiversx proxy
+func (pm *prometheusMetrics) ObserveUpstreamRequest(endpoint string, duration time.Duration, err error) {
+	outcome := outcomeSuccess
+	if err != nil {
+		outcome = outcomeError
+	}
+
+	pm.upstreamRequestDuration.WithLabelValues(endpoint, outcome).Observe(duration.Seconds())
+}
+
+// ObserveEncodedHyperBlockSize records the size of an avro encoded hyper block
+func (pm *prometheusMetrics) ObserveEncodedHyperBlockSize(size int) {
+	pm.encodedHyperBlockSize.Observe(float64(size))
+}
+
+// IncRetries increments the number of retried hyper block requests for the provided reason
+func (pm *prometheusMetrics) IncRetries(reason string) {
+	pm.retries.WithLabelValues(reason).Inc()
+}
+
+// IncFailures increments the number of failed hyper block requests for the provided reason
+func (pm *prometheusMetrics) IncFailures(reason string) {
+	pm.failures.WithLabelValues(reason).Inc()
+}
+
+// IncTransactionRetries increments the number of retried transaction requests for the provided reason
+func (pm *prometheusMetrics) IncTransactionRetries(reason string) {
+	pm.transactionRetries.WithLabelValues(reason).Inc()
+}
+
+// IncTransactionFailures increments the number of failed transaction requests for the provided reason
+func (pm *prometheusMetrics) IncTransactionFailures(reason string) {
+	pm.transactionFailures.WithLabelValues(reason).Inc()
+}
+
+// IncInFlightBatchRequests increments the number of in-flight hyper block requests of hyper blocks batches
+func (pm *prometheusMetrics) IncInFlightBatchRequests() {
+	pm.inFlightBatchRequests.Inc()
+}
+
+// DecInFlightBatchRequests decrements the number of in-flight hyper block requests of hyper blocks batches
+func (pm *prometheusMetrics) DecInFlightBatchRequests() {
+	pm.inFlightBatchRequests.Dec()
+}
+
+// ObserveUpstreamResponse records the outcome of a request sent to an upstream Multiversx proxy
+func (pm *prometheusMetrics) ObserveUpstreamResponse(upstream string, err error) {
+	outcome := outcomeSuccess
+	if err != nil {
+		outcome = outcomeError
+	}
+
+	pm.upstreamResponses.WithLabelValues(upstream, outcome).Inc()
+}
+
+// IncFallbackResponses increments the number of requests served by the provided fallback upstream
+func (pm *prometheusMetrics) IncFallbackResponses(upstream string) {
+	pm.fallbackResponses.WithLabelValues(upstream).Inc()
+}
+
+// Handler returns the http handler exposing all recorded metrics in prometheus text format
+func (pm *prometheusMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(pm.registry, promhttp.HandlerOpts{})
+}
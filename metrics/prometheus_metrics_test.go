@@ -0,0 +1,140 @@
+package metrics
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPrometheusMetrics(t *testing.T) {
+	t.Parallel()
+
+	pm, err := NewPrometheusMetrics()
+	require.Nil(t, err)
+	require.NotNil(t, pm)
+}
+
+func TestPrometheusMetrics_Counters(t *testing.T) {
+	t.Parallel()
+
+	pm, _ := NewPrometheusMetrics()
+
+	pm.IncRetries("upstream")
+	pm.IncRetries("upstream")
+	pm.IncRetries("process")
+	pm.IncFailures("encode")
+
+	require.Equal(t, float64(2), testutil.ToFloat64(pm.retries.WithLabelValues("upstream")))
+	require.Equal(t, float64(1), testutil.ToFloat64(pm.retries.WithLabelValues("process")))
+	require.Equal(t, float64(1), testutil.ToFloat64(pm.failures.WithLabelValues("encode")))
+	require.Equal(t, float64(0), testutil.ToFloat64(pm.failures.WithLabelValues("upstream")))
+}
+
+func TestPrometheusMetrics_TransactionCounters(t *testing.T) {
+	t.Parallel()
+
+	pm, _ := NewPrometheusMetrics()
+
+	pm.IncTransactionRetries("upstream")
+	pm.IncTransactionFailures("upstream")
+	pm.IncTransactionFailures("upstream")
+
+	require.Equal(t, float64(1), testutil.ToFloat64(pm.transactionRetries.WithLabelValues("upstream")))
+	require.Equal(t, float64(2), testutil.ToFloat64(pm.transactionFailures.WithLabelValues("upstream")))
+	require.Equal(t, float64(0), testutil.ToFloat64(pm.retries.WithLabelValues("upstream")))
+	require.Equal(t, float64(0), testutil.ToFloat64(pm.failures.WithLabelValues("upstream")))
+}
+
+func TestPrometheusMetrics_UpstreamResponses(t *testing.T) {
+	t.Parallel()
+
+	pm, _ := NewPrometheusMetrics()
+
+	pm.ObserveUpstreamResponse("url1", errors.New("error"))
+	pm.ObserveUpstreamResponse("url2", nil)
+	pm.ObserveUpstreamResponse("url2", nil)
+	pm.IncFallbackResponses("url2")
+
+	require.Equal(t, float64(1), testutil.ToFloat64(pm.upstreamResponses.WithLabelValues("url1", "error")))
+	require.Equal(t, float64(0), testutil.ToFloat64(pm.upstreamResponses.WithLabelValues("url1", "success")))
+	require.Equal(t, float64(2), testutil.ToFloat64(pm.upstreamResponses.WithLabelValues("url2", "success")))
+	require.Equal(t, float64(1), testutil.ToFloat64(pm.fallbackResponses.WithLabelValues("url2")))
+}
+
+func TestPrometheusMetrics_InFlightBatchRequests(t *testing.T) {
+	t.Parallel()
+
+	pm, _ := NewPrometheusMetrics()
+
+	pm.IncInFlightBatchRequests()
+	pm.IncInFlightBatchRequests()
+	pm.IncInFlightBatchRequests()
+	pm.DecInFlightBatchRequests()
+
+	require.Equal(t, float64(2), testutil.ToFloat64(pm.inFlightBatchRequests))
+}
+
+func TestPrometheusMetrics_Histograms(t *testing.T) {
+	t.Parallel()
+
+	pm, _ := NewPrometheusMetrics()
+
+	pm.ObserveRequest("/hyperblock/by-nonce/:nonce", http.StatusOK, time.Millisecond, 100)
+	pm.ObserveRequest("/hyperblock/by-nonce/:nonce", http.StatusInternalServerError, time.Second, 10)
+	pm.ObserveStreamConnection("/hyperblocks/stream", time.Minute)
+	pm.ObserveUpstreamRequest("hyperblock", time.Millisecond, nil)
+	pm.ObserveUpstreamRequest("hyperblock", time.Millisecond, errors.New("error"))
+	pm.ObserveEncodedHyperBlockSize(2048)
+
+	require.Equal(t, 2, testutil.CollectAndCount(pm.requestDuration))
+	require.Equal(t, 1, testutil.CollectAndCount(pm.responseSize))
+	require.Equal(t, 1, testutil.CollectAndCount(pm.streamDuration))
+	require.Equal(t, 2, testutil.CollectAndCount(pm.upstreamRequestDuration))
+	require.Equal(t, 1, testutil.CollectAndCount(pm.encodedHyperBlockSize))
+}
+
+func TestPrometheusMetrics_Handler(t *testing.T) {
+	t.Parallel()
+
+	pm, _ := NewPrometheusMetrics()
+	pm.IncFailures("upstream")
+	pm.ObserveUpstreamRequest("network_status", time.Millisecond, nil)
+
+	rw := httptest.NewRecorder()
+	pm.Handler().ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rw.Code)
+
+	body, err := ioutil.ReadAll(rw.Body)
+	require.Nil(t, err)
+	require.True(t, strings.Contains(string(body), `covalent_proxy_hyper_block_failures_total{reason="upstream"} 1`))
+	require.True(t, strings.Contains(string(body), `covalent_proxy_upstream_request_duration_seconds_count{endpoint="network_status",outcome="success"} 1`))
+}
+
+func TestDisabledMetrics(t *testing.T) {
+	t.Parallel()
+
+	dm := NewDisabledMetrics()
+	dm.ObserveRequest("/route", http.StatusOK, time.Second, 1)
+	dm.ObserveStreamConnection("/route", time.Second)
+	dm.ObserveUpstreamRequest("hyperblock", time.Second, nil)
+	dm.ObserveEncodedHyperBlockSize(1)
+	dm.IncRetries("upstream")
+	dm.IncFailures("upstream")
+	dm.IncTransactionRetries("upstream")
+	dm.IncTransactionFailures("upstream")
+	dm.IncInFlightBatchRequests()
+	dm.DecInFlightBatchRequests()
+	dm.ObserveUpstreamResponse("url", nil)
+	dm.IncFallbackResponses("url")
+
+	rw := httptest.NewRecorder()
+	dm.Handler().ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusNotFound, rw.Code)
+}
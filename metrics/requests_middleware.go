@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const unknownRoute = "unknown"
+
+// RequestsMetricsHandler should record metrics about the requests served by the proxy
+type RequestsMetricsHandler interface {
+	ObserveRequest(route string, code int, duration time.Duration, responseSize int)
+	ObserveStreamConnection(route string, duration time.Duration)
+}
+
+// NewRequestsMiddleware creates a gin middleware which records the duration, the response code and the response size
+// of each served request, labeled by its route template(e.g. /hyperblock/by-nonce/:nonce). Websocket connections,
+// which last as long as the stream, are only recorded as stream connections, so that they do not skew the request
+// durations
+func NewRequestsMiddleware(handler RequestsMetricsHandler) (gin.HandlerFunc, error) {
+	if handler == nil {
+		return nil, errNilRequestsMetricsHandler
+	}
+
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if len(route) == 0 {
+			route = unknownRoute
+		}
+
+		if isStreamConnection(c) {
+			handler.ObserveStreamConnection(route, time.Since(start))
+			return
+		}
+
+		handler.ObserveRequest(route, c.Writer.Status(), time.Since(start), c.Writer.Size())
+	}, nil
+}
+
+// isStreamConnection returns true for upgraded websocket requests. Websocket requests refused before the upgrade are
+// answered with an error status code, as plain requests
+func isStreamConnection(c *gin.Context) bool {
+	return c.IsWebsocket() && c.Writer.Status() < http.StatusBadRequest
+}
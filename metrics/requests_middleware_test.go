@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type requestsMetricsHandlerStub struct {
+	mutex           sync.Mutex
+	observed        map[string]int
+	observedStreams []string
+}
+
+func (rmh *requestsMetricsHandlerStub) ObserveRequest(route string, code int, _ time.Duration, _ int) {
+	rmh.mutex.Lock()
+	defer rmh.mutex.Unlock()
+
+	rmh.observed[route] = code
+}
+
+func (rmh *requestsMetricsHandlerStub) ObserveStreamConnection(route string, _ time.Duration) {
+	rmh.mutex.Lock()
+	defer rmh.mutex.Unlock()
+
+	rmh.observedStreams = append(rmh.observedStreams, route)
+}
+
+func TestNewRequestsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil handler, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewRequestsMiddleware(nil)
+		require.Nil(t, middleware)
+		require.Equal(t, errNilRequestsMetricsHandler, err)
+	})
+
+	t.Run("should observe requests by route template", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &requestsMetricsHandlerStub{observed: make(map[string]int)}
+		middleware, err := NewRequestsMiddleware(handler)
+		require.Nil(t, err)
+
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.Use(middleware)
+		router.GET("/hyperblock/by-nonce/:nonce", func(c *gin.Context) {
+			c.String(http.StatusBadRequest, "bad request")
+		})
+
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/hyperblock/by-nonce/4", nil))
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/missing", nil))
+
+		require.Equal(t, map[string]int{
+			"/hyperblock/by-nonce/:nonce": http.StatusBadRequest,
+			unknownRoute:                  http.StatusNotFound,
+		}, handler.observed)
+	})
+
+	t.Run("should observe upgraded websocket requests as stream connections", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &requestsMetricsHandlerStub{observed: make(map[string]int)}
+		middleware, err := NewRequestsMiddleware(handler)
+		require.Nil(t, err)
+
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.Use(middleware)
+		router.GET("/hyperblocks/stream", func(c *gin.Context) {
+			if c.Query("fromNonce") == "" {
+				c.String(http.StatusBadRequest, "bad request")
+			}
+		})
+
+		router.ServeHTTP(httptest.NewRecorder(), createWebsocketRequest("/hyperblocks/stream?fromNonce=4"))
+		router.ServeHTTP(httptest.NewRecorder(), createWebsocketRequest("/hyperblocks/stream"))
+
+		require.Equal(t, []string{"/hyperblocks/stream"}, handler.observedStreams)
+		require.Equal(t, map[string]int{"/hyperblocks/stream": http.StatusBadRequest}, handler.observed)
+	})
+}
+
+func createWebsocketRequest(target string) *http.Request {
+	request := httptest.NewRequest(http.MethodGet, target, nil)
+	request.Header.Set("Connection", "Upgrade")
+	request.Header.Set("Upgrade", "websocket")
+
+	return request
+}
@@ -0,0 +1,79 @@
+package polling
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+)
+
+const tmpFileSuffix = ".tmp"
+
+// Checkpoint holds the next nonce to be processed by a long running hyper blocks consumer, such that it can be
+// resumed after a restart. LastFile is only set by consumers writing hyper blocks in files
+type Checkpoint struct {
+	NextNonce uint64 `json:"nextNonce"`
+	LastFile  string `json:"lastFile,omitempty"`
+}
+
+// LoadCheckpoint reads the checkpoint from the provided path. If there is no checkpoint yet, it returns false
+func LoadCheckpoint(path string) (*Checkpoint, bool, error) {
+	checkpointBytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	cp := &Checkpoint{}
+	err = json.Unmarshal(checkpointBytes, cp)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return cp, true, nil
+}
+
+// SaveCheckpoint writes the checkpoint in a temporary file, synced to disk, and renames it afterwards, such that
+// neither a crash nor a power loss can leave a partially written checkpoint behind
+func SaveCheckpoint(path string, cp *Checkpoint) error {
+	checkpointBytes, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := path + tmpFileSuffix
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(checkpointBytes)
+	if err == nil {
+		err = file.Sync()
+	}
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// GetResumeNonce returns the nonce to start from: the provided start nonce, or the next nonce of the checkpoint at
+// the provided path, if it is higher
+func GetResumeNonce(path string, startNonce uint64) (uint64, error) {
+	cp, found, err := LoadCheckpoint(path)
+	if err != nil {
+		return 0, err
+	}
+	if !found || cp.NextNonce <= startNonce {
+		return startNonce, nil
+	}
+
+	log.Info("resuming from checkpoint", "path", path, "next nonce", cp.NextNonce, "last file", cp.LastFile)
+	return cp.NextNonce, nil
+}
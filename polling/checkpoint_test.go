@@ -0,0 +1,66 @@
+package polling
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpoint_SaveLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no checkpoint, should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		cp, found, err := LoadCheckpoint(filepath.Join(t.TempDir(), "checkpoint.json"))
+		require.Nil(t, err)
+		require.False(t, found)
+		require.Nil(t, cp)
+	})
+
+	t.Run("should load saved checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "checkpoint.json")
+		require.Nil(t, SaveCheckpoint(path, &Checkpoint{NextNonce: 4, LastFile: "hyperblocks.avro"}))
+		require.Nil(t, SaveCheckpoint(path, &Checkpoint{NextNonce: 7}))
+
+		cp, found, err := LoadCheckpoint(path)
+		require.Nil(t, err)
+		require.True(t, found)
+		require.Equal(t, &Checkpoint{NextNonce: 7}, cp)
+		require.NoFileExists(t, path+tmpFileSuffix)
+	})
+
+	t.Run("corrupted checkpoint, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "checkpoint.json")
+		require.Nil(t, ioutil.WriteFile(path, []byte("{"), 0644))
+
+		cp, found, err := LoadCheckpoint(path)
+		require.NotNil(t, err)
+		require.False(t, found)
+		require.Nil(t, cp)
+	})
+}
+
+func TestGetResumeNonce(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	nonce, err := GetResumeNonce(path, 3)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), nonce)
+
+	require.Nil(t, SaveCheckpoint(path, &Checkpoint{NextNonce: 6}))
+	nonce, err = GetResumeNonce(path, 3)
+	require.Nil(t, err)
+	require.Equal(t, uint64(6), nonce)
+
+	nonce, err = GetResumeNonce(path, 8)
+	require.Nil(t, err)
+	require.Equal(t, uint64(8), nonce)
+}
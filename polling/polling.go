@@ -0,0 +1,31 @@
+package polling
+
+import (
+	"context"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("polling")
+
+// Wait blocks until the polling interval elapses or the context is done, whichever comes first
+func Wait(ctx context.Context, pollingInterval time.Duration) {
+	timer := time.NewTimer(pollingInterval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// IsContextDone returns true if the context is done, without blocking
+func IsContextDone(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
@@ -1,17 +1,25 @@
 package accounts
 
 import (
+	"github.com/multiversx/mx-chain-covalent-go/process"
 	"github.com/multiversx/mx-chain-covalent-go/process/utility"
 	"github.com/multiversx/mx-chain-covalent-go/schema"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 )
 
 type alteredAccountsProcessor struct {
+	addressConverter process.AddressConverter
 }
 
 // NewAlteredAccountsProcessor creates a new instance of altered accounts processor
-func NewAlteredAccountsProcessor() *alteredAccountsProcessor {
-	return &alteredAccountsProcessor{}
+func NewAlteredAccountsProcessor(addressConverter process.AddressConverter) (*alteredAccountsProcessor, error) {
+	if addressConverter == nil {
+		return nil, errNilAddressConverter
+	}
+
+	return &alteredAccountsProcessor{
+		addressConverter: addressConverter,
+	}, nil
 }
 
 // ProcessAccounts converts accounts data to a specific structure defined by avro schema
@@ -23,6 +31,10 @@ func (ap *alteredAccountsProcessor) ProcessAccounts(apiAlteredAccounts []*outpor
 			continue
 		}
 
+		address, err := ap.addressConverter.ConvertAddress(apiAlteredAccount.Address)
+		if err != nil {
+			return nil, err
+		}
 		balance, err := utility.GetBigIntBytesFromStr(apiAlteredAccount.Balance)
 		if err != nil {
 			return nil, err
@@ -33,7 +45,7 @@ func (ap *alteredAccountsProcessor) ProcessAccounts(apiAlteredAccounts []*outpor
 		}
 
 		account := &schema.AccountBalanceUpdate{
-			Address: []byte(apiAlteredAccount.Address),
+			Address: address,
 			Balance: balance,
 			Nonce:   int64(apiAlteredAccount.Nonce),
 			Tokens:  tokensOrNil(accountTokenData),
@@ -1,12 +1,15 @@
 package accounts_test
 
 import (
+	"errors"
 	"math/big"
 	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-covalent-go/process/accounts"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
 	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/stretchr/testify/require"
 )
@@ -54,10 +57,30 @@ func createAlteredAccounts() []*outport.AlteredAccount {
 	return []*outport.AlteredAccount{alteredAcc1, alteredAcc2, alteredAcc3}
 }
 
+func TestNewAlteredAccountsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ap, err := accounts.NewAlteredAccountsProcessor(utility.NewBech32AddressConverter())
+		require.Nil(t, err)
+		require.NotNil(t, ap)
+	})
+
+	t.Run("nil address converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, err := accounts.NewAlteredAccountsProcessor(nil)
+		require.Nil(t, ap)
+		require.Equal(t, accounts.ErrNilAddressConverter, err)
+	})
+}
+
 func TestAlteredAccountsProcessor_ProcessAccounts(t *testing.T) {
 	t.Parallel()
 
-	ap := accounts.NewAlteredAccountsProcessor()
+	ap, _ := accounts.NewAlteredAccountsProcessor(utility.NewBech32AddressConverter())
 
 	processedAcc1 := &schema.AccountBalanceUpdate{
 		Address: []byte("erd1a"),
@@ -148,6 +171,21 @@ func TestAlteredAccountsProcessor_ProcessAccounts(t *testing.T) {
 		require.True(t, strings.Contains(err.Error(), "invalidNativeBalance"))
 	})
 
+	t.Run("cannot convert address, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errConvertAddress := errors.New("error converting address")
+		apWithInvalidAddresses, _ := accounts.NewAlteredAccountsProcessor(&mock.AddressConverterStub{
+			ConvertAddressCalled: func(address string) ([]byte, error) {
+				return nil, errConvertAddress
+			},
+		})
+
+		res, err := apWithInvalidAddresses.ProcessAccounts(createAlteredAccounts())
+		require.Nil(t, res)
+		require.Equal(t, errConvertAddress, err)
+	})
+
 	t.Run("invalid token balance, should return error", func(t *testing.T) {
 		t.Parallel()
 
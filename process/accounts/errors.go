@@ -0,0 +1,5 @@
+package accounts
+
+import "errors"
+
+var errNilAddressConverter = errors.New("nil address converter provided")
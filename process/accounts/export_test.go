@@ -0,0 +1,4 @@
+package accounts
+
+// ErrNilAddressConverter -
+var ErrNilAddressConverter = errNilAddressConverter
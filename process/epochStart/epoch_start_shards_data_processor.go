@@ -0,0 +1,121 @@
+package epochStart
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+)
+
+type epochStartShardsDataProcessor struct {
+}
+
+// NewEpochStartShardsDataProcessor will create a new instance of epoch start shards data processor
+func NewEpochStartShardsDataProcessor() *epochStartShardsDataProcessor {
+	return &epochStartShardsDataProcessor{}
+}
+
+// ProcessEpochStartShardsData converts api epoch start shards data to a specific structure defined by avro schema
+func (esd *epochStartShardsDataProcessor) ProcessEpochStartShardsData(apiShardsData []*api.EpochStartShardData) ([]*schema.EpochStartShardData, error) {
+	shardsData := make([]*schema.EpochStartShardData, 0, len(apiShardsData))
+
+	for _, apiShardData := range apiShardsData {
+		if apiShardData == nil {
+			continue
+		}
+
+		shardData, err := processEpochStartShardData(apiShardData)
+		if err != nil {
+			return nil, fmt.Errorf("epochStartShardsDataProcessor.ProcessEpochStartShardsData: shard: %d, err: %w", apiShardData.ShardID, err)
+		}
+
+		shardsData = append(shardsData, shardData)
+	}
+
+	return shardsData, nil
+}
+
+func processEpochStartShardData(apiShardData *api.EpochStartShardData) (*schema.EpochStartShardData, error) {
+	headerHash, err := decodeHashOrNil(apiShardData.HeaderHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode header hash: %w", err)
+	}
+	rootHash, err := decodeHashOrNil(apiShardData.RootHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode root hash: %w", err)
+	}
+	scheduledRootHash, err := decodeHashOrNil(apiShardData.ScheduledRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode scheduled root hash: %w", err)
+	}
+	firstPendingMetaBlock, err := decodeHashOrNil(apiShardData.FirstPendingMetaBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode first pending meta block: %w", err)
+	}
+	lastFinishedMetaBlock, err := decodeHashOrNil(apiShardData.LastFinishedMetaBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode last finished meta block: %w", err)
+	}
+	pendingMiniBlockHeaders, err := processPendingMiniBlockHeaders(apiShardData.PendingMiniBlockHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.EpochStartShardData{
+		ShardID:                 int32(apiShardData.ShardID),
+		Epoch:                   int32(apiShardData.Epoch),
+		Round:                   int64(apiShardData.Round),
+		Nonce:                   int64(apiShardData.Nonce),
+		HeaderHash:              headerHash,
+		RootHash:                rootHash,
+		ScheduledRootHash:       scheduledRootHash,
+		FirstPendingMetaBlock:   firstPendingMetaBlock,
+		LastFinishedMetaBlock:   lastFinishedMetaBlock,
+		PendingMiniBlockHeaders: pendingMiniBlockHeadersOrNil(pendingMiniBlockHeaders),
+	}, nil
+}
+
+func processPendingMiniBlockHeaders(apiMiniBlocks []*api.MiniBlock) ([]*schema.PendingMiniBlockHeader, error) {
+	miniBlockHeaders := make([]*schema.PendingMiniBlockHeader, 0, len(apiMiniBlocks))
+
+	for _, apiMiniBlock := range apiMiniBlocks {
+		if apiMiniBlock == nil {
+			continue
+		}
+
+		hash, err := hex.DecodeString(apiMiniBlock.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode pending mini block hash: %w", err)
+		}
+
+		miniBlockHeaders = append(miniBlockHeaders, &schema.PendingMiniBlockHeader{
+			Hash:                    hash,
+			Type:                    apiMiniBlock.Type,
+			ProcessingType:          apiMiniBlock.ProcessingType,
+			ConstructionState:       apiMiniBlock.ConstructionState,
+			SourceShard:             int32(apiMiniBlock.SourceShard),
+			DestinationShard:        int32(apiMiniBlock.DestinationShard),
+			IndexOfFirstTxProcessed: apiMiniBlock.IndexOfFirstTxProcessed,
+			IndexOfLastTxProcessed:  apiMiniBlock.IndexOfLastTxProcessed,
+		})
+	}
+
+	return miniBlockHeaders, nil
+}
+
+func decodeHashOrNil(hash string) ([]byte, error) {
+	if len(hash) == 0 {
+		return nil, nil
+	}
+
+	return hex.DecodeString(hash)
+}
+
+func pendingMiniBlockHeadersOrNil(miniBlockHeaders []*schema.PendingMiniBlockHeader) []*schema.PendingMiniBlockHeader {
+	if len(miniBlockHeaders) == 0 {
+		return nil
+	}
+
+	return miniBlockHeaders
+}
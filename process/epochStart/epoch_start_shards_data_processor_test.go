@@ -0,0 +1,157 @@
+package epochStart
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpochStartShardsDataProcessor_ProcessEpochStartShardsData(t *testing.T) {
+	t.Parallel()
+
+	esd := NewEpochStartShardsDataProcessor()
+
+	apiShardData := &api.EpochStartShardData{
+		ShardID:               1,
+		Epoch:                 2,
+		Round:                 3,
+		Nonce:                 4,
+		HeaderHash:            "0a",
+		RootHash:              "0b",
+		ScheduledRootHash:     "0c",
+		FirstPendingMetaBlock: "0d",
+		LastFinishedMetaBlock: "0e",
+		PendingMiniBlockHeaders: []*api.MiniBlock{
+			{
+				Hash:                    "0f",
+				Type:                    "TxBlock",
+				ProcessingType:          "Normal",
+				ConstructionState:       "Final",
+				SourceShard:             1,
+				DestinationShard:        2,
+				IndexOfFirstTxProcessed: 5,
+				IndexOfLastTxProcessed:  6,
+			},
+			nil,
+		},
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		shardsData, err := esd.ProcessEpochStartShardsData([]*api.EpochStartShardData{apiShardData, nil})
+		require.Nil(t, err)
+		require.Equal(t, []*schema.EpochStartShardData{
+			{
+				ShardID:               1,
+				Epoch:                 2,
+				Round:                 3,
+				Nonce:                 4,
+				HeaderHash:            []byte{10},
+				RootHash:              []byte{11},
+				ScheduledRootHash:     []byte{12},
+				FirstPendingMetaBlock: []byte{13},
+				LastFinishedMetaBlock: []byte{14},
+				PendingMiniBlockHeaders: []*schema.PendingMiniBlockHeader{
+					{
+						Hash:                    []byte{15},
+						Type:                    "TxBlock",
+						ProcessingType:          "Normal",
+						ConstructionState:       "Final",
+						SourceShard:             1,
+						DestinationShard:        2,
+						IndexOfFirstTxProcessed: 5,
+						IndexOfLastTxProcessed:  6,
+					},
+				},
+			},
+		}, shardsData)
+	})
+
+	t.Run("nil api shards data, should return empty shards data", func(t *testing.T) {
+		t.Parallel()
+
+		shardsData, err := esd.ProcessEpochStartShardsData(nil)
+		require.Nil(t, err)
+		require.Empty(t, shardsData)
+	})
+
+	t.Run("empty hashes and no pending mini blocks, should fill them with nil", func(t *testing.T) {
+		t.Parallel()
+
+		shardsData, err := esd.ProcessEpochStartShardsData([]*api.EpochStartShardData{{ShardID: 1, Epoch: 2}})
+		require.Nil(t, err)
+		require.Equal(t, []*schema.EpochStartShardData{{ShardID: 1, Epoch: 2}}, shardsData)
+	})
+
+	t.Run("invalid header hash, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		apiShardDataCopy := *apiShardData
+		apiShardDataCopy.HeaderHash = "header hash"
+
+		shardsData, err := esd.ProcessEpochStartShardsData([]*api.EpochStartShardData{&apiShardDataCopy})
+		require.Nil(t, shardsData)
+		require.Error(t, err)
+		require.True(t, strings.Contains(err.Error(), "header hash"))
+	})
+
+	t.Run("invalid first pending meta block, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		apiShardDataCopy := *apiShardData
+		apiShardDataCopy.FirstPendingMetaBlock = "first pending meta block"
+
+		shardsData, err := esd.ProcessEpochStartShardsData([]*api.EpochStartShardData{&apiShardDataCopy})
+		require.Nil(t, shardsData)
+		require.Error(t, err)
+		require.True(t, strings.Contains(err.Error(), "first pending meta block"))
+	})
+
+	t.Run("invalid pending mini block hash, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		apiShardDataCopy := *apiShardData
+		apiShardDataCopy.PendingMiniBlockHeaders = []*api.MiniBlock{{Hash: "mini block hash"}}
+
+		shardsData, err := esd.ProcessEpochStartShardsData([]*api.EpochStartShardData{&apiShardDataCopy})
+		require.Nil(t, shardsData)
+		require.Error(t, err)
+		require.True(t, strings.Contains(err.Error(), "pending mini block hash"))
+	})
+}
+
+func TestEpochStartShardsDataProcessor_ProcessEpochStartShardsData_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	esd := NewEpochStartShardsDataProcessor()
+	hash := strings.Repeat("ab", 32)
+
+	shardsData, err := esd.ProcessEpochStartShardsData([]*api.EpochStartShardData{
+		{
+			ShardID:               1,
+			HeaderHash:            hash,
+			FirstPendingMetaBlock: hash,
+			PendingMiniBlockHeaders: []*api.MiniBlock{
+				{Hash: hash, Type: "TxBlock"},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	hyperBlock := schema.NewHyperBlock()
+	hyperBlock.EpochStartShardsData = shardsData
+	avroMarshaller := &utility.AvroMarshaller{}
+
+	encodedHyperBlock, err := avroMarshaller.Encode(hyperBlock)
+	require.Nil(t, err)
+
+	decodedHyperBlock := schema.NewHyperBlock()
+	err = avroMarshaller.Decode(decodedHyperBlock, encodedHyperBlock)
+	require.Nil(t, err)
+	require.Equal(t, shardsData, decodedHyperBlock.EpochStartShardsData)
+}
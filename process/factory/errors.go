@@ -0,0 +1,5 @@
+package factory
+
+import "errors"
+
+var errUnknownAddressEncoding = errors.New("unknown address encoding")
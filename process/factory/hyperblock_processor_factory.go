@@ -1,6 +1,9 @@
 package factory
 
 import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-covalent-go"
 	"github.com/multiversx/mx-chain-covalent-go/process"
 	"github.com/multiversx/mx-chain-covalent-go/process/accounts"
@@ -9,28 +12,97 @@ import (
 	"github.com/multiversx/mx-chain-covalent-go/process/receipts"
 	"github.com/multiversx/mx-chain-covalent-go/process/shardBlocks"
 	"github.com/multiversx/mx-chain-covalent-go/process/transactions"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
-// CreateHyperBlockProcessor creates a new hyper block processor handler
-func CreateHyperBlockProcessor() (covalent.HyperBlockProcessor, error) {
-	receiptsHandler := receipts.NewReceiptsProcessor()
-	logsHandler := logs.NewLogsProcessor()
-	transactionsHandler, err := transactions.NewTransactionProcessor(logsHandler, receiptsHandler)
+const pubKeyLen = 32
+
+var log = logger.GetOrCreate("process/factory")
+
+// CreateHyperBlockProcessor creates a new hyper block processor handler, which outputs addresses in the provided
+// encoding(schema.AddressEncodingBech32 or schema.AddressEncodingPubKey)
+func CreateHyperBlockProcessor(addressEncoding string) (covalent.HyperBlockProcessor, error) {
+	addressConverter, err := CreateAddressConverter(addressEncoding)
 	if err != nil {
 		return nil, err
 	}
 
-	alteredAccountsHandler := accounts.NewAlteredAccountsProcessor()
+	transactionsHandler, err := createTransactionProcessor(addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	alteredAccountsHandler, err := accounts.NewAlteredAccountsProcessor(addressConverter)
+	if err != nil {
+		return nil, err
+	}
 	shardBlocksHandler, err := shardBlocks.NewShardBlocksProcessor(alteredAccountsHandler)
 	if err != nil {
 		return nil, err
 	}
 
 	epochStartInfoHandler := epochStart.NewEpochStartInfoProcessor()
+	epochStartShardsDataHandler := epochStart.NewEpochStartShardsDataProcessor()
 	args := &process.HyperBlockProcessorArgs{
-		TransactionHandler:    transactionsHandler,
-		ShardBlockHandler:     shardBlocksHandler,
-		EpochStartInfoHandler: epochStartInfoHandler,
+		TransactionHandler:          transactionsHandler,
+		ShardBlockHandler:           shardBlocksHandler,
+		EpochStartInfoHandler:       epochStartInfoHandler,
+		EpochStartShardsDataHandler: epochStartShardsDataHandler,
 	}
 	return process.NewHyperBlockProcessor(args)
 }
+
+// CreateTransactionProcessor creates a new transaction processor handler, which outputs addresses in the provided
+// encoding(schema.AddressEncodingBech32 or schema.AddressEncodingPubKey)
+func CreateTransactionProcessor(addressEncoding string) (process.TransactionHandler, error) {
+	addressConverter, err := CreateAddressConverter(addressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	return createTransactionProcessor(addressConverter)
+}
+
+func createTransactionProcessor(addressConverter process.AddressConverter) (process.TransactionHandler, error) {
+	receiptsHandler, err := receipts.NewReceiptsProcessor(addressConverter)
+	if err != nil {
+		return nil, err
+	}
+	logsHandler, err := logs.NewLogsProcessor(addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions.NewTransactionProcessor(logsHandler, receiptsHandler, addressConverter)
+}
+
+// CreateBalanceUpdatesEncoder creates an avro marshaller which encodes arrays of account balance updates, having
+// addresses in the provided encoding(schema.AddressEncodingBech32 or schema.AddressEncodingPubKey)
+func CreateBalanceUpdatesEncoder(addressEncoding string) (*utility.AvroMarshaller, error) {
+	balanceUpdatesSchemaDefinition, err := schema.GetAccountBalanceUpdatesSchemaDefinition(addressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	return utility.NewAvroMarshallerWithSchema(balanceUpdatesSchemaDefinition)
+}
+
+// CreateAddressConverter creates an address converter for the provided encoding(schema.AddressEncodingBech32 or
+// schema.AddressEncodingPubKey)
+func CreateAddressConverter(addressEncoding string) (process.AddressConverter, error) {
+	switch addressEncoding {
+	case schema.AddressEncodingBech32:
+		return utility.NewBech32AddressConverter(), nil
+	case schema.AddressEncodingPubKey:
+		bech32PubKeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(pubKeyLen, log)
+		if err != nil {
+			return nil, err
+		}
+
+		return utility.NewPubKeyAddressConverter(bech32PubKeyConverter)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownAddressEncoding, addressEncoding)
+	}
+}
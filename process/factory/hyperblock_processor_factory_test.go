@@ -0,0 +1,227 @@
+package factory
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/elodina/go-avro"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-covalent-go/hyperBlock"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateHyperBlockProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown address encoding, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		hyperBlockProcessor, err := CreateHyperBlockProcessor("hex")
+		require.Nil(t, hyperBlockProcessor)
+		require.True(t, errors.Is(err, errUnknownAddressEncoding))
+	})
+
+	t.Run("bech32 address encoding, should work", func(t *testing.T) {
+		t.Parallel()
+
+		hyperBlockProcessor, err := CreateHyperBlockProcessor(schema.AddressEncodingBech32)
+		require.Nil(t, err)
+		require.NotNil(t, hyperBlockProcessor)
+	})
+
+	t.Run("pub key address encoding, should work", func(t *testing.T) {
+		t.Parallel()
+
+		hyperBlockProcessor, err := CreateHyperBlockProcessor(schema.AddressEncodingPubKey)
+		require.Nil(t, err)
+		require.NotNil(t, hyperBlockProcessor)
+	})
+}
+
+func TestCreateHyperBlockProcessor_PubKeyAddressEncoding_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	bech32PubKeyConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(pubKeyLen, log)
+	sender := testscommon.GenerateRandomFixedBytes(pubKeyLen)
+	receiver := testscommon.GenerateRandomFixedBytes(pubKeyLen)
+
+	apiHyperBlock := &hyperBlock.HyperBlock{
+		Hash:          generateHash(),
+		PrevBlockHash: generateHash(),
+		StateRootHash: generateHash(),
+		Nonce:         4,
+		Transactions: []*transaction.ApiTransactionResult{
+			generateApiTx(bech32PubKeyConverter.Encode(sender), bech32PubKeyConverter.Encode(receiver)),
+			generateApiTx(utility.MetachainShardName, bech32PubKeyConverter.Encode(receiver)),
+		},
+	}
+
+	hyperBlockProcessor, _ := CreateHyperBlockProcessor(schema.AddressEncodingPubKey)
+	processedHyperBlock, err := hyperBlockProcessor.Process(apiHyperBlock)
+	require.Nil(t, err)
+
+	avroMarshaller, err := utility.NewAvroMarshallerWithSchema(schema.HyperBlockPubKeySchemaDefinition)
+	require.Nil(t, err)
+
+	encodedHyperBlock, err := avroMarshaller.Encode(processedHyperBlock)
+	require.Nil(t, err)
+
+	decodedHyperBlock := schema.NewHyperBlock()
+	err = avroMarshaller.Decode(decodedHyperBlock, encodedHyperBlock)
+	require.Nil(t, err)
+	require.Equal(t, processedHyperBlock.Hash, decodedHyperBlock.Hash)
+
+	require.Len(t, decodedHyperBlock.Transactions, 2)
+	require.Equal(t, sender, decodedHyperBlock.Transactions[0].Sender)
+	require.Equal(t, receiver, decodedHyperBlock.Transactions[0].Receiver)
+	require.Equal(t, processedHyperBlock.Transactions[1].Sender, decodedHyperBlock.Transactions[1].Sender)
+	require.Len(t, decodedHyperBlock.Transactions[1].Sender, pubKeyLen)
+
+	bech32AvroMarshaller, _ := utility.NewAvroMarshallerWithSchema(schema.HyperBlockSchemaDefinition)
+	_, err = bech32AvroMarshaller.Encode(processedHyperBlock)
+	require.NotNil(t, err)
+}
+
+func TestCreateTransactionProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown address encoding, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionProcessor, err := CreateTransactionProcessor("hex")
+		require.Nil(t, transactionProcessor)
+		require.True(t, errors.Is(err, errUnknownAddressEncoding))
+	})
+
+	bech32PubKeyConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(pubKeyLen, log)
+	for _, addressEncoding := range []string{schema.AddressEncodingBech32, schema.AddressEncodingPubKey} {
+		addressEncoding := addressEncoding
+		t.Run(addressEncoding+" address encoding, should encode and decode transaction", func(t *testing.T) {
+			t.Parallel()
+
+			sender := bech32PubKeyConverter.Encode(testscommon.GenerateRandomFixedBytes(pubKeyLen))
+			receiver := bech32PubKeyConverter.Encode(testscommon.GenerateRandomFixedBytes(pubKeyLen))
+			transactionProcessor, err := CreateTransactionProcessor(addressEncoding)
+			require.Nil(t, err)
+
+			processedTransactions, err := transactionProcessor.ProcessTransactions([]*transaction.ApiTransactionResult{
+				generateApiTx(sender, receiver),
+			})
+			require.Nil(t, err)
+			require.Len(t, processedTransactions, 1)
+
+			transactionSchemaDefinition, err := schema.GetTransactionSchemaDefinition(addressEncoding)
+			require.Nil(t, err)
+			avroMarshaller, err := utility.NewAvroMarshallerWithSchema(transactionSchemaDefinition)
+			require.Nil(t, err)
+
+			encodedTransaction, err := avroMarshaller.Encode(processedTransactions[0])
+			require.Nil(t, err)
+
+			decodedTransaction := schema.NewTransaction()
+			err = avroMarshaller.Decode(decodedTransaction, encodedTransaction)
+			require.Nil(t, err)
+			require.Equal(t, processedTransactions[0].Hash, decodedTransaction.Hash)
+			require.Equal(t, processedTransactions[0].Sender, decodedTransaction.Sender)
+			require.Equal(t, processedTransactions[0].Receiver, decodedTransaction.Receiver)
+			require.Equal(t, processedTransactions[0].Value, decodedTransaction.Value)
+		})
+	}
+}
+
+func TestCreateBalanceUpdatesEncoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown address encoding, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		balanceUpdatesEncoder, err := CreateBalanceUpdatesEncoder("hex")
+		require.Nil(t, balanceUpdatesEncoder)
+		require.NotNil(t, err)
+	})
+
+	addressesLen := map[string]int{
+		schema.AddressEncodingBech32: len(schema.NewAccountBalanceUpdate().Address),
+		schema.AddressEncodingPubKey: pubKeyLen,
+	}
+	for addressEncoding, addressLen := range addressesLen {
+		addressEncoding := addressEncoding
+		addressLen := addressLen
+		t.Run(addressEncoding+" address encoding, should encode balance updates array", func(t *testing.T) {
+			t.Parallel()
+
+			balanceUpdatesEncoder, err := CreateBalanceUpdatesEncoder(addressEncoding)
+			require.Nil(t, err)
+
+			address := testscommon.GenerateRandomFixedBytes(addressLen)
+			shardBlockHash := testscommon.GenerateRandomFixedBytes(32)
+			encodedBalanceUpdates, err := balanceUpdatesEncoder.Encode(schema.AccountBalanceUpdates{
+				{
+					ShardBlockNonce: 4,
+					ShardBlockHash:  shardBlockHash,
+					BalanceUpdate: &schema.AccountBalanceUpdate{
+						Address: address,
+						Balance: []byte{1},
+						Nonce:   2,
+						Tokens: []*schema.AccountTokenData{
+							{Identifier: "TKN-abcdef", Balance: []byte{3}},
+						},
+					},
+				},
+			})
+			require.Nil(t, err)
+
+			balanceUpdatesSchemaDefinition, _ := schema.GetAccountBalanceUpdatesSchemaDefinition(addressEncoding)
+			reader := avro.NewGenericDatumReader()
+			reader.SetSchema(avro.MustParseSchema(balanceUpdatesSchemaDefinition))
+			var decodedBalanceUpdates []interface{}
+			err = reader.Read(&decodedBalanceUpdates, avro.NewBinaryDecoder(encodedBalanceUpdates))
+			require.Nil(t, err)
+			require.Len(t, decodedBalanceUpdates, 1)
+
+			decodedShardBalanceUpdate := decodedBalanceUpdates[0].(*avro.GenericRecord)
+			require.Equal(t, int64(4), decodedShardBalanceUpdate.Get("ShardBlockNonce"))
+			require.Equal(t, shardBlockHash, decodedShardBalanceUpdate.Get("ShardBlockHash"))
+			decodedBalanceUpdate := decodedShardBalanceUpdate.Get("BalanceUpdate").(*avro.GenericRecord)
+			require.Equal(t, address, decodedBalanceUpdate.Get("Address"))
+			require.Len(t, decodedBalanceUpdate.Get("Tokens"), 1)
+
+			_, err = balanceUpdatesEncoder.Encode(schema.AccountBalanceUpdates{
+				{
+					ShardBlockHash: shardBlockHash,
+					BalanceUpdate: &schema.AccountBalanceUpdate{
+						Address: testscommon.GenerateRandomFixedBytes(addressLen + 1),
+					},
+				},
+			})
+			require.NotNil(t, err)
+		})
+	}
+}
+
+func generateApiTx(sender string, receiver string) *transaction.ApiTransactionResult {
+	return &transaction.ApiTransactionResult{
+		Hash:                             generateHash(),
+		Sender:                           sender,
+		Receiver:                         receiver,
+		OriginalSender:                   sender,
+		Signature:                        hex.EncodeToString(testscommon.GenerateRandomFixedBytes(64)),
+		Value:                            "1000",
+		PreviousTransactionHash:          generateHash(),
+		OriginalTransactionHash:          generateHash(),
+		BlockHash:                        generateHash(),
+		NotarizedAtSourceInMetaHash:      generateHash(),
+		NotarizedAtDestinationInMetaHash: generateHash(),
+		MiniBlockHash:                    generateHash(),
+		HyperblockHash:                   generateHash(),
+	}
+}
+
+func generateHash() string {
+	return hex.EncodeToString(testscommon.GenerateRandomFixedBytes(32))
+}
@@ -11,15 +11,17 @@ import (
 // HyperBlockProcessorArgs holds all input dependencies required
 // by hyper block processor in order to create a new hyper block processor
 type HyperBlockProcessorArgs struct {
-	TransactionHandler    TransactionHandler
-	ShardBlockHandler     ShardBlocksHandler
-	EpochStartInfoHandler EpochStartInfoHandler
+	TransactionHandler          TransactionHandler
+	ShardBlockHandler           ShardBlocksHandler
+	EpochStartInfoHandler       EpochStartInfoHandler
+	EpochStartShardsDataHandler EpochStartShardsDataHandler
 }
 
 type hyperBlockProcessor struct {
-	transactionProcessor    TransactionHandler
-	shardBlocksProcessor    ShardBlocksHandler
-	epochStartInfoProcessor EpochStartInfoHandler
+	transactionProcessor          TransactionHandler
+	shardBlocksProcessor          ShardBlocksHandler
+	epochStartInfoProcessor       EpochStartInfoHandler
+	epochStartShardsDataProcessor EpochStartShardsDataHandler
 }
 
 // NewHyperBlockProcessor will create a new instance of an hyper block processor
@@ -33,11 +35,15 @@ func NewHyperBlockProcessor(args *HyperBlockProcessorArgs) (*hyperBlockProcessor
 	if args.EpochStartInfoHandler == nil {
 		return nil, errNilEpochStartInfoHandler
 	}
+	if args.EpochStartShardsDataHandler == nil {
+		return nil, errNilEpochStartShardsDataHandler
+	}
 
 	return &hyperBlockProcessor{
-		transactionProcessor:    args.TransactionHandler,
-		shardBlocksProcessor:    args.ShardBlockHandler,
-		epochStartInfoProcessor: args.EpochStartInfoHandler,
+		transactionProcessor:          args.TransactionHandler,
+		shardBlocksProcessor:          args.ShardBlockHandler,
+		epochStartInfoProcessor:       args.EpochStartInfoHandler,
+		epochStartShardsDataProcessor: args.EpochStartShardsDataHandler,
 	}, nil
 }
 
@@ -83,6 +89,10 @@ func (hbp *hyperBlockProcessor) Process(hyperBlock *hyperBlock.HyperBlock) (*sch
 	if err != nil {
 		return nil, err
 	}
+	epochStartShardsData, err := hbp.epochStartShardsDataProcessor.ProcessEpochStartShardsData(hyperBlock.EpochStartShardsData)
+	if err != nil {
+		return nil, err
+	}
 
 	return &schema.HyperBlock{
 		Hash:                   hash,
@@ -98,6 +108,7 @@ func (hbp *hyperBlockProcessor) Process(hyperBlock *hyperBlock.HyperBlock) (*sch
 		DeveloperFeesInEpoch:   developerFeesInEpoch,
 		Timestamp:              int64(hyperBlock.Timestamp),
 		EpochStartInfo:         epochStartInfoOrNil(epochStartInfo),
+		EpochStartShardsData:   epochStartShardsDataOrNil(epochStartShardsData),
 		ShardBlocks:            shardBlocksOrNil(shardBlocks),
 		Transactions:           txsOrNil(txs),
 		Status:                 hyperBlock.Status,
@@ -118,6 +129,13 @@ func shardBlocksOrNil(shardBlocks []*schema.ShardBlocks) []*schema.ShardBlocks {
 	return shardBlocks
 }
 
+func epochStartShardsDataOrNil(epochStartShardsData []*schema.EpochStartShardData) []*schema.EpochStartShardData {
+	if len(epochStartShardsData) == 0 {
+		return nil
+	}
+	return epochStartShardsData
+}
+
 func epochStartInfoOrNil(epochStartInfo *schema.EpochStartInfo) *schema.EpochStartInfo {
 	if emptyEpochStartInfo(epochStartInfo) {
 		return nil
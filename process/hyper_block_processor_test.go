@@ -17,9 +17,10 @@ import (
 
 func createHyperBlockProcessorArgs() *HyperBlockProcessorArgs {
 	return &HyperBlockProcessorArgs{
-		TransactionHandler:    &processMocks.TransactionHandlerStub{},
-		ShardBlockHandler:     &processMocks.ShardBlocksHandlerStub{},
-		EpochStartInfoHandler: &processMocks.EpochStartInfoHandlerStub{},
+		TransactionHandler:          &processMocks.TransactionHandlerStub{},
+		ShardBlockHandler:           &processMocks.ShardBlocksHandlerStub{},
+		EpochStartInfoHandler:       &processMocks.EpochStartInfoHandlerStub{},
+		EpochStartShardsDataHandler: &processMocks.EpochStartShardsDataHandlerStub{},
 	}
 }
 
@@ -67,6 +68,17 @@ func TestNewHyperBlockProcessor(t *testing.T) {
 		require.Nil(t, hbp)
 		require.Equal(t, errNilEpochStartInfoHandler, err)
 	})
+
+	t.Run("nil epoch start shards data processor, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createHyperBlockProcessorArgs()
+		args.EpochStartShardsDataHandler = nil
+
+		hbp, err := NewHyperBlockProcessor(args)
+		require.Nil(t, hbp)
+		require.Equal(t, errNilEpochStartShardsDataHandler, err)
+	})
 }
 
 func TestHyperBlockProcessor_Process(t *testing.T) {
@@ -76,10 +88,12 @@ func TestHyperBlockProcessor_Process(t *testing.T) {
 	alteredAcc := &outport.AlteredAccount{Balance: "100"}
 	shardBlocks := []*api.NotarizedBlock{{Hash: "hash2", AlteredAccounts: []*outport.AlteredAccount{alteredAcc}}}
 	epochStartInfo := &api.EpochStartInfo{NodePrice: "100"}
+	epochStartShardsData := []*api.EpochStartShardData{{ShardID: 1, HeaderHash: "0d"}}
 
 	processedTxs := []*schema.Transaction{{Hash: []byte(apiTxs[0].Hash)}}
 	processedShardBlocks := []*schema.ShardBlocks{{Hash: []byte(shardBlocks[0].Hash)}}
 	processedEpochStartInfo := &schema.EpochStartInfo{NodePrice: big.NewInt(100).Bytes()}
+	processedEpochStartShardsData := []*schema.EpochStartShardData{{ShardID: 1, HeaderHash: []byte{13}}}
 
 	apiHyperBLock := &hyperBlock.HyperBlock{
 		Hash:                   "0a",
@@ -95,6 +109,7 @@ func TestHyperBlockProcessor_Process(t *testing.T) {
 		DeveloperFeesInEpoch:   "11",
 		Timestamp:              12,
 		EpochStartInfo:         epochStartInfo,
+		EpochStartShardsData:   epochStartShardsData,
 		ShardBlocks:            shardBlocks,
 		Transactions:           apiTxs,
 		Status:                 "status",
@@ -114,6 +129,7 @@ func TestHyperBlockProcessor_Process(t *testing.T) {
 		DeveloperFeesInEpoch:   big.NewInt(11).Bytes(),
 		Timestamp:              12,
 		EpochStartInfo:         processedEpochStartInfo,
+		EpochStartShardsData:   processedEpochStartShardsData,
 		ShardBlocks:            processedShardBlocks,
 		Transactions:           processedTxs,
 		Status:                 "status",
@@ -137,13 +153,20 @@ func TestHyperBlockProcessor_Process(t *testing.T) {
 			return processedEpochStartInfo, nil
 		},
 	}
+	epochStartShardsDataProcessor := &processMocks.EpochStartShardsDataHandlerStub{
+		ProcessEpochStartShardsDataCalled: func(apiShardsData []*api.EpochStartShardData) ([]*schema.EpochStartShardData, error) {
+			require.Equal(t, epochStartShardsData, apiShardsData)
+			return processedEpochStartShardsData, nil
+		},
+	}
 
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 		args := &HyperBlockProcessorArgs{
-			TransactionHandler:    txProcessor,
-			ShardBlockHandler:     shardBlocksProcessor,
-			EpochStartInfoHandler: epochStartInfoProcessor,
+			TransactionHandler:          txProcessor,
+			ShardBlockHandler:           shardBlocksProcessor,
+			EpochStartInfoHandler:       epochStartInfoProcessor,
+			EpochStartShardsDataHandler: epochStartShardsDataProcessor,
 		}
 		hbp, _ := NewHyperBlockProcessor(args)
 
@@ -261,8 +284,9 @@ func TestHyperBlockProcessor_Process(t *testing.T) {
 					return []*schema.Transaction{}, nil
 				},
 			},
-			ShardBlockHandler:     shardBlocksProcessor,
-			EpochStartInfoHandler: epochStartInfoProcessor,
+			ShardBlockHandler:           shardBlocksProcessor,
+			EpochStartInfoHandler:       epochStartInfoProcessor,
+			EpochStartShardsDataHandler: epochStartShardsDataProcessor,
 		}
 		hbp, _ := NewHyperBlockProcessor(args)
 
@@ -303,7 +327,8 @@ func TestHyperBlockProcessor_Process(t *testing.T) {
 					return []*schema.ShardBlocks{}, nil
 				},
 			},
-			EpochStartInfoHandler: epochStartInfoProcessor,
+			EpochStartInfoHandler:       epochStartInfoProcessor,
+			EpochStartShardsDataHandler: epochStartShardsDataProcessor,
 		}
 		hbp, _ := NewHyperBlockProcessor(args)
 
@@ -345,6 +370,7 @@ func TestHyperBlockProcessor_Process(t *testing.T) {
 					return nil, nil
 				},
 			},
+			EpochStartShardsDataHandler: epochStartShardsDataProcessor,
 		}
 		hbp, _ := NewHyperBlockProcessor(args)
 
@@ -368,6 +394,7 @@ func TestHyperBlockProcessor_Process(t *testing.T) {
 					return schema.NewEpochStartInfo(), nil
 				},
 			},
+			EpochStartShardsDataHandler: epochStartShardsDataProcessor,
 		}
 		hbp, _ := NewHyperBlockProcessor(args)
 
@@ -396,4 +423,46 @@ func TestHyperBlockProcessor_Process(t *testing.T) {
 		require.Nil(t, processedHyperBlock)
 		require.Equal(t, errProcessEpochStartInfo, err)
 	})
+
+	t.Run("empty epoch start shards data, should fill epoch start shards data with nil", func(t *testing.T) {
+		t.Parallel()
+
+		apiHyperBLockCopy := *apiHyperBLock
+		args := &HyperBlockProcessorArgs{
+			TransactionHandler:    txProcessor,
+			ShardBlockHandler:     shardBlocksProcessor,
+			EpochStartInfoHandler: epochStartInfoProcessor,
+			EpochStartShardsDataHandler: &processMocks.EpochStartShardsDataHandlerStub{
+				ProcessEpochStartShardsDataCalled: func(apiShardsData []*api.EpochStartShardData) ([]*schema.EpochStartShardData, error) {
+					return []*schema.EpochStartShardData{}, nil
+				},
+			},
+		}
+		hbp, _ := NewHyperBlockProcessor(args)
+
+		processedHyperBlock, err := hbp.Process(&apiHyperBLockCopy)
+		require.Nil(t, err)
+
+		expectedProcessedHyperBlockCopy := *expectedProcessedHyperBlock
+		expectedProcessedHyperBlockCopy.EpochStartShardsData = nil
+		require.Equal(t, &expectedProcessedHyperBlockCopy, processedHyperBlock)
+	})
+
+	t.Run("invalid epoch start shards data, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		apiHyperBLockCopy := *apiHyperBLock
+		args := createHyperBlockProcessorArgs()
+		errProcessEpochStartShardsData := errors.New("error processing epoch start shards data")
+		args.EpochStartShardsDataHandler = &processMocks.EpochStartShardsDataHandlerStub{
+			ProcessEpochStartShardsDataCalled: func(apiShardsData []*api.EpochStartShardData) ([]*schema.EpochStartShardData, error) {
+				return nil, errProcessEpochStartShardsData
+			},
+		}
+		hbp, _ := NewHyperBlockProcessor(args)
+
+		processedHyperBlock, err := hbp.Process(&apiHyperBLockCopy)
+		require.Nil(t, processedHyperBlock)
+		require.Equal(t, errProcessEpochStartShardsData, err)
+	})
 }
@@ -19,7 +19,7 @@ type ReceiptHandler interface {
 
 // LogHandler defines what a log processor shall do
 type LogHandler interface {
-	ProcessLog(log *transaction.ApiLogs) *schema.Log
+	ProcessLog(log *transaction.ApiLogs) (*schema.Log, error)
 }
 
 // ShardBlocksHandler defines what shard blocks processor shall do
@@ -32,7 +32,19 @@ type EpochStartInfoHandler interface {
 	ProcessEpochStartInfo(apiEpochInfo *api.EpochStartInfo) (*schema.EpochStartInfo, error)
 }
 
+// EpochStartShardsDataHandler defines what epoch start shards data processor shall do
+type EpochStartShardsDataHandler interface {
+	ProcessEpochStartShardsData(apiShardsData []*api.EpochStartShardData) ([]*schema.EpochStartShardData, error)
+}
+
 // AlteredAccountsHandler defines what an account processor shall do
 type AlteredAccountsHandler interface {
 	ProcessAccounts(apiAlteredAccounts []*outport.AlteredAccount) ([]*schema.AccountBalanceUpdate, error)
 }
+
+// AddressConverter defines what an address converter shall do
+type AddressConverter interface {
+	ConvertAddress(address string) ([]byte, error)
+	DecodeAddress(address []byte) string
+	AddressLen() int
+}
@@ -0,0 +1,5 @@
+package logs
+
+import "errors"
+
+var errNilAddressConverter = errors.New("nil address converter provided")
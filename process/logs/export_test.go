@@ -0,0 +1,4 @@
+package logs
+
+// ErrNilAddressConverter -
+var ErrNilAddressConverter = errNilAddressConverter
@@ -1,53 +1,78 @@
 package logs
 
 import (
+	"github.com/multiversx/mx-chain-covalent-go/process"
 	"github.com/multiversx/mx-chain-covalent-go/schema"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 )
 
 type logsProcessor struct {
+	addressConverter process.AddressConverter
 }
 
 // NewLogsProcessor creates a new instance of logs processor
-func NewLogsProcessor() *logsProcessor {
-	return &logsProcessor{}
+func NewLogsProcessor(addressConverter process.AddressConverter) (*logsProcessor, error) {
+	if addressConverter == nil {
+		return nil, errNilAddressConverter
+	}
+
+	return &logsProcessor{
+		addressConverter: addressConverter,
+	}, nil
 }
 
 // ProcessLog converts logs data to a specific structure defined by avro schema
-func (lp *logsProcessor) ProcessLog(log *transaction.ApiLogs) *schema.Log {
+func (lp *logsProcessor) ProcessLog(log *transaction.ApiLogs) (*schema.Log, error) {
 	if log == nil {
-		return schema.NewLog()
+		return schema.NewLog(), nil
 	}
 
-	return &schema.Log{
-		Address: []byte(log.Address),
-		Events:  lp.processEvents(log.Events),
+	address, err := lp.addressConverter.ConvertAddress(log.Address)
+	if err != nil {
+		return nil, err
 	}
+	events, err := lp.processEvents(log.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.Log{
+		Address: address,
+		Events:  events,
+	}, nil
 }
 
-func (lp *logsProcessor) processEvents(events []*transaction.Events) []*schema.Event {
+func (lp *logsProcessor) processEvents(events []*transaction.Events) ([]*schema.Event, error) {
 	allEvents := make([]*schema.Event, 0, len(events))
 
 	for _, currEvent := range events {
-		processedEvent := lp.processEvent(currEvent)
+		processedEvent, err := lp.processEvent(currEvent)
+		if err != nil {
+			return nil, err
+		}
 
 		if processedEvent != nil {
 			allEvents = append(allEvents, processedEvent)
 		}
 	}
 
-	return allEvents
+	return allEvents, nil
 }
 
-func (lp *logsProcessor) processEvent(event *transaction.Events) *schema.Event {
+func (lp *logsProcessor) processEvent(event *transaction.Events) (*schema.Event, error) {
 	if event == nil {
-		return nil
+		return nil, nil
+	}
+
+	address, err := lp.addressConverter.ConvertAddress(event.Address)
+	if err != nil {
+		return nil, err
 	}
 
 	return &schema.Event{
-		Address:    []byte(event.Address),
+		Address:    address,
 		Identifier: []byte(event.Identifier),
 		Topics:     event.Topics,
 		Data:       event.Data,
-	}
+	}, nil
 }
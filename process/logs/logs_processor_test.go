@@ -1,24 +1,48 @@
 package logs_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/multiversx/mx-chain-covalent-go/process/logs"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
 	"github.com/multiversx/mx-chain-covalent-go/schema"
 	"github.com/multiversx/mx-chain-covalent-go/testscommon"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/stretchr/testify/require"
 )
 
+func TestNewLogsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lp, err := logs.NewLogsProcessor(utility.NewBech32AddressConverter())
+		require.Nil(t, err)
+		require.NotNil(t, lp)
+	})
+
+	t.Run("nil address converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		lp, err := logs.NewLogsProcessor(nil)
+		require.Nil(t, lp)
+		require.Equal(t, logs.ErrNilAddressConverter, err)
+	})
+}
+
 func TestLogsProcessor_ProcessLog(t *testing.T) {
 	t.Parallel()
 
-	lp := logs.NewLogsProcessor()
+	lp, _ := logs.NewLogsProcessor(utility.NewBech32AddressConverter())
 
 	t.Run("no log, expect empty log", func(t *testing.T) {
 		t.Parallel()
 
-		log := lp.ProcessLog(nil)
+		log, err := lp.ProcessLog(nil)
+		require.Nil(t, err)
 		require.Equal(t, schema.NewLog(), log)
 	})
 
@@ -27,7 +51,8 @@ func TestLogsProcessor_ProcessLog(t *testing.T) {
 
 		apiLog := &transaction.ApiLogs{Address: "erd1qq", Events: nil}
 
-		processedLog := lp.ProcessLog(apiLog)
+		processedLog, err := lp.ProcessLog(apiLog)
+		require.Nil(t, err)
 		require.Equal(t, &schema.Log{
 			Address: []byte(apiLog.Address),
 			Events:  []*schema.Event{},
@@ -56,7 +81,8 @@ func TestLogsProcessor_ProcessLog(t *testing.T) {
 			Events:  []*transaction.Events{event1, nil, event2, nil},
 		}
 
-		processedLog := lp.ProcessLog(apiLog)
+		processedLog, err := lp.ProcessLog(apiLog)
+		require.Nil(t, err)
 		expectedLog := &schema.Log{
 			Address: []byte(apiLog.Address),
 			Events: []*schema.Event{
@@ -76,4 +102,26 @@ func TestLogsProcessor_ProcessLog(t *testing.T) {
 		}
 		require.Equal(t, expectedLog, processedLog)
 	})
+
+	t.Run("cannot convert event address, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errConvertAddress := errors.New("error converting address")
+		lpWithInvalidAddresses, _ := logs.NewLogsProcessor(&mock.AddressConverterStub{
+			ConvertAddressCalled: func(address string) ([]byte, error) {
+				if address == "erd1aa" {
+					return nil, errConvertAddress
+				}
+				return []byte(address), nil
+			},
+		})
+
+		apiLog := &transaction.ApiLogs{
+			Address: "erd1cc",
+			Events:  []*transaction.Events{{Address: "erd1aa"}},
+		}
+		processedLog, err := lpWithInvalidAddresses.ProcessLog(apiLog)
+		require.Nil(t, processedLog)
+		require.Equal(t, errConvertAddress, err)
+	})
 }
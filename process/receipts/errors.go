@@ -0,0 +1,5 @@
+package receipts
+
+import "errors"
+
+var errNilAddressConverter = errors.New("nil address converter provided")
@@ -0,0 +1,4 @@
+package receipts
+
+// ErrNilAddressConverter -
+var ErrNilAddressConverter = errNilAddressConverter
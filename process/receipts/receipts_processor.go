@@ -4,36 +4,55 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/multiversx/mx-chain-covalent-go/process"
 	"github.com/multiversx/mx-chain-covalent-go/process/utility"
 	"github.com/multiversx/mx-chain-covalent-go/schema"
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 )
 
 type receiptsProcessor struct {
-	pubKeyConverter core.PubkeyConverter
+	addressConverter process.AddressConverter
 }
 
 // NewReceiptsProcessor creates a new instance of receipts processor
-func NewReceiptsProcessor() *receiptsProcessor {
-	return &receiptsProcessor{}
+func NewReceiptsProcessor(addressConverter process.AddressConverter) (*receiptsProcessor, error) {
+	if addressConverter == nil {
+		return nil, errNilAddressConverter
+	}
+
+	return &receiptsProcessor{
+		addressConverter: addressConverter,
+	}, nil
 }
 
 // ProcessReceipt converts receipts api data to a specific structure defined by avro schema
 func (rp *receiptsProcessor) ProcessReceipt(apiReceipt *transaction.ApiReceipt) (*schema.Receipt, error) {
 	if apiReceipt == nil {
-		return schema.NewReceipt(), nil
+		return rp.newEmptyReceipt(), nil
 	}
 
 	hash, err := hex.DecodeString(apiReceipt.TxHash)
 	if err != nil {
 		return nil, fmt.Errorf("receiptsProcessor.ProcessReceipt: could not decode tx hash: %s from receipt, err: %w", apiReceipt.TxHash, err)
 	}
+	sender, err := rp.addressConverter.ConvertAddress(apiReceipt.SndAddr)
+	if err != nil {
+		return nil, fmt.Errorf("receiptsProcessor.ProcessReceipt: could not convert sender from receipt, err: %w", err)
+	}
 
 	return &schema.Receipt{
 		Value:  utility.GetBytes(apiReceipt.Value),
-		Sender: []byte(apiReceipt.SndAddr),
+		Sender: sender,
 		Data:   []byte(apiReceipt.Data),
 		TxHash: hash,
 	}, nil
 }
+
+// newEmptyReceipt returns a default receipt, having the sender address length of the address converter, such that it
+// can be encoded with the schema variant matching the address encoding
+func (rp *receiptsProcessor) newEmptyReceipt() *schema.Receipt {
+	receipt := schema.NewReceipt()
+	receipt.Sender = make([]byte, rp.addressConverter.AddressLen())
+
+	return receipt
+}
@@ -2,20 +2,43 @@ package receipts_test
 
 import (
 	"encoding/hex"
+	"errors"
 	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-covalent-go/process/receipts"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
 	"github.com/multiversx/mx-chain-covalent-go/schema"
 	"github.com/multiversx/mx-chain-covalent-go/testscommon"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/stretchr/testify/require"
 )
 
+func TestNewReceiptsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rp, err := receipts.NewReceiptsProcessor(utility.NewBech32AddressConverter())
+		require.Nil(t, err)
+		require.NotNil(t, rp)
+	})
+
+	t.Run("nil address converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		rp, err := receipts.NewReceiptsProcessor(nil)
+		require.Nil(t, rp)
+		require.Equal(t, receipts.ErrNilAddressConverter, err)
+	})
+}
+
 func TestReceiptsProcessor_ProcessReceipt(t *testing.T) {
 	t.Parallel()
 
-	rp := receipts.NewReceiptsProcessor()
+	rp, _ := receipts.NewReceiptsProcessor(utility.NewBech32AddressConverter())
 	receipt := &transaction.ApiReceipt{
 		Value:   testscommon.GenerateRandomBigInt(),
 		SndAddr: "erd1qqq",
@@ -31,6 +54,21 @@ func TestReceiptsProcessor_ProcessReceipt(t *testing.T) {
 		require.Equal(t, schema.NewReceipt(), processedReceipt)
 	})
 
+	t.Run("nil receipt, should return empty receipt with sender of address converter length", func(t *testing.T) {
+		t.Parallel()
+
+		rpPubKey, _ := receipts.NewReceiptsProcessor(&mock.AddressConverterStub{
+			AddressLenCalled: func() int {
+				return 32
+			},
+		})
+
+		processedReceipt, err := rpPubKey.ProcessReceipt(nil)
+		require.Nil(t, err)
+		require.Equal(t, make([]byte, 32), processedReceipt.Sender)
+		require.Equal(t, schema.NewReceipt().TxHash, processedReceipt.TxHash)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -59,4 +97,19 @@ func TestReceiptsProcessor_ProcessReceipt(t *testing.T) {
 		require.NotNil(t, err)
 		require.True(t, strings.Contains(err.Error(), receiptCopy.TxHash))
 	})
+
+	t.Run("cannot convert sender, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errConvertAddress := errors.New("error converting address")
+		rpWithInvalidAddresses, _ := receipts.NewReceiptsProcessor(&mock.AddressConverterStub{
+			ConvertAddressCalled: func(address string) ([]byte, error) {
+				return nil, errConvertAddress
+			},
+		})
+
+		processedReceipt, err := rpWithInvalidAddresses.ProcessReceipt(receipt)
+		require.Nil(t, processedReceipt)
+		require.True(t, errors.Is(err, errConvertAddress))
+	})
 }
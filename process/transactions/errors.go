@@ -5,3 +5,5 @@ import "errors"
 var errNilLogProcessor = errors.New("nil log processor provided")
 
 var errNilReceiptProcessor = errors.New("nil receipt processor provided")
+
+var errNilAddressConverter = errors.New("nil address converter provided")
@@ -2,6 +2,7 @@ package transactions
 
 import (
 	"encoding/hex"
+	"fmt"
 
 	"github.com/multiversx/mx-chain-covalent-go/process"
 	"github.com/multiversx/mx-chain-covalent-go/process/utility"
@@ -10,14 +11,16 @@ import (
 )
 
 type transactionProcessor struct {
-	logProcessor   process.LogHandler
-	receiptHandler process.ReceiptHandler
+	logProcessor     process.LogHandler
+	receiptHandler   process.ReceiptHandler
+	addressConverter process.AddressConverter
 }
 
 // NewTransactionProcessor creates a new instance of transactions processor
 func NewTransactionProcessor(
 	logProcessor process.LogHandler,
 	receiptHandler process.ReceiptHandler,
+	addressConverter process.AddressConverter,
 ) (*transactionProcessor, error) {
 	if logProcessor == nil {
 		return nil, errNilLogProcessor
@@ -25,10 +28,14 @@ func NewTransactionProcessor(
 	if receiptHandler == nil {
 		return nil, errNilReceiptProcessor
 	}
+	if addressConverter == nil {
+		return nil, errNilAddressConverter
+	}
 
 	return &transactionProcessor{
-		logProcessor:   logProcessor,
-		receiptHandler: receiptHandler,
+		logProcessor:     logProcessor,
+		receiptHandler:   receiptHandler,
+		addressConverter: addressConverter,
 	}, nil
 }
 
@@ -105,8 +112,35 @@ func (txp *transactionProcessor) processTransaction(apiTx *transaction.ApiTransa
 	if err != nil {
 		return nil, err
 	}
+	relayedValue, err := utility.GetBigIntBytesFromStr(apiTx.RelayedValue)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := txp.addressConverter.ConvertAddress(apiTx.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := txp.addressConverter.ConvertAddress(apiTx.Sender)
+	if err != nil {
+		return nil, err
+	}
+	originalSender, err := txp.addressConverter.ConvertAddress(apiTx.OriginalSender)
+	if err != nil {
+		return nil, err
+	}
+	receivers, err := txp.convertAddresses(apiTx.Receivers)
+	if err != nil {
+		return nil, err
+	}
+	relayerAddress, err := txp.addressConverter.ConvertAddress(apiTx.RelayerAddress)
+	if err != nil {
+		return nil, err
+	}
+	log, err := txp.logProcessor.ProcessLog(apiTx.Logs)
+	if err != nil {
+		return nil, fmt.Errorf("could not process logs of tx: %s, err: %w", apiTx.Hash, err)
+	}
 
-	log := txp.logProcessor.ProcessLog(apiTx.Logs)
 	return &schema.Transaction{
 		Type:                              apiTx.Type,
 		ProcessingTypeOnSource:            apiTx.ProcessingTypeOnSource,
@@ -116,8 +150,8 @@ func (txp *transactionProcessor) processTransaction(apiTx *transaction.ApiTransa
 		Round:                             int64(apiTx.Round),
 		Epoch:                             int32(apiTx.Epoch),
 		Value:                             value,
-		Receiver:                          []byte(apiTx.Receiver),
-		Sender:                            utility.GetAddressOrMetachainAddr(apiTx.Sender),
+		Receiver:                          receiver,
+		Sender:                            sender,
 		SenderUserName:                    apiTx.SenderUsername,
 		ReceiverUserName:                  apiTx.ReceiverUsername,
 		GasPrice:                          int64(apiTx.GasPrice),
@@ -128,7 +162,7 @@ func (txp *transactionProcessor) processTransaction(apiTx *transaction.ApiTransa
 		PreviousTransactionHash:           prevTxHash,
 		OriginalTransactionHash:           originalTxHash,
 		ReturnMessage:                     apiTx.ReturnMessage,
-		OriginalSender:                    utility.GetAddressOrMetachainAddr(apiTx.OriginalSender),
+		OriginalSender:                    originalSender,
 		Signature:                         signature,
 		SourceShard:                       int32(apiTx.SourceShard),
 		DestinationShard:                  int32(apiTx.DestinationShard),
@@ -148,16 +182,37 @@ func (txp *transactionProcessor) processTransaction(apiTx *transaction.ApiTransa
 		Status:                            apiTx.Status.String(),
 		Tokens:                            apiTx.Tokens,
 		ESDTValues:                        esdtValues,
-		Receivers:                         utility.StringSliceToByteSlice(apiTx.Receivers),
+		Receivers:                         receivers,
 		ReceiversShardIDs:                 utility.UInt32SliceToInt32Slice(apiTx.ReceiversShardIDs),
 		Operation:                         apiTx.Operation,
 		Function:                          apiTx.Function,
 		InitiallyPaidFee:                  initiallyPaidFee,
 		IsRelayed:                         apiTx.IsRelayed,
 		IsRefund:                          apiTx.IsRefund,
+		CallType:                          apiTx.CallType,
+		RelayerAddress:                    relayerAddress,
+		RelayedValue:                      relayedValue,
+		ChainID:                           apiTx.ChainID,
+		Version:                           int32(apiTx.Version),
+		Options:                           int32(apiTx.Options),
 	}, nil
 }
 
+func (txp *transactionProcessor) convertAddresses(addresses []string) ([][]byte, error) {
+	out := make([][]byte, len(addresses))
+
+	for idx, address := range addresses {
+		convertedAddress, err := txp.addressConverter.ConvertAddress(address)
+		if err != nil {
+			return nil, err
+		}
+
+		out[idx] = convertedAddress
+	}
+
+	return out, nil
+}
+
 func receiptOrNil(receipt *schema.Receipt) *schema.Receipt {
 	if receipt == nil {
 		return nil
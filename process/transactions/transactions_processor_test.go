@@ -2,6 +2,7 @@ package transactions
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -96,6 +97,12 @@ func generateApiTx() *transaction.ApiTransactionResult {
 		InitiallyPaidFee:                  testscommon.GenerateRandomBigInt().String(),
 		IsRelayed:                         true,
 		IsRefund:                          true,
+		CallType:                          "directCall",
+		RelayerAddress:                    "erd1ee",
+		RelayedValue:                      testscommon.GenerateRandomBigInt().String(),
+		ChainID:                           "1",
+		Version:                           rand.Uint32(),
+		Options:                           rand.Uint32(),
 	}
 }
 
@@ -115,7 +122,7 @@ func TestNewTransactionProcessor(t *testing.T) {
 	t.Run("nil log processor, should return error", func(t *testing.T) {
 		t.Parallel()
 
-		txp, err := NewTransactionProcessor(nil, &mock.ReceiptHandlerStub{})
+		txp, err := NewTransactionProcessor(nil, &mock.ReceiptHandlerStub{}, utility.NewBech32AddressConverter())
 		require.Nil(t, txp)
 		require.Equal(t, errNilLogProcessor, err)
 	})
@@ -123,15 +130,23 @@ func TestNewTransactionProcessor(t *testing.T) {
 	t.Run("nil receipt processor, should return error", func(t *testing.T) {
 		t.Parallel()
 
-		txp, err := NewTransactionProcessor(&mock.LogHandlerStub{}, nil)
+		txp, err := NewTransactionProcessor(&mock.LogHandlerStub{}, nil, utility.NewBech32AddressConverter())
 		require.Nil(t, txp)
 		require.Equal(t, errNilReceiptProcessor, err)
 	})
 
+	t.Run("nil address converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		txp, err := NewTransactionProcessor(&mock.LogHandlerStub{}, &mock.ReceiptHandlerStub{}, nil)
+		require.Nil(t, txp)
+		require.Equal(t, errNilAddressConverter, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
-		txp, err := NewTransactionProcessor(&mock.LogHandlerStub{}, &mock.ReceiptHandlerStub{})
+		txp, err := NewTransactionProcessor(&mock.LogHandlerStub{}, &mock.ReceiptHandlerStub{}, utility.NewBech32AddressConverter())
 		require.Nil(t, err)
 		require.NotNil(t, txp)
 	})
@@ -142,13 +157,13 @@ func TestTransactionProcessor_ProcessTransactions(t *testing.T) {
 
 	processLogCalledCt := 0
 	logHandler := &mock.LogHandlerStub{
-		ProcessLogCalled: func(log *transaction.ApiLogs) *schema.Log {
+		ProcessLogCalled: func(log *transaction.ApiLogs) (*schema.Log, error) {
 			processLogCalledCt++
 			if log == nil {
-				return nil
+				return nil, nil
 			}
 
-			return &schema.Log{Address: []byte(log.Address)}
+			return &schema.Log{Address: []byte(log.Address)}, nil
 		},
 	}
 	processReceiptCalledCt := 0
@@ -167,7 +182,7 @@ func TestTransactionProcessor_ProcessTransactions(t *testing.T) {
 		},
 	}
 
-	txp, _ := NewTransactionProcessor(logHandler, receiptHandler)
+	txp, _ := NewTransactionProcessor(logHandler, receiptHandler, utility.NewBech32AddressConverter())
 
 	t.Run("should work", func(t *testing.T) {
 		apiTxs := generateApiTxs(10)
@@ -333,6 +348,58 @@ func TestTransactionProcessor_ProcessTransactions(t *testing.T) {
 		require.Nil(t, ret)
 		require.NotNil(t, err)
 	})
+
+	t.Run("invalid relayedValue, should err", func(t *testing.T) {
+		apiTxs := generateApiTxs(3)
+		apiTxs[1].RelayedValue = "invalid"
+		ret, err := txp.ProcessTransactions(apiTxs)
+		require.Nil(t, ret)
+		require.NotNil(t, err)
+	})
+
+	t.Run("empty relayer address, should fill it with nil", func(t *testing.T) {
+		apiTxs := generateApiTxs(1)
+		apiTxs[0].RelayerAddress = ""
+		apiTxs[0].RelayedValue = ""
+		ret, err := txp.ProcessTransactions(apiTxs)
+		require.Nil(t, err)
+		requireTransactionsProcessedSuccessfully(t, apiTxs, ret, logHandler, receiptHandler)
+		require.Nil(t, ret[0].RelayerAddress)
+	})
+
+	t.Run("cannot process log, should err", func(t *testing.T) {
+		errProcessLog := errors.New("error processing log")
+		txpWithInvalidLogs, _ := NewTransactionProcessor(
+			&mock.LogHandlerStub{
+				ProcessLogCalled: func(log *transaction.ApiLogs) (*schema.Log, error) {
+					return nil, errProcessLog
+				},
+			},
+			receiptHandler,
+			utility.NewBech32AddressConverter(),
+		)
+
+		ret, err := txpWithInvalidLogs.ProcessTransactions(generateApiTxs(1))
+		require.Nil(t, ret)
+		require.True(t, errors.Is(err, errProcessLog))
+	})
+
+	t.Run("cannot convert receivers, should err", func(t *testing.T) {
+		apiTxs := generateApiTxs(1)
+		errConvertAddress := errors.New("error converting address")
+		txpWithInvalidAddresses, _ := NewTransactionProcessor(logHandler, receiptHandler, &mock.AddressConverterStub{
+			ConvertAddressCalled: func(address string) ([]byte, error) {
+				if address == apiTxs[0].Receivers[0] {
+					return nil, errConvertAddress
+				}
+				return []byte(address), nil
+			},
+		})
+
+		ret, err := txpWithInvalidAddresses.ProcessTransactions(apiTxs)
+		require.Nil(t, ret)
+		require.Equal(t, errConvertAddress, err)
+	})
 }
 
 func requireTransactionsProcessedSuccessfully(
@@ -382,7 +449,12 @@ func requireTransactionProcessedSuccessfully(
 	require.Nil(t, err)
 	initiallyPaidFee, err := utility.GetBigIntBytesFromStr(apiTx.InitiallyPaidFee)
 	require.Nil(t, err)
-	log := logHandler.ProcessLog(apiTx.Logs)
+	relayedValue, err := utility.GetBigIntBytesFromStr(apiTx.RelayedValue)
+	require.Nil(t, err)
+	log, err := logHandler.ProcessLog(apiTx.Logs)
+	require.Nil(t, err)
+	relayerAddress, err := utility.NewBech32AddressConverter().ConvertAddress(apiTx.RelayerAddress)
+	require.Nil(t, err)
 
 	expectedTx := &schema.Transaction{
 		Type:                              apiTx.Type,
@@ -432,7 +504,84 @@ func requireTransactionProcessedSuccessfully(
 		InitiallyPaidFee:                  initiallyPaidFee,
 		IsRelayed:                         apiTx.IsRelayed,
 		IsRefund:                          apiTx.IsRefund,
+		CallType:                          apiTx.CallType,
+		RelayerAddress:                    relayerAddress,
+		RelayedValue:                      relayedValue,
+		ChainID:                           apiTx.ChainID,
+		Version:                           int32(apiTx.Version),
+		Options:                           int32(apiTx.Options),
 	}
 
 	require.Equal(t, expectedTx, processedTx)
 }
+
+func generateAddress() string {
+	return string(testscommon.GenerateRandomFixedBytes(62))
+}
+
+func TestTransactionProcessor_ProcessTransactions_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	txp, _ := NewTransactionProcessor(&mock.LogHandlerStub{}, &mock.ReceiptHandlerStub{}, utility.NewBech32AddressConverter())
+	avroMarshaller := &utility.AvroMarshaller{}
+
+	t.Run("relayed transaction", func(t *testing.T) {
+		t.Parallel()
+
+		apiTx := generateApiTx()
+		apiTx.Sender = generateAddress()
+		apiTx.Receiver = generateAddress()
+		apiTx.OriginalSender = generateAddress()
+		apiTx.RelayerAddress = generateAddress()
+		apiTx.Receivers = []string{generateAddress()}
+		apiTx.Signature = hex.EncodeToString(testscommon.GenerateRandomFixedBytes(64))
+
+		txs, err := txp.ProcessTransactions([]*transaction.ApiTransactionResult{apiTx})
+		require.Nil(t, err)
+		require.Len(t, txs, 1)
+
+		encodedTx, err := avroMarshaller.Encode(txs[0])
+		require.Nil(t, err)
+
+		decodedTx := schema.NewTransaction()
+		err = avroMarshaller.Decode(decodedTx, encodedTx)
+		require.Nil(t, err)
+
+		require.Equal(t, apiTx.CallType, decodedTx.CallType)
+		require.Equal(t, []byte(apiTx.RelayerAddress), decodedTx.RelayerAddress)
+		require.Equal(t, txs[0].RelayedValue, decodedTx.RelayedValue)
+		require.Equal(t, apiTx.ChainID, decodedTx.ChainID)
+		require.Equal(t, int32(apiTx.Version), decodedTx.Version)
+		require.Equal(t, int32(apiTx.Options), decodedTx.Options)
+	})
+
+	t.Run("non relayed transaction", func(t *testing.T) {
+		t.Parallel()
+
+		apiTx := generateApiTx()
+		apiTx.Sender = generateAddress()
+		apiTx.Receiver = generateAddress()
+		apiTx.OriginalSender = generateAddress()
+		apiTx.RelayerAddress = ""
+		apiTx.RelayedValue = ""
+		apiTx.Receivers = []string{generateAddress()}
+		apiTx.Signature = hex.EncodeToString(testscommon.GenerateRandomFixedBytes(64))
+
+		txs, err := txp.ProcessTransactions([]*transaction.ApiTransactionResult{apiTx})
+		require.Nil(t, err)
+		require.Len(t, txs, 1)
+
+		encodedTx, err := avroMarshaller.Encode(txs[0])
+		require.Nil(t, err)
+
+		decodedTx := schema.NewTransaction()
+		err = avroMarshaller.Decode(decodedTx, encodedTx)
+		require.Nil(t, err)
+
+		require.Nil(t, decodedTx.RelayerAddress)
+		require.Empty(t, decodedTx.RelayedValue)
+		require.Equal(t, apiTx.ChainID, decodedTx.ChainID)
+		require.Equal(t, int32(apiTx.Version), decodedTx.Version)
+		require.Equal(t, int32(apiTx.Options), decodedTx.Options)
+	})
+}
@@ -0,0 +1,92 @@
+package utility
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+type bech32AddressConverter struct {
+}
+
+// NewBech32AddressConverter creates an address converter which outputs the bytes of the bech32 encoded addresses
+func NewBech32AddressConverter() *bech32AddressConverter {
+	return &bech32AddressConverter{}
+}
+
+// ConvertAddress returns the bytes of the provided bech32 address. Empty addresses are converted to nil, while the
+// metachain is converted to a 62 byte array address.
+func (bac *bech32AddressConverter) ConvertAddress(address string) ([]byte, error) {
+	if len(address) == 0 {
+		return nil, nil
+	}
+
+	return GetAddressOrMetachainAddr(address), nil
+}
+
+// DecodeAddress returns the bech32 address held by the provided bytes, or MetachainShardName for the metachain address
+func (bac *bech32AddressConverter) DecodeAddress(address []byte) string {
+	if bytes.Equal(address, MetaChainShardAddress()) {
+		return MetachainShardName
+	}
+
+	return string(address)
+}
+
+// AddressLen returns the length of a bech32 encoded address
+func (bac *bech32AddressConverter) AddressLen() int {
+	return bech32AddressLen
+}
+
+type pubKeyAddressConverter struct {
+	pubKeyConverter core.PubkeyConverter
+}
+
+// NewPubKeyAddressConverter creates an address converter which decodes bech32 addresses into their public keys
+func NewPubKeyAddressConverter(pubKeyConverter core.PubkeyConverter) (*pubKeyAddressConverter, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, errNilPubKeyConverter
+	}
+
+	return &pubKeyAddressConverter{
+		pubKeyConverter: pubKeyConverter,
+	}, nil
+}
+
+// ConvertAddress decodes the provided bech32 address into its public key. Empty addresses are converted to nil, while
+// the metachain is converted to an address having the length of a public key.
+func (pac *pubKeyAddressConverter) ConvertAddress(address string) ([]byte, error) {
+	switch address {
+	case "":
+		return nil, nil
+	case MetachainShardName:
+		return metaChainShardAddressWithLen(pac.pubKeyConverter.Len()), nil
+	default:
+		pubKey, err := pac.pubKeyConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s, err: %v", errInvalidAddress, address, err)
+		}
+
+		return pubKey, nil
+	}
+}
+
+// DecodeAddress encodes the provided public key as a bech32 address, or returns MetachainShardName for the metachain
+// address
+func (pac *pubKeyAddressConverter) DecodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+	if bytes.Equal(address, metaChainShardAddressWithLen(pac.pubKeyConverter.Len())) {
+		return MetachainShardName
+	}
+
+	return pac.pubKeyConverter.Encode(address)
+}
+
+// AddressLen returns the length of a public key
+func (pac *pubKeyAddressConverter) AddressLen() int {
+	return pac.pubKeyConverter.Len()
+}
@@ -0,0 +1,145 @@
+package utility_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/require"
+)
+
+const pubKeyLen = 32
+
+func TestBech32AddressConverter_ConvertAddress(t *testing.T) {
+	t.Parallel()
+
+	converter := utility.NewBech32AddressConverter()
+
+	t.Run("empty address", func(t *testing.T) {
+		t.Parallel()
+
+		address, err := converter.ConvertAddress("")
+		require.Nil(t, err)
+		require.Nil(t, address)
+	})
+
+	t.Run("metachain address", func(t *testing.T) {
+		t.Parallel()
+
+		address, err := converter.ConvertAddress(utility.MetachainShardName)
+		require.Nil(t, err)
+		require.Equal(t, utility.MetaChainShardAddress(), address)
+	})
+
+	t.Run("bech32 address", func(t *testing.T) {
+		t.Parallel()
+
+		bech32Address := "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+		address, err := converter.ConvertAddress(bech32Address)
+		require.Nil(t, err)
+		require.Equal(t, []byte(bech32Address), address)
+	})
+}
+
+func TestBech32AddressConverter_DecodeAddress(t *testing.T) {
+	t.Parallel()
+
+	converter := utility.NewBech32AddressConverter()
+
+	bech32Address := "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+	address, _ := converter.ConvertAddress(bech32Address)
+	require.Equal(t, bech32Address, converter.DecodeAddress(address))
+
+	address, _ = converter.ConvertAddress(utility.MetachainShardName)
+	require.Equal(t, utility.MetachainShardName, converter.DecodeAddress(address))
+
+	require.Empty(t, converter.DecodeAddress(nil))
+}
+
+func TestNewPubKeyAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := utility.NewPubKeyAddressConverter(nil)
+		require.Nil(t, converter)
+		require.Equal(t, utility.ErrNilPubKeyConverter, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := utility.NewPubKeyAddressConverter(createBech32PubKeyConverter(t))
+		require.Nil(t, err)
+		require.NotNil(t, converter)
+	})
+}
+
+func TestPubKeyAddressConverter_ConvertAddress(t *testing.T) {
+	t.Parallel()
+
+	bech32PubKeyConverter := createBech32PubKeyConverter(t)
+	converter, _ := utility.NewPubKeyAddressConverter(bech32PubKeyConverter)
+
+	t.Run("empty address", func(t *testing.T) {
+		t.Parallel()
+
+		address, err := converter.ConvertAddress("")
+		require.Nil(t, err)
+		require.Nil(t, address)
+	})
+
+	t.Run("metachain address", func(t *testing.T) {
+		t.Parallel()
+
+		address, err := converter.ConvertAddress(utility.MetachainShardName)
+		require.Nil(t, err)
+		require.Len(t, address, pubKeyLen)
+		require.Equal(t, utility.MetaChainShardAddress()[:pubKeyLen], address)
+	})
+
+	t.Run("invalid address, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		address, err := converter.ConvertAddress("erd1invalid")
+		require.Nil(t, address)
+		require.True(t, errors.Is(err, utility.ErrInvalidAddress))
+	})
+
+	t.Run("bech32 address", func(t *testing.T) {
+		t.Parallel()
+
+		pubKey := []byte("12345678901234567890123456789012")
+		bech32Address := bech32PubKeyConverter.Encode(pubKey)
+
+		address, err := converter.ConvertAddress(bech32Address)
+		require.Nil(t, err)
+		require.Equal(t, pubKey, address)
+	})
+}
+
+func TestPubKeyAddressConverter_DecodeAddress(t *testing.T) {
+	t.Parallel()
+
+	bech32PubKeyConverter := createBech32PubKeyConverter(t)
+	converter, _ := utility.NewPubKeyAddressConverter(bech32PubKeyConverter)
+
+	bech32Address := bech32PubKeyConverter.Encode([]byte("12345678901234567890123456789012"))
+	address, _ := converter.ConvertAddress(bech32Address)
+	require.Equal(t, bech32Address, converter.DecodeAddress(address))
+
+	address, _ = converter.ConvertAddress(utility.MetachainShardName)
+	require.Equal(t, utility.MetachainShardName, converter.DecodeAddress(address))
+
+	require.Empty(t, converter.DecodeAddress(nil))
+}
+
+func createBech32PubKeyConverter(t *testing.T) core.PubkeyConverter {
+	converter, err := pubkeyConverter.NewBech32PubkeyConverter(pubKeyLen, logger.GetOrCreate("test"))
+	require.Nil(t, err)
+	return converter
+}
@@ -0,0 +1,349 @@
+package utility
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+
+	"github.com/elodina/go-avro"
+	"github.com/golang/snappy"
+)
+
+const (
+	// CodecNull defines an avro object container file with uncompressed blocks
+	CodecNull = "null"
+	// CodecDeflate defines an avro object container file with blocks compressed using deflate(RFC 1951)
+	CodecDeflate = "deflate"
+	// CodecSnappy defines an avro object container file with blocks compressed using snappy, each followed by the
+	// 4-byte, big-endian CRC32 checksum of the uncompressed data
+	CodecSnappy = "snappy"
+)
+
+const (
+	containerSchemaKey = "avro.schema"
+	containerCodecKey  = "avro.codec"
+	containerSyncSize  = 16
+	snappyChecksumSize = 4
+)
+
+var containerMagic = []byte{'O', 'b', 'j', 1}
+
+// IsContainerCodecSupported checks if the provided codec can be used to write avro object container files
+func IsContainerCodecSupported(codec string) bool {
+	switch codec {
+	case CodecNull, CodecDeflate, CodecSnappy:
+		return true
+	default:
+		return false
+	}
+}
+
+// AvroContainerWriter can write already encoded avro records in an avro object container file.
+// Spec: https://avro.apache.org/docs/1.11.1/specification/#object-container-files
+type AvroContainerWriter struct {
+	output           io.Writer
+	encoder          *avro.BinaryEncoder
+	codec            string
+	sync             []byte
+	block            *bytes.Buffer
+	blockCount       int64
+	wireFormatHeader []byte
+}
+
+// NewContainerWriter will create an avro object container file writer, which will embed the provided schema definition
+// in the file header and will compress all data blocks with the provided codec
+func (av *AvroMarshaller) NewContainerWriter(output io.Writer, schemaDefinition string, codec string) (*AvroContainerWriter, error) {
+	if !IsContainerCodecSupported(codec) {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedContainerCodec, codec)
+	}
+
+	sync := make([]byte, containerSyncSize)
+	_, err := rand.Read(sync)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &AvroContainerWriter{
+		output:           output,
+		encoder:          avro.NewBinaryEncoder(output),
+		codec:            codec,
+		sync:             sync,
+		block:            &bytes.Buffer{},
+		wireFormatHeader: av.wireFormatHeader,
+	}
+
+	err = writer.writeHeader(schemaDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+func (acw *AvroContainerWriter) writeHeader(schemaDefinition string) error {
+	_, err := acw.output.Write(containerMagic)
+	if err != nil {
+		return err
+	}
+
+	acw.encoder.WriteMapStart(2)
+	acw.encoder.WriteString(containerSchemaKey)
+	acw.encoder.WriteBytes([]byte(schemaDefinition))
+	acw.encoder.WriteString(containerCodecKey)
+	acw.encoder.WriteBytes([]byte(acw.codec))
+	acw.encoder.WriteMapNext(0)
+
+	_, err = acw.output.Write(acw.sync)
+	return err
+}
+
+// Append will buffer the provided avro binary encoded record in the current data block. Records encoded in the schema
+// registry wire format are stripped of their header, since container files only hold avro payloads.
+// Buffered records are not written to the output until Flush or Close is called.
+func (acw *AvroContainerWriter) Append(encodedRecord []byte) error {
+	payload, err := removeWireFormatHeader(acw.wireFormatHeader, encodedRecord)
+	if err != nil {
+		return err
+	}
+
+	_, err = acw.block.Write(payload)
+	if err != nil {
+		return err
+	}
+
+	acw.blockCount++
+	return nil
+}
+
+// Flush will write all buffered records to the output as a single compressed data block.
+// It does nothing if no records have been appended since the last flush.
+func (acw *AvroContainerWriter) Flush() error {
+	if acw.blockCount == 0 {
+		return nil
+	}
+
+	compressedBlock, err := compressBlock(acw.codec, acw.block.Bytes())
+	if err != nil {
+		return err
+	}
+
+	acw.encoder.WriteLong(acw.blockCount)
+	acw.encoder.WriteLong(int64(len(compressedBlock)))
+	_, err = acw.output.Write(compressedBlock)
+	if err != nil {
+		return err
+	}
+	_, err = acw.output.Write(acw.sync)
+	if err != nil {
+		return err
+	}
+
+	acw.block.Reset()
+	acw.blockCount = 0
+	return nil
+}
+
+// Close will flush all remaining buffered records. The writer should not be used afterwards.
+func (acw *AvroContainerWriter) Close() error {
+	return acw.Flush()
+}
+
+func compressBlock(codec string, block []byte) ([]byte, error) {
+	switch codec {
+	case CodecNull:
+		return block, nil
+	case CodecDeflate:
+		buffer := &bytes.Buffer{}
+		writer, err := flate.NewWriter(buffer, flate.DefaultCompression)
+		if err != nil {
+			return nil, err
+		}
+		_, err = writer.Write(block)
+		if err != nil {
+			return nil, err
+		}
+		err = writer.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		return buffer.Bytes(), nil
+	case CodecSnappy:
+		compressed := snappy.Encode(nil, block)
+		checksum := make([]byte, snappyChecksumSize)
+		binary.BigEndian.PutUint32(checksum, crc32.ChecksumIEEE(block))
+
+		return append(compressed, checksum...), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedContainerCodec, codec)
+	}
+}
+
+func decompressBlock(codec string, block []byte) ([]byte, error) {
+	switch codec {
+	case CodecNull:
+		return block, nil
+	case CodecDeflate:
+		reader := flate.NewReader(bytes.NewReader(block))
+		defer func() {
+			_ = reader.Close()
+		}()
+
+		return ioutil.ReadAll(reader)
+	case CodecSnappy:
+		if len(block) < snappyChecksumSize {
+			return nil, errInvalidContainerBlock
+		}
+
+		checksumIdx := len(block) - snappyChecksumSize
+		decompressed, err := snappy.Decode(nil, block[:checksumIdx])
+		if err != nil {
+			return nil, err
+		}
+		if crc32.ChecksumIEEE(decompressed) != binary.BigEndian.Uint32(block[checksumIdx:]) {
+			return nil, errInvalidContainerBlock
+		}
+
+		return decompressed, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedContainerCodec, codec)
+	}
+}
+
+// EncodeContainer returns an avro object container file, having the provided schema definition in its header and
+// one record for each of the provided avro binary encoded records
+func (av *AvroMarshaller) EncodeContainer(schemaDefinition string, codec string, encodedRecords [][]byte) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	writer, err := av.NewContainerWriter(buffer, schemaDefinition, codec)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, encodedRecord := range encodedRecords {
+		err = writer.Append(encodedRecord)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// DecodeContainer tries to decode all records from an avro object container file. For each record found, createRecord
+// is called to provide an empty record, which is then filled with data from the container, using the same schema as
+// Decode.
+func (av *AvroMarshaller) DecodeContainer(buffer []byte, createRecord func() avro.AvroRecord) ([]avro.AvroRecord, error) {
+	decoder := avro.NewBinaryDecoder(buffer)
+	header := &containerHeader{}
+	err := readContainerHeader(decoder, header)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header.Magic, containerMagic) {
+		return nil, errInvalidContainerHeader
+	}
+
+	codec := string(header.Meta[containerCodecKey])
+	if len(codec) == 0 {
+		codec = CodecNull
+	}
+
+	records := make([]avro.AvroRecord, 0)
+	for decoder.Tell() < int64(len(buffer)) {
+		blockRecords, errBlock := av.decodeContainerBlock(decoder, int64(len(buffer)), header.Sync, codec, createRecord)
+		if errBlock != nil {
+			return nil, errBlock
+		}
+
+		records = append(records, blockRecords...)
+	}
+
+	return records, nil
+}
+
+func (av *AvroMarshaller) decodeContainerBlock(
+	decoder *avro.BinaryDecoder,
+	bufferSize int64,
+	sync []byte,
+	codec string,
+	createRecord func() avro.AvroRecord,
+) ([]avro.AvroRecord, error) {
+	blockCount, err := decoder.ReadLong()
+	if err != nil {
+		return nil, err
+	}
+	blockSize, err := decoder.ReadLong()
+	if err != nil {
+		return nil, err
+	}
+	if blockCount < 0 || blockSize < 0 || blockSize > bufferSize-decoder.Tell() {
+		return nil, errInvalidContainerBlock
+	}
+
+	compressedBlock := make([]byte, blockSize)
+	err = decoder.ReadFixed(compressedBlock)
+	if err != nil {
+		return nil, err
+	}
+	blockSync := make([]byte, containerSyncSize)
+	err = decoder.ReadFixed(blockSync)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(sync, blockSync) {
+		return nil, errInvalidContainerBlock
+	}
+
+	block, err := decompressBlock(codec, compressedBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	blockDecoder := avro.NewBinaryDecoder(block)
+	records := make([]avro.AvroRecord, 0, blockCount)
+	for i := int64(0); i < blockCount; i++ {
+		record := createRecord()
+		reader := avro.NewSpecificDatumReader()
+		reader.SetSchema(av.getSchema(record))
+
+		err = reader.Read(record, blockDecoder)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+type containerHeader struct {
+	Magic []byte            `avro:"magic"`
+	Meta  map[string][]byte `avro:"meta"`
+	Sync  []byte            `avro:"sync"`
+}
+
+var containerHeaderSchema = avro.MustParseSchema(`{"type": "record", "name": "org.apache.avro.file.Header",
+ "fields" : [
+   {"name": "magic", "type": {"type": "fixed", "name": "Magic", "size": 4}},
+   {"name": "meta", "type": {"type": "map", "values": "bytes"}},
+   {"name": "sync", "type": {"type": "fixed", "name": "Sync", "size": 16}}
+  ]
+}`)
+
+func readContainerHeader(decoder *avro.BinaryDecoder, header *containerHeader) error {
+	reader := avro.NewSpecificDatumReader()
+	reader.SetSchema(containerHeaderSchema)
+
+	return reader.Read(header, decoder)
+}
@@ -0,0 +1,190 @@
+package utility_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/elodina/go-avro"
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createHyperBlock(nonce int64) *schema.HyperBlock {
+	hyperBlock := schema.NewHyperBlock()
+	hyperBlock.Hash = testscommon.GenerateRandomFixedBytes(32)
+	hyperBlock.PrevBlockHash = testscommon.GenerateRandomFixedBytes(32)
+	hyperBlock.Nonce = nonce
+	hyperBlock.Status = "on-chain"
+
+	return hyperBlock
+}
+
+func encodeHyperBlocks(t *testing.T, hyperBlocks []*schema.HyperBlock) [][]byte {
+	encodedHyperBlocks := make([][]byte, 0, len(hyperBlocks))
+	for _, hyperBlock := range hyperBlocks {
+		encodedHyperBlock, err := testAvroMarshaller.Encode(hyperBlock)
+		require.Nil(t, err)
+
+		encodedHyperBlocks = append(encodedHyperBlocks, encodedHyperBlock)
+	}
+
+	return encodedHyperBlocks
+}
+
+func createEmptyHyperBlock() avro.AvroRecord {
+	return schema.NewHyperBlock()
+}
+
+func TestIsContainerCodecSupported(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, utility.IsContainerCodecSupported(utility.CodecNull))
+	require.True(t, utility.IsContainerCodecSupported(utility.CodecDeflate))
+	require.True(t, utility.IsContainerCodecSupported(utility.CodecSnappy))
+	require.False(t, utility.IsContainerCodecSupported("zstandard"))
+	require.False(t, utility.IsContainerCodecSupported(""))
+}
+
+func TestAvroMarshaller_EncodeDecodeContainer(t *testing.T) {
+	t.Parallel()
+
+	hyperBlocks := []*schema.HyperBlock{createHyperBlock(4), createHyperBlock(5), createHyperBlock(6)}
+	encodedHyperBlocks := encodeHyperBlocks(t, hyperBlocks)
+
+	for _, codec := range []string{utility.CodecNull, utility.CodecDeflate, utility.CodecSnappy} {
+		codec := codec
+		t.Run(codec, func(t *testing.T) {
+			t.Parallel()
+
+			container, err := testAvroMarshaller.EncodeContainer(schema.HyperBlockSchemaDefinition, codec, encodedHyperBlocks)
+			require.Nil(t, err)
+			require.True(t, bytes.HasPrefix(container, []byte("Obj\x01")))
+			require.True(t, bytes.Contains(container, []byte(schema.HyperBlockSchemaDefinition)))
+			require.True(t, bytes.Contains(container, []byte(codec)))
+
+			records, err := testAvroMarshaller.DecodeContainer(container, createEmptyHyperBlock)
+			require.Nil(t, err)
+			require.Len(t, records, len(hyperBlocks))
+			for idx, record := range records {
+				require.Equal(t, hyperBlocks[idx], record)
+			}
+		})
+	}
+}
+
+func TestAvroMarshaller_EncodeDecodeContainer_NoRecords(t *testing.T) {
+	t.Parallel()
+
+	container, err := testAvroMarshaller.EncodeContainer(schema.HyperBlockSchemaDefinition, utility.CodecDeflate, nil)
+	require.Nil(t, err)
+
+	records, err := testAvroMarshaller.DecodeContainer(container, createEmptyHyperBlock)
+	require.Nil(t, err)
+	require.Empty(t, records)
+}
+
+func TestAvroMarshaller_EncodeDecodeContainer_PubKeySchema(t *testing.T) {
+	t.Parallel()
+
+	pubKeyAvroMarshaller, err := utility.NewAvroMarshallerWithSchema(schema.HyperBlockPubKeySchemaDefinition)
+	require.Nil(t, err)
+
+	tx := schema.NewTransaction()
+	tx.Sender = testscommon.GenerateRandomFixedBytes(32)
+	tx.Receiver = testscommon.GenerateRandomFixedBytes(32)
+	hyperBlock := createHyperBlock(4)
+	hyperBlock.Transactions = []*schema.Transaction{tx}
+	encodedHyperBlock, err := pubKeyAvroMarshaller.Encode(hyperBlock)
+	require.Nil(t, err)
+
+	container, err := pubKeyAvroMarshaller.EncodeContainer(schema.HyperBlockPubKeySchemaDefinition, utility.CodecDeflate, [][]byte{encodedHyperBlock})
+	require.Nil(t, err)
+
+	records, err := pubKeyAvroMarshaller.DecodeContainer(container, createEmptyHyperBlock)
+	require.Nil(t, err)
+	require.Equal(t, []avro.AvroRecord{hyperBlock}, records)
+}
+
+func TestAvroMarshaller_NewContainerWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unsupported codec, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		writer, err := testAvroMarshaller.NewContainerWriter(&bytes.Buffer{}, schema.HyperBlockSchemaDefinition, "zstandard")
+		require.Nil(t, writer)
+		require.ErrorIs(t, err, utility.ErrUnsupportedContainerCodec)
+	})
+
+	t.Run("multiple flushes, should write one block per flush", func(t *testing.T) {
+		t.Parallel()
+
+		hyperBlocks := []*schema.HyperBlock{createHyperBlock(4), createHyperBlock(5), createHyperBlock(6)}
+		encodedHyperBlocks := encodeHyperBlocks(t, hyperBlocks)
+
+		buffer := &bytes.Buffer{}
+		writer, err := testAvroMarshaller.NewContainerWriter(buffer, schema.HyperBlockSchemaDefinition, utility.CodecSnappy)
+		require.Nil(t, err)
+
+		for _, encodedHyperBlock := range encodedHyperBlocks {
+			err = writer.Append(encodedHyperBlock)
+			require.Nil(t, err)
+			err = writer.Flush()
+			require.Nil(t, err)
+		}
+		err = writer.Close()
+		require.Nil(t, err)
+
+		records, err := testAvroMarshaller.DecodeContainer(buffer.Bytes(), createEmptyHyperBlock)
+		require.Nil(t, err)
+		require.Len(t, records, len(hyperBlocks))
+		for idx, record := range records {
+			require.Equal(t, hyperBlocks[idx], record)
+		}
+	})
+}
+
+func TestAvroMarshaller_DecodeContainer_InvalidData(t *testing.T) {
+	t.Parallel()
+
+	encodedHyperBlocks := encodeHyperBlocks(t, []*schema.HyperBlock{createHyperBlock(4)})
+
+	t.Run("invalid magic, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		container, err := testAvroMarshaller.EncodeContainer(schema.HyperBlockSchemaDefinition, utility.CodecNull, encodedHyperBlocks)
+		require.Nil(t, err)
+		container[0] = 'X'
+
+		records, err := testAvroMarshaller.DecodeContainer(container, createEmptyHyperBlock)
+		require.Nil(t, records)
+		require.Equal(t, utility.ErrInvalidContainerHeader, err)
+	})
+
+	t.Run("invalid block sync marker, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		container, err := testAvroMarshaller.EncodeContainer(schema.HyperBlockSchemaDefinition, utility.CodecNull, encodedHyperBlocks)
+		require.Nil(t, err)
+		container[len(container)-1]++
+
+		records, err := testAvroMarshaller.DecodeContainer(container, createEmptyHyperBlock)
+		require.Nil(t, records)
+		require.Equal(t, utility.ErrInvalidContainerBlock, err)
+	})
+
+	t.Run("invalid snappy checksum, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		container, err := testAvroMarshaller.EncodeContainer(schema.HyperBlockSchemaDefinition, utility.CodecSnappy, encodedHyperBlocks)
+		require.Nil(t, err)
+		checksumLastByteIdx := len(container) - 16 - 1
+		container[checksumLastByteIdx]++
+
+		records, err := testAvroMarshaller.DecodeContainer(container, createEmptyHyperBlock)
+		require.Nil(t, records)
+		require.Equal(t, utility.ErrInvalidContainerBlock, err)
+	})
+}
@@ -2,20 +2,93 @@ package utility
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
 
 	"github.com/elodina/go-avro"
 )
 
+const (
+	wireFormatMagicByte  = byte(0)
+	wireFormatHeaderSize = 5
+)
+
 // AvroMarshaller can marshall/unmarshall avro records
 type AvroMarshaller struct {
+	schema           avro.Schema
+	wireFormatHeader []byte
+}
+
+// ArgsAvroMarshallerWithSchemaRegistry holds all input dependencies required by an avro marshaller using the schema
+// registry wire format
+type ArgsAvroMarshallerWithSchemaRegistry struct {
+	SchemaDefinition string
+	SchemaRegistry   SchemaRegistryClient
+	Subject          string
+}
+
+// NewAvroMarshallerWithSchema creates an avro marshaller which encodes and decodes all records using the provided
+// schema definition, instead of the schema of each record. This is needed to encode records with a schema variant,
+// having the same fields as the record.
+func NewAvroMarshallerWithSchema(schemaDefinition string) (*AvroMarshaller, error) {
+	schema, err := avro.ParseSchema(schemaDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvroMarshaller{
+		schema: schema,
+	}, nil
+}
+
+// NewAvroMarshallerWithSchemaRegistry creates an avro marshaller which encodes and decodes all records using the
+// provided schema definition, in the confluent schema registry wire format: each encoded record is prefixed with a
+// zero magic byte and the 4-byte, big-endian id of the schema, as registered under the provided subject. The schema id
+// is looked up once, at creation
+func NewAvroMarshallerWithSchemaRegistry(args ArgsAvroMarshallerWithSchemaRegistry) (*AvroMarshaller, error) {
+	if args.SchemaRegistry == nil {
+		return nil, errNilSchemaRegistry
+	}
+	if len(args.Subject) == 0 {
+		return nil, errEmptySchemaSubject
+	}
+
+	marshaller, err := NewAvroMarshallerWithSchema(args.SchemaDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	schemaId, err := args.SchemaRegistry.GetSchemaId(context.Background(), args.Subject, args.SchemaDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaller.wireFormatHeader = make([]byte, wireFormatHeaderSize)
+	marshaller.wireFormatHeader[0] = wireFormatMagicByte
+	binary.BigEndian.PutUint32(marshaller.wireFormatHeader[1:], schemaId)
+
+	return marshaller, nil
 }
 
-// Encode returns a byte slice representing the binary encoding of the input avro record
+// SchemaId returns the schema registry id of the schema, prefixed to each encoded record, and whether the schema
+// registry wire format is used
+func (av *AvroMarshaller) SchemaId() (uint32, bool) {
+	if len(av.wireFormatHeader) == 0 {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint32(av.wireFormatHeader[1:]), true
+}
+
+// Encode returns a byte slice representing the binary encoding of the input avro record, prefixed with the schema
+// registry wire format header, if used
 func (av *AvroMarshaller) Encode(record avro.AvroRecord) ([]byte, error) {
 	writer := avro.NewSpecificDatumWriter()
-	writer.SetSchema(record.Schema())
+	writer.SetSchema(av.getSchema(record))
 
 	buffer := new(bytes.Buffer)
+	buffer.Write(av.wireFormatHeader)
 	encoder := avro.NewBinaryEncoder(buffer)
 
 	err := writer.Write(record, encoder)
@@ -27,11 +100,54 @@ func (av *AvroMarshaller) Encode(record avro.AvroRecord) ([]byte, error) {
 }
 
 // Decode tries to decode a data buffer, read it and store it on the input record.
-// If successfully, the record is filled with data from the buffer, otherwise an error might be returned
+// If successfully, the record is filled with data from the buffer, otherwise an error might be returned.
+// If the schema registry wire format is used, the buffer should be prefixed with the header of the same schema id
 func (av *AvroMarshaller) Decode(record avro.AvroRecord, buffer []byte) error {
+	payload, err := removeWireFormatHeader(av.wireFormatHeader, buffer)
+	if err != nil {
+		return err
+	}
+
 	reader := avro.NewSpecificDatumReader()
-	reader.SetSchema(record.Schema())
+	reader.SetSchema(av.getSchema(record))
 
-	decoder := avro.NewBinaryDecoder(buffer)
+	decoder := avro.NewBinaryDecoder(payload)
 	return reader.Read(record, decoder)
 }
+
+// removeWireFormatHeader returns the avro payload of a record encoded with the provided wire format header. If no
+// header is provided, the record is returned as it is
+func removeWireFormatHeader(wireFormatHeader []byte, encodedRecord []byte) ([]byte, error) {
+	if len(wireFormatHeader) == 0 {
+		return encodedRecord, nil
+	}
+
+	payload, schemaId, err := RemoveWireFormatHeader(encodedRecord)
+	if err != nil {
+		return nil, err
+	}
+	expectedSchemaId := binary.BigEndian.Uint32(wireFormatHeader[1:])
+	if schemaId != expectedSchemaId {
+		return nil, fmt.Errorf("%w: expected %d, got %d", errUnexpectedSchemaId, expectedSchemaId, schemaId)
+	}
+
+	return payload, nil
+}
+
+// RemoveWireFormatHeader returns the avro payload of a record encoded in the schema registry wire format, along with
+// the schema id of its header
+func RemoveWireFormatHeader(encodedRecord []byte) ([]byte, uint32, error) {
+	if len(encodedRecord) < wireFormatHeaderSize || encodedRecord[0] != wireFormatMagicByte {
+		return nil, 0, ErrInvalidWireFormat
+	}
+
+	return encodedRecord[wireFormatHeaderSize:], binary.BigEndian.Uint32(encodedRecord[1:wireFormatHeaderSize]), nil
+}
+
+func (av *AvroMarshaller) getSchema(record avro.AvroRecord) avro.Schema {
+	if av.schema != nil {
+		return av.schema
+	}
+
+	return record.Schema()
+}
@@ -0,0 +1,180 @@
+package utility_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-covalent-go/process/utility"
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsAvroMarshallerWithSchemaRegistry(schemaId uint32) utility.ArgsAvroMarshallerWithSchemaRegistry {
+	return utility.ArgsAvroMarshallerWithSchemaRegistry{
+		SchemaDefinition: schema.HyperBlockSchemaDefinition,
+		SchemaRegistry: &mock.SchemaRegistryClientStub{
+			GetSchemaIdCalled: func(ctx context.Context, subject string, schemaDefinition string) (uint32, error) {
+				return schemaId, nil
+			},
+		},
+		Subject: "hyperblocks-value",
+	}
+}
+
+func TestNewAvroMarshallerWithSchemaRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAvroMarshallerWithSchemaRegistry(7)
+		getSchemaIdCalled := false
+		args.SchemaRegistry = &mock.SchemaRegistryClientStub{
+			GetSchemaIdCalled: func(ctx context.Context, subject string, schemaDefinition string) (uint32, error) {
+				require.Equal(t, "hyperblocks-value", subject)
+				require.Equal(t, schema.HyperBlockSchemaDefinition, schemaDefinition)
+				getSchemaIdCalled = true
+				return 7, nil
+			},
+		}
+
+		marshaller, err := utility.NewAvroMarshallerWithSchemaRegistry(args)
+		require.Nil(t, err)
+		require.True(t, getSchemaIdCalled)
+
+		schemaId, isWireFormat := marshaller.SchemaId()
+		require.True(t, isWireFormat)
+		require.Equal(t, uint32(7), schemaId)
+	})
+
+	t.Run("nil schema registry, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAvroMarshallerWithSchemaRegistry(7)
+		args.SchemaRegistry = nil
+		marshaller, err := utility.NewAvroMarshallerWithSchemaRegistry(args)
+		require.Nil(t, marshaller)
+		require.Equal(t, utility.ErrNilSchemaRegistry, err)
+	})
+
+	t.Run("empty subject, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAvroMarshallerWithSchemaRegistry(7)
+		args.Subject = ""
+		marshaller, err := utility.NewAvroMarshallerWithSchemaRegistry(args)
+		require.Nil(t, marshaller)
+		require.Equal(t, utility.ErrEmptySchemaSubject, err)
+	})
+
+	t.Run("invalid schema definition, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAvroMarshallerWithSchemaRegistry(7)
+		args.SchemaDefinition = "invalid"
+		marshaller, err := utility.NewAvroMarshallerWithSchemaRegistry(args)
+		require.Nil(t, marshaller)
+		require.NotNil(t, err)
+	})
+
+	t.Run("could not get schema id, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errRegistry := errors.New("registry error")
+		args := createMockArgsAvroMarshallerWithSchemaRegistry(7)
+		args.SchemaRegistry = &mock.SchemaRegistryClientStub{
+			GetSchemaIdCalled: func(ctx context.Context, subject string, schemaDefinition string) (uint32, error) {
+				return 0, errRegistry
+			},
+		}
+		marshaller, err := utility.NewAvroMarshallerWithSchemaRegistry(args)
+		require.Nil(t, marshaller)
+		require.Equal(t, errRegistry, err)
+	})
+}
+
+func TestAvroMarshaller_SchemaId(t *testing.T) {
+	t.Parallel()
+
+	schemaId, isWireFormat := testAvroMarshaller.SchemaId()
+	require.False(t, isWireFormat)
+	require.Zero(t, schemaId)
+}
+
+func TestAvroMarshaller_EncodeDecodeWireFormat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should prefix payload with magic byte and schema id", func(t *testing.T) {
+		t.Parallel()
+
+		marshaller, _ := utility.NewAvroMarshallerWithSchemaRegistry(createMockArgsAvroMarshallerWithSchemaRegistry(0x01020304))
+		hyperBlock := createHyperBlock(4)
+
+		encoded, err := marshaller.Encode(hyperBlock)
+		require.Nil(t, err)
+		payload, err := testAvroMarshaller.Encode(hyperBlock)
+		require.Nil(t, err)
+		require.Equal(t, append([]byte{0, 1, 2, 3, 4}, payload...), encoded)
+
+		decoded := schema.NewHyperBlock()
+		err = marshaller.Decode(decoded, encoded)
+		require.Nil(t, err)
+		require.Equal(t, hyperBlock, decoded)
+	})
+
+	t.Run("invalid wire format, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		marshaller, _ := utility.NewAvroMarshallerWithSchemaRegistry(createMockArgsAvroMarshallerWithSchemaRegistry(7))
+		payload, err := testAvroMarshaller.Encode(createHyperBlock(4))
+		require.Nil(t, err)
+
+		err = marshaller.Decode(schema.NewHyperBlock(), []byte{0, 0})
+		require.Equal(t, utility.ErrInvalidWireFormat, err)
+
+		err = marshaller.Decode(schema.NewHyperBlock(), append([]byte{1, 0, 0, 0, 7}, payload...))
+		require.Equal(t, utility.ErrInvalidWireFormat, err)
+	})
+
+	t.Run("unexpected schema id, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		marshaller, _ := utility.NewAvroMarshallerWithSchemaRegistry(createMockArgsAvroMarshallerWithSchemaRegistry(7))
+		otherMarshaller, _ := utility.NewAvroMarshallerWithSchemaRegistry(createMockArgsAvroMarshallerWithSchemaRegistry(8))
+		encoded, err := otherMarshaller.Encode(createHyperBlock(4))
+		require.Nil(t, err)
+
+		err = marshaller.Decode(schema.NewHyperBlock(), encoded)
+		require.ErrorIs(t, err, utility.ErrUnexpectedSchemaId)
+	})
+
+	t.Run("container files should hold raw avro payloads", func(t *testing.T) {
+		t.Parallel()
+
+		marshaller, _ := utility.NewAvroMarshallerWithSchemaRegistry(createMockArgsAvroMarshallerWithSchemaRegistry(7))
+		hyperBlocks := []*schema.HyperBlock{createHyperBlock(4), createHyperBlock(5)}
+		buffer := &bytes.Buffer{}
+		writer, err := marshaller.NewContainerWriter(buffer, schema.HyperBlockSchemaDefinition, utility.CodecNull)
+		require.Nil(t, err)
+		for _, hyperBlock := range hyperBlocks {
+			encoded, errEncode := marshaller.Encode(hyperBlock)
+			require.Nil(t, errEncode)
+			err = writer.Append(encoded)
+			require.Nil(t, err)
+		}
+		require.Nil(t, writer.Close())
+
+		records, err := testAvroMarshaller.DecodeContainer(buffer.Bytes(), createEmptyHyperBlock)
+		require.Nil(t, err)
+		require.Len(t, records, len(hyperBlocks))
+		for idx, record := range records {
+			require.Equal(t, hyperBlocks[idx], record)
+		}
+
+		err = writer.Append([]byte{1, 2})
+		require.Equal(t, utility.ErrInvalidWireFormat, err)
+	})
+}
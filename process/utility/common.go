@@ -2,3 +2,6 @@ package utility
 
 // MetachainShardName is the string identifier of the metachain shard
 const MetachainShardName = "metachain"
+
+// bech32AddressLen is the length of an address as bytes of its bech32 encoded string
+const bech32AddressLen = 62
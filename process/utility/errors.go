@@ -3,3 +3,22 @@ package utility
 import "errors"
 
 var errInvalidValueInBase10 = errors.New("invalid value in base 10")
+
+var errUnsupportedContainerCodec = errors.New("unsupported avro container codec")
+
+var errInvalidContainerHeader = errors.New("invalid avro container header")
+
+var errInvalidContainerBlock = errors.New("invalid avro container block")
+
+var errNilPubKeyConverter = errors.New("nil public key converter provided")
+
+var errInvalidAddress = errors.New("invalid address")
+
+var errNilSchemaRegistry = errors.New("nil schema registry client provided")
+
+var errEmptySchemaSubject = errors.New("empty schema subject provided")
+
+// ErrInvalidWireFormat signals that an encoded record is not prefixed with a schema registry wire format header
+var ErrInvalidWireFormat = errors.New("invalid schema registry wire format")
+
+var errUnexpectedSchemaId = errors.New("unexpected schema id")
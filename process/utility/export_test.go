@@ -2,3 +2,27 @@ package utility
 
 // ErrInvalidValueInBase10 -
 var ErrInvalidValueInBase10 = errInvalidValueInBase10
+
+// ErrUnsupportedContainerCodec -
+var ErrUnsupportedContainerCodec = errUnsupportedContainerCodec
+
+// ErrInvalidContainerHeader -
+var ErrInvalidContainerHeader = errInvalidContainerHeader
+
+// ErrInvalidContainerBlock -
+var ErrInvalidContainerBlock = errInvalidContainerBlock
+
+// ErrNilPubKeyConverter -
+var ErrNilPubKeyConverter = errNilPubKeyConverter
+
+// ErrInvalidAddress -
+var ErrInvalidAddress = errInvalidAddress
+
+// ErrNilSchemaRegistry -
+var ErrNilSchemaRegistry = errNilSchemaRegistry
+
+// ErrEmptySchemaSubject -
+var ErrEmptySchemaSubject = errEmptySchemaSubject
+
+// ErrUnexpectedSchemaId -
+var ErrUnexpectedSchemaId = errUnexpectedSchemaId
@@ -0,0 +1,8 @@
+package utility
+
+import "context"
+
+// SchemaRegistryClient should provide the id of a schema registered under a subject of a schema registry
+type SchemaRegistryClient interface {
+	GetSchemaId(ctx context.Context, subject string, schemaDefinition string) (uint32, error)
+}
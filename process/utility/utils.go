@@ -92,7 +92,11 @@ func GetAddressOrMetachainAddr(address string) []byte {
 // MetaChainShardAddress returns core.MetachainShardId as a 62 byte array address(by padding with zeros).
 // This is needed, since all addresses from avro schema are required to be 62 fixed byte array
 func MetaChainShardAddress() []byte {
-	ret := make([]byte, 62)
+	return metaChainShardAddressWithLen(bech32AddressLen)
+}
+
+func metaChainShardAddressWithLen(addressLen int) []byte {
+	ret := make([]byte, addressLen)
 	copy(ret, fmt.Sprintf("%d", core.MetachainShardId))
 	return ret
 }
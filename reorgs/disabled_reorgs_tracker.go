@@ -0,0 +1,35 @@
+package reorgs
+
+import "github.com/multiversx/mx-chain-covalent-go/api"
+
+type disabledReorgsTracker struct {
+}
+
+// NewDisabledReorgsTracker will create a reorgs tracker which does not track anything
+func NewDisabledReorgsTracker() *disabledReorgsTracker {
+	return &disabledReorgsTracker{}
+}
+
+// Track does nothing
+func (drt *disabledReorgsTracker) Track(_ uint64, _ string) {
+}
+
+// GetReorgs returns no events, since nothing is tracked
+func (drt *disabledReorgsTracker) GetReorgs(_ uint64) []api.ReorgEvent {
+	return make([]api.ReorgEvent, 0)
+}
+
+// GetInstanceId returns an empty string, since no events are published
+func (drt *disabledReorgsTracker) GetInstanceId() string {
+	return ""
+}
+
+// IsEnabled returns false, since nothing is tracked
+func (drt *disabledReorgsTracker) IsEnabled() bool {
+	return false
+}
+
+// Close does nothing
+func (drt *disabledReorgsTracker) Close() error {
+	return nil
+}
@@ -0,0 +1,13 @@
+package reorgs
+
+import "errors"
+
+var errNilMultiversxEndpoint = errors.New("nil multiversx hyper block endpoint handler provided")
+
+var errInvalidVerifyInterval = errors.New("invalid verify interval")
+
+var errInvalidMaxTrackedNonces = errors.New("invalid max number of tracked nonces")
+
+var errInvalidMaxEvents = errors.New("invalid max number of events")
+
+var errCouldNotCreateInstanceId = errors.New("could not create reorgs tracker instance id")
@@ -0,0 +1,254 @@
+package reorgs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const hyperBlockPathByNonce = "/hyperblock/by-nonce"
+
+const networkStatusPath = "/network/status"
+
+const instanceIdLength = 8
+
+var log = logger.GetOrCreate("reorgs")
+
+// ArgsReorgsTracker holds all input dependencies required by reorgs tracker
+type ArgsReorgsTracker struct {
+	MultiversxEndpoint api.MultiversxHyperBlockEndpointHandler
+	VerifyIntervalMs   uint64
+	MaxTrackedNonces   uint32
+	MaxEvents          uint32
+}
+
+// reorgsTracker keeps the hashes of recently served hyper blocks, which are not final yet, and periodically verifies
+// them against Multiversx proxy. Once a different hash is found at a tracked nonce, a rollback event is published.
+// Nonces are no longer tracked once they are final and verified one last time. Events are only kept in memory, so
+// their ids restart from 0 along with a new random instance id once the process is restarted
+type reorgsTracker struct {
+	mutex              sync.RWMutex
+	multiversxEndpoint api.MultiversxHyperBlockEndpointHandler
+	trackedHashes      map[uint64]string
+	events             []api.ReorgEvent
+	instanceId         string
+	nextEventId        uint64
+	maxTrackedNonces   int
+	maxEvents          int
+	verifyInterval     time.Duration
+	cancel             func()
+}
+
+// NewReorgsTracker creates a reorgs tracker and starts verifying the tracked hyper blocks hashes
+func NewReorgsTracker(args ArgsReorgsTracker) (*reorgsTracker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	instanceId, err := createInstanceId()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rt := &reorgsTracker{
+		multiversxEndpoint: args.MultiversxEndpoint,
+		trackedHashes:      make(map[uint64]string),
+		events:             make([]api.ReorgEvent, 0),
+		instanceId:         instanceId,
+		maxTrackedNonces:   int(args.MaxTrackedNonces),
+		maxEvents:          int(args.MaxEvents),
+		verifyInterval:     time.Duration(args.VerifyIntervalMs) * time.Millisecond,
+		cancel:             cancel,
+	}
+
+	go rt.verifyTrackedHashes(ctx)
+
+	return rt, nil
+}
+
+func checkArgs(args ArgsReorgsTracker) error {
+	if args.MultiversxEndpoint == nil {
+		return errNilMultiversxEndpoint
+	}
+	if args.VerifyIntervalMs == 0 {
+		return fmt.Errorf("%w; expected non zero value", errInvalidVerifyInterval)
+	}
+	if args.MaxTrackedNonces == 0 {
+		return fmt.Errorf("%w; expected non zero value", errInvalidMaxTrackedNonces)
+	}
+	if args.MaxEvents == 0 {
+		return fmt.Errorf("%w; expected non zero value", errInvalidMaxEvents)
+	}
+
+	return nil
+}
+
+func createInstanceId() (string, error) {
+	buff := make([]byte, instanceIdLength)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", errCouldNotCreateInstanceId, err)
+	}
+
+	return hex.EncodeToString(buff), nil
+}
+
+// Track records the hash of a served hyper block, which is not final yet. If another hash was served before at the
+// same nonce, a rollback event is published. Once the max number of tracked nonces is exceeded, the lowest one is
+// no longer tracked
+func (rt *reorgsTracker) Track(nonce uint64, hash string) {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+
+	rt.updateHash(nonce, hash)
+	if len(rt.trackedHashes) > rt.maxTrackedNonces {
+		rt.evictLowestNonce()
+	}
+}
+
+func (rt *reorgsTracker) updateHash(nonce uint64, hash string) {
+	oldHash, found := rt.trackedHashes[nonce]
+	rt.trackedHashes[nonce] = hash
+	if found && oldHash != hash {
+		rt.publishEvent(nonce, oldHash, hash)
+	}
+}
+
+func (rt *reorgsTracker) evictLowestNonce() {
+	isFirst := true
+	lowestNonce := uint64(0)
+	for nonce := range rt.trackedHashes {
+		if isFirst || nonce < lowestNonce {
+			lowestNonce = nonce
+			isFirst = false
+		}
+	}
+
+	delete(rt.trackedHashes, lowestNonce)
+}
+
+func (rt *reorgsTracker) publishEvent(nonce uint64, oldHash string, newHash string) {
+	log.Warn("hyper block reorg detected", "nonce", nonce, "old hash", oldHash, "new hash", newHash)
+
+	rt.events = append(rt.events, api.ReorgEvent{
+		Id:         rt.nextEventId,
+		InstanceId: rt.instanceId,
+		Nonce:      nonce,
+		OldHash:    oldHash,
+		NewHash:    newHash,
+		Timestamp:  time.Now().Unix(),
+	})
+	rt.nextEventId++
+
+	if len(rt.events) > rt.maxEvents {
+		rt.events = append(make([]api.ReorgEvent, 0, rt.maxEvents), rt.events[len(rt.events)-rt.maxEvents:]...)
+	}
+}
+
+// GetReorgs returns the kept rollback events, having an id greater than or equal to the provided one, oldest first
+func (rt *reorgsTracker) GetReorgs(fromId uint64) []api.ReorgEvent {
+	rt.mutex.RLock()
+	defer rt.mutex.RUnlock()
+
+	events := make([]api.ReorgEvent, 0)
+	for _, event := range rt.events {
+		if event.Id >= fromId {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+// GetInstanceId returns the random id of this tracker, which changes once the process is restarted and the event ids
+// start again from 0
+func (rt *reorgsTracker) GetInstanceId() string {
+	return rt.instanceId
+}
+
+func (rt *reorgsTracker) verifyTrackedHashes(ctx context.Context) {
+	timer := time.NewTimer(rt.verifyInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		rt.verify(ctx)
+		timer.Reset(rt.verifyInterval)
+	}
+}
+
+func (rt *reorgsTracker) verify(ctx context.Context) {
+	metaNetworkStatusPath := fmt.Sprintf("%s/%d", networkStatusPath, core.MetachainShardId)
+	networkStatus, err := rt.multiversxEndpoint.GetNetworkStatus(ctx, metaNetworkStatusPath)
+	if err != nil {
+		log.Debug("could not get highest final hyper block nonce", "error", err)
+		return
+	}
+
+	highestFinalNonce := networkStatus.Data.Status.HighestFinalNonce
+	for _, nonce := range rt.getTrackedNonces() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		path := fmt.Sprintf("%s/%d", hyperBlockPathByNonce, nonce)
+		hyperBlockApiResponse, errGet := rt.multiversxEndpoint.GetHyperBlock(ctx, path)
+		if errGet != nil {
+			log.Debug("could not verify hyper block hash", "nonce", nonce, "error", errGet)
+			continue
+		}
+
+		rt.verifyHash(nonce, hyperBlockApiResponse.Data.HyperBlock.Hash, nonce <= highestFinalNonce)
+	}
+}
+
+func (rt *reorgsTracker) getTrackedNonces() []uint64 {
+	rt.mutex.RLock()
+	defer rt.mutex.RUnlock()
+
+	nonces := make([]uint64, 0, len(rt.trackedHashes))
+	for nonce := range rt.trackedHashes {
+		nonces = append(nonces, nonce)
+	}
+
+	return nonces
+}
+
+func (rt *reorgsTracker) verifyHash(nonce uint64, hash string, isFinal bool) {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+
+	_, isTracked := rt.trackedHashes[nonce]
+	if !isTracked {
+		return
+	}
+
+	rt.updateHash(nonce, hash)
+	if isFinal {
+		delete(rt.trackedHashes, nonce)
+	}
+}
+
+// IsEnabled returns true, since served hyper blocks are tracked
+func (rt *reorgsTracker) IsEnabled() bool {
+	return true
+}
+
+// Close stops verifying the tracked hyper blocks hashes
+func (rt *reorgsTracker) Close() error {
+	rt.cancel()
+	return nil
+}
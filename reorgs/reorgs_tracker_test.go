@@ -0,0 +1,291 @@
+package reorgs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock/apiMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsReorgsTracker() ArgsReorgsTracker {
+	return ArgsReorgsTracker{
+		MultiversxEndpoint: &apiMocks.MultiversxHyperBlockEndPointStub{},
+		VerifyIntervalMs:   60000,
+		MaxTrackedNonces:   3,
+		MaxEvents:          2,
+	}
+}
+
+func getNonceFromPath(t *testing.T, path string) uint64 {
+	nonce, err := strconv.ParseUint(strings.TrimPrefix(path, hyperBlockPathByNonce+"/"), 10, 64)
+	require.Nil(t, err)
+
+	return nonce
+}
+
+func TestNewReorgsTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rt, err := NewReorgsTracker(createMockArgsReorgsTracker())
+		require.Nil(t, err)
+		require.NotNil(t, rt)
+		require.True(t, rt.IsEnabled())
+		require.Nil(t, rt.Close())
+	})
+
+	t.Run("nil multiversx endpoint, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReorgsTracker()
+		args.MultiversxEndpoint = nil
+		rt, err := NewReorgsTracker(args)
+		require.Nil(t, rt)
+		require.Equal(t, errNilMultiversxEndpoint, err)
+	})
+
+	t.Run("invalid verify interval, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReorgsTracker()
+		args.VerifyIntervalMs = 0
+		rt, err := NewReorgsTracker(args)
+		require.Nil(t, rt)
+		require.True(t, errors.Is(err, errInvalidVerifyInterval))
+	})
+
+	t.Run("invalid max tracked nonces, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReorgsTracker()
+		args.MaxTrackedNonces = 0
+		rt, err := NewReorgsTracker(args)
+		require.Nil(t, rt)
+		require.True(t, errors.Is(err, errInvalidMaxTrackedNonces))
+	})
+
+	t.Run("invalid max events, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReorgsTracker()
+		args.MaxEvents = 0
+		rt, err := NewReorgsTracker(args)
+		require.Nil(t, rt)
+		require.True(t, errors.Is(err, errInvalidMaxEvents))
+	})
+}
+
+func TestReorgsTracker_GetInstanceId(t *testing.T) {
+	t.Parallel()
+
+	rt1, _ := NewReorgsTracker(createMockArgsReorgsTracker())
+	rt2, _ := NewReorgsTracker(createMockArgsReorgsTracker())
+	defer func() {
+		_ = rt1.Close()
+		_ = rt2.Close()
+	}()
+
+	require.Len(t, rt1.GetInstanceId(), 2*instanceIdLength)
+	require.Equal(t, rt1.GetInstanceId(), rt1.GetInstanceId())
+	require.NotEqual(t, rt1.GetInstanceId(), rt2.GetInstanceId())
+}
+
+func TestReorgsTracker_Track(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same hash served again, should not publish any event", func(t *testing.T) {
+		t.Parallel()
+
+		rt, _ := NewReorgsTracker(createMockArgsReorgsTracker())
+		defer func() {
+			_ = rt.Close()
+		}()
+
+		rt.Track(4, "hash4")
+		rt.Track(4, "hash4")
+		require.Empty(t, rt.GetReorgs(0))
+	})
+
+	t.Run("different hash served at the same nonce, should publish event", func(t *testing.T) {
+		t.Parallel()
+
+		rt, _ := NewReorgsTracker(createMockArgsReorgsTracker())
+		defer func() {
+			_ = rt.Close()
+		}()
+
+		rt.Track(4, "hash4")
+		rt.Track(5, "hash5")
+		rt.Track(4, "newHash4")
+		rt.Track(5, "newHash5")
+
+		events := rt.GetReorgs(0)
+		require.Len(t, events, 2)
+		require.Equal(t, uint64(0), events[0].Id)
+		require.Equal(t, rt.GetInstanceId(), events[0].InstanceId)
+		require.Equal(t, uint64(4), events[0].Nonce)
+		require.Equal(t, "hash4", events[0].OldHash)
+		require.Equal(t, "newHash4", events[0].NewHash)
+		require.Equal(t, uint64(1), events[1].Id)
+		require.Equal(t, uint64(5), events[1].Nonce)
+
+		events = rt.GetReorgs(1)
+		require.Len(t, events, 1)
+		require.Equal(t, uint64(5), events[0].Nonce)
+		require.Empty(t, rt.GetReorgs(2))
+	})
+
+	t.Run("too many tracked nonces, should evict the lowest one", func(t *testing.T) {
+		t.Parallel()
+
+		rt, _ := NewReorgsTracker(createMockArgsReorgsTracker())
+		defer func() {
+			_ = rt.Close()
+		}()
+
+		rt.Track(5, "hash5")
+		rt.Track(4, "hash4")
+		rt.Track(6, "hash6")
+		rt.Track(7, "hash7")
+		require.Equal(t, map[uint64]string{5: "hash5", 6: "hash6", 7: "hash7"}, rt.trackedHashes)
+	})
+
+	t.Run("too many events, should keep the latest ones", func(t *testing.T) {
+		t.Parallel()
+
+		rt, _ := NewReorgsTracker(createMockArgsReorgsTracker())
+		defer func() {
+			_ = rt.Close()
+		}()
+
+		for i := 0; i < 5; i++ {
+			rt.Track(4, fmt.Sprintf("hash%d", i))
+		}
+
+		events := rt.GetReorgs(0)
+		require.Len(t, events, 2)
+		require.Equal(t, uint64(2), events[0].Id)
+		require.Equal(t, "hash3", events[1].OldHash)
+		require.Equal(t, "hash4", events[1].NewHash)
+	})
+}
+
+func TestReorgsTracker_Verify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should publish events for changed hashes and stop tracking final nonces", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReorgsTracker()
+		args.VerifyIntervalMs = 5
+		args.MultiversxEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				nonce := getNonceFromPath(t, path)
+				if nonce == 7 {
+					return nil, errors.New("local error")
+				}
+
+				response := &api.MultiversxHyperBlockApiResponse{}
+				response.Data.HyperBlock.Nonce = nonce
+				response.Data.HyperBlock.Hash = fmt.Sprintf("hash%d", nonce)
+				if nonce == 5 {
+					response.Data.HyperBlock.Hash = "newHash5"
+				}
+
+				return response, nil
+			},
+			GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				require.Equal(t, "/network/status/4294967295", path)
+
+				response := &api.MultiversxNetworkStatusApiResponse{}
+				response.Data.Status.HighestFinalNonce = 5
+				return response, nil
+			},
+		}
+		rt, _ := NewReorgsTracker(args)
+		defer func() {
+			_ = rt.Close()
+		}()
+
+		rt.Track(5, "hash5")
+		rt.Track(6, "hash6")
+		rt.Track(7, "hash7")
+
+		require.Eventually(t, func() bool {
+			return len(rt.getTrackedNonces()) == 2
+		}, time.Second, time.Millisecond)
+
+		events := rt.GetReorgs(0)
+		require.Len(t, events, 1)
+		require.Equal(t, uint64(5), events[0].Nonce)
+		require.Equal(t, "hash5", events[0].OldHash)
+		require.Equal(t, "newHash5", events[0].NewHash)
+
+		rt.mutex.RLock()
+		require.Equal(t, map[uint64]string{6: "hash6", 7: "hash7"}, rt.trackedHashes)
+		rt.mutex.RUnlock()
+	})
+
+	t.Run("could not get network status, should not verify anything", func(t *testing.T) {
+		t.Parallel()
+
+		numNetworkStatusRequests := int32(0)
+		args := createMockArgsReorgsTracker()
+		args.VerifyIntervalMs = 1
+		args.MultiversxEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetHyperBlockCalled: func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
+				require.Fail(t, "should not request hyper block")
+				return nil, nil
+			},
+			GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				atomic.AddInt32(&numNetworkStatusRequests, 1)
+				return nil, errors.New("local error")
+			},
+		}
+		rt, _ := NewReorgsTracker(args)
+		defer func() {
+			_ = rt.Close()
+		}()
+
+		rt.Track(5, "hash5")
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&numNetworkStatusRequests) > 1
+		}, time.Second, time.Millisecond)
+		require.Len(t, rt.getTrackedNonces(), 1)
+	})
+
+	t.Run("close should stop verifying", func(t *testing.T) {
+		t.Parallel()
+
+		numNetworkStatusRequests := int32(0)
+		args := createMockArgsReorgsTracker()
+		args.VerifyIntervalMs = 1
+		args.MultiversxEndpoint = &apiMocks.MultiversxHyperBlockEndPointStub{
+			GetNetworkStatusCalled: func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+				atomic.AddInt32(&numNetworkStatusRequests, 1)
+				return &api.MultiversxNetworkStatusApiResponse{}, nil
+			},
+		}
+		rt, _ := NewReorgsTracker(args)
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&numNetworkStatusRequests) > 0
+		}, time.Second, time.Millisecond)
+
+		require.Nil(t, rt.Close())
+		time.Sleep(20 * time.Millisecond)
+		numRequestsAfterClose := atomic.LoadInt32(&numNetworkStatusRequests)
+		time.Sleep(20 * time.Millisecond)
+		require.Equal(t, numRequestsAfterClose, atomic.LoadInt32(&numNetworkStatusRequests))
+	})
+}
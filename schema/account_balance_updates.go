@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"github.com/elodina/go-avro"
+)
+
+// ShardAccountBalanceUpdate is the balance update of an account, attached to the shard block which changed it
+type ShardAccountBalanceUpdate struct {
+	ShardBlockNonce int64
+	ShardBlockHash  []byte
+	BalanceUpdate   *AccountBalanceUpdate
+}
+
+// AccountBalanceUpdates is the array of balance updates of an account, encoded as an avro array
+type AccountBalanceUpdates []*ShardAccountBalanceUpdate
+
+var _AccountBalanceUpdates_schema, _AccountBalanceUpdates_schema_err = parseAccountBalanceUpdatesSchema()
+
+func parseAccountBalanceUpdatesSchema() (avro.Schema, error) {
+	schemaDefinition, err := GetAccountBalanceUpdatesSchemaDefinition(AddressEncodingBech32)
+	if err != nil {
+		return nil, err
+	}
+
+	return avro.ParseSchema(schemaDefinition)
+}
+
+// Schema returns the avro array schema of balance updates, having bech32 addresses
+func (o AccountBalanceUpdates) Schema() avro.Schema {
+	if _AccountBalanceUpdates_schema_err != nil {
+		panic(_AccountBalanceUpdates_schema_err)
+	}
+	return _AccountBalanceUpdates_schema
+}
@@ -0,0 +1,142 @@
+package schema
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/elodina/go-avro"
+)
+
+const (
+	// AddressEncodingBech32 defines hyper blocks whose addresses hold the bytes of the bech32 encoded string,
+	// as defined in block.multiversx.avsc
+	AddressEncodingBech32 = "bech32"
+	// AddressEncodingPubKey defines hyper blocks whose addresses hold the 32-byte public keys,
+	// as defined in block.multiversx.pubkey.avsc
+	AddressEncodingPubKey = "pubkey"
+)
+
+const (
+	transactionsFieldName = "Transactions"
+	shardBlocksFieldName  = "ShardBlocks"
+	stateChangesFieldName = "StateChanges"
+)
+
+// accountBalanceUpdatesSchemaTemplate defines the array of balance updates of an account, each attached to the nonce
+// and hash of its shard block. It should be formatted with the AccountBalanceUpdate record schema
+const accountBalanceUpdatesSchemaTemplate = `{
+  "type": "array",
+  "items": {
+    "type": "record",
+    "namespace": "com.covalenthq.block.schema",
+    "name": "ShardAccountBalanceUpdate",
+    "fields": [
+      {"name": "ShardBlockNonce", "type": "long"},
+      {"name": "ShardBlockHash", "type": {
+        "name": "hash", "type": "fixed", "size": 32}},
+      {"name": "BalanceUpdate", "type": %s}
+    ]
+  }
+}`
+
+var errUnknownAddressEncoding = errors.New("unknown address encoding")
+
+var errNestedSchemaNotFound = errors.New("nested schema not found in hyper block schema")
+
+// HyperBlockSchemaDefinition is the raw avro schema definition of HyperBlock record, as defined in block.multiversx.avsc
+//
+//go:embed block.multiversx.avsc
+var HyperBlockSchemaDefinition string
+
+// HyperBlockPubKeySchemaDefinition is the raw avro schema definition of HyperBlock record, having 32-byte public key
+// addresses, as defined in block.multiversx.pubkey.avsc
+//
+//go:embed block.multiversx.pubkey.avsc
+var HyperBlockPubKeySchemaDefinition string
+
+// GetHyperBlockSchemaDefinition returns the raw avro schema definition of HyperBlock record, for the provided address encoding
+func GetHyperBlockSchemaDefinition(addressEncoding string) (string, error) {
+	switch addressEncoding {
+	case AddressEncodingBech32:
+		return HyperBlockSchemaDefinition, nil
+	case AddressEncodingPubKey:
+		return HyperBlockPubKeySchemaDefinition, nil
+	default:
+		return "", fmt.Errorf("%w: %s", errUnknownAddressEncoding, addressEncoding)
+	}
+}
+
+// GetTransactionSchemaDefinition returns the raw avro schema definition of Transaction record, as nested in the
+// HyperBlock record of the provided address encoding
+func GetTransactionSchemaDefinition(addressEncoding string) (string, error) {
+	transactionSchema, err := getNestedRecordSchema(addressEncoding, transactionsFieldName)
+	if err != nil {
+		return "", err
+	}
+
+	return transactionSchema.String(), nil
+}
+
+// GetAccountBalanceUpdatesSchemaDefinition returns the raw avro schema definition of an array of
+// ShardAccountBalanceUpdate records, each wrapping the AccountBalanceUpdate record nested in the HyperBlock record of
+// the provided address encoding
+func GetAccountBalanceUpdatesSchemaDefinition(addressEncoding string) (string, error) {
+	accountBalanceUpdateSchema, err := getNestedRecordSchema(addressEncoding, shardBlocksFieldName, stateChangesFieldName)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(accountBalanceUpdatesSchemaTemplate, accountBalanceUpdateSchema.String()), nil
+}
+
+// getNestedRecordSchema returns the schema of the records held by the array fields along the provided path, starting
+// from the HyperBlock record of the provided address encoding
+func getNestedRecordSchema(addressEncoding string, fieldNames ...string) (avro.Schema, error) {
+	hyperBlockSchemaDefinition, err := GetHyperBlockSchemaDefinition(addressEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	recordSchema, err := avro.ParseSchema(hyperBlockSchemaDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fieldName := range fieldNames {
+		recordSchema, err = getArrayFieldItemsSchema(recordSchema, fieldName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return recordSchema, nil
+}
+
+// getArrayFieldItemsSchema returns the items schema of the provided array field, which can also be nullable
+func getArrayFieldItemsSchema(schema avro.Schema, fieldName string) (avro.Schema, error) {
+	recordSchema, ok := schema.(*avro.RecordSchema)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is not a record", errNestedSchemaNotFound, schema.GetName())
+	}
+
+	for _, field := range recordSchema.Fields {
+		if field.Name != fieldName {
+			continue
+		}
+
+		fieldTypes := []avro.Schema{field.Type}
+		unionSchema, isUnion := field.Type.(*avro.UnionSchema)
+		if isUnion {
+			fieldTypes = unionSchema.Types
+		}
+		for _, fieldType := range fieldTypes {
+			arraySchema, isArray := fieldType.(*avro.ArraySchema)
+			if isArray {
+				return arraySchema.Items, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no array field %s in %s", errNestedSchemaNotFound, fieldName, recordSchema.GetName())
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/elodina/go-avro"
+	"github.com/stretchr/testify/require"
+)
+
+const epochStartShardsDataFieldName = "EpochStartShardsData"
+
+// removeLastHyperBlockField returns the hyper block schema definition without its last field, as read by consumers
+// which still use the previous version of the schema
+func removeLastHyperBlockField(t *testing.T, definition string) (string, string) {
+	hyperBlockSchema := make(map[string]interface{})
+	require.Nil(t, json.Unmarshal([]byte(definition), &hyperBlockSchema))
+
+	fields := hyperBlockSchema["fields"].([]interface{})
+	lastField := fields[len(fields)-1].(map[string]interface{})
+	hyperBlockSchema["fields"] = fields[:len(fields)-1]
+
+	previousDefinition, err := json.Marshal(hyperBlockSchema)
+	require.Nil(t, err)
+
+	return string(previousDefinition), lastField["name"].(string)
+}
+
+func TestHyperBlockSchemaDefinitions_NewFieldsAreAppended(t *testing.T) {
+	t.Parallel()
+
+	for _, addressEncoding := range []string{AddressEncodingBech32, AddressEncodingPubKey} {
+		definition, err := GetHyperBlockSchemaDefinition(addressEncoding)
+		require.Nil(t, err)
+
+		previousDefinition, lastFieldName := removeLastHyperBlockField(t, definition)
+		require.Equal(t, epochStartShardsDataFieldName, lastFieldName, addressEncoding)
+
+		hyperBlock := NewHyperBlock()
+		hyperBlock.Nonce = 4
+		hyperBlock.Status = "final"
+		hyperBlock.EpochStartShardsData = []*EpochStartShardData{
+			{ShardID: 1, Nonce: 3},
+		}
+
+		currentSchema, err := avro.ParseSchema(definition)
+		require.Nil(t, err)
+		writer := avro.NewSpecificDatumWriter()
+		writer.SetSchema(currentSchema)
+		buffer := &bytes.Buffer{}
+		require.Nil(t, writer.Write(hyperBlock, avro.NewBinaryEncoder(buffer)))
+
+		// a consumer without the writer schema decodes the fields by position, using the previous schema
+		previousSchema, err := avro.ParseSchema(previousDefinition)
+		require.Nil(t, err)
+		reader := avro.NewGenericDatumReader()
+		reader.SetSchema(previousSchema)
+		decodedHyperBlock := avro.NewGenericRecord(previousSchema)
+		require.Nil(t, reader.Read(decodedHyperBlock, avro.NewBinaryDecoder(buffer.Bytes())))
+		require.Equal(t, int64(4), decodedHyperBlock.Get("Nonce"), addressEncoding)
+		require.Equal(t, "final", decodedHyperBlock.Get("Status"), addressEncoding)
+	}
+}
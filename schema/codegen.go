@@ -1,2 +1,2 @@
-//go:generate go run codegen/codegenerator.go --schema block.multiversx.avsc --out schema.go
+//go:generate go run ./codegen --schema block.multiversx.avsc --out schema.go
 package schema
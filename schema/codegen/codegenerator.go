@@ -31,6 +31,16 @@ func main() {
 	app.Action = func(c *cli.Context) error {
 		return startProcess(c)
 	}
+	app.Commands = []cli.Command{
+		{
+			Name:  "check-compatibility",
+			Usage: "Check the avro schema against a baseline schema, using the avro schema resolution rules",
+			Flags: []cli.Flag{in, baseline, baselineRevision, compatibilityMode},
+			Action: func(c *cli.Context) error {
+				return checkCompatibility(c)
+			},
+		},
+	}
 
 	err := app.Run(os.Args)
 	if err != nil {
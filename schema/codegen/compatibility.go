@@ -0,0 +1,98 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-covalent-go/schema/compatibility"
+	"github.com/urfave/cli"
+)
+
+var (
+	baseline = cli.StringFlag{
+		Name:  "baseline",
+		Usage: "File name of the baseline avro schema",
+		Value: "",
+	}
+	baselineRevision = cli.StringFlag{
+		Name: "baseline-revision",
+		Usage: "Git revision(e.g. HEAD, main) holding the baseline avro schema, at the same path as the input schema. " +
+			"Used if no baseline file name is provided",
+		Value: "",
+	}
+	compatibilityMode = cli.StringFlag{
+		Name: "compatibility",
+		Usage: "Compatibility mode: backward(new readers can read baseline data), forward(baseline readers can read " +
+			"new data) or full(both)",
+		Value: compatibility.ModeFull,
+	}
+
+	errNoBaseline         = errors.New("no baseline file name or git revision provided")
+	errIncompatibleSchema = errors.New("incompatible schema changes")
+)
+
+func checkCompatibility(c *cli.Context) error {
+	input := c.String(in.Name)
+	mode := c.String(compatibilityMode.Name)
+
+	schema, err := ioutil.ReadFile(input)
+	if err != nil {
+		return err
+	}
+
+	baselineSchema, err := readBaselineSchema(c, input)
+	if err != nil {
+		return err
+	}
+
+	report, err := compatibility.CheckCompatibility(string(schema), string(baselineSchema), mode)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range report.Changes {
+		status := "compatible"
+		if change.IsIncompatible(mode) {
+			status = "INCOMPATIBLE"
+		}
+
+		fmt.Printf("%s: %s\n", status, change.String())
+	}
+
+	incompatibleChanges := report.IncompatibleChanges()
+	if len(incompatibleChanges) > 0 {
+		return fmt.Errorf("%w: found %d changes breaking %s compatibility", errIncompatibleSchema, len(incompatibleChanges), mode)
+	}
+
+	fmt.Printf("schema is %s compatible, found %d changes\n", mode, len(report.Changes))
+	return nil
+}
+
+func readBaselineSchema(c *cli.Context, input string) ([]byte, error) {
+	baselineFile := c.String(baseline.Name)
+	if len(baselineFile) > 0 {
+		return ioutil.ReadFile(baselineFile)
+	}
+
+	revision := c.String(baselineRevision.Name)
+	if len(revision) == 0 {
+		return nil, errNoBaseline
+	}
+
+	// the "./" prefix makes git resolve the path relative to the current directory, instead of the repository root
+	object := fmt.Sprintf("%s:./%s", revision, filepath.ToSlash(filepath.Clean(input)))
+	baselineSchema, err := exec.Command("git", "show", object).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("could not read %s: %w, %s", object, err, exitErr.Stderr)
+		}
+
+		return nil, fmt.Errorf("could not read %s: %w", object, err)
+	}
+
+	return baselineSchema, nil
+}
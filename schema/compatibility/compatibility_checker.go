@@ -0,0 +1,268 @@
+package compatibility
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ModeBackward requires that readers using the new schema can read data written with the baseline schema
+	ModeBackward = "backward"
+	// ModeForward requires that readers using the baseline schema can read data written with the new schema
+	ModeForward = "forward"
+	// ModeFull requires both backward and forward compatibility
+	ModeFull = "full"
+)
+
+// ChangeKind defines the kind of schema change
+type ChangeKind string
+
+const (
+	// FieldAdded is a field found only in the new schema
+	FieldAdded ChangeKind = "field added"
+	// FieldRemoved is a field found only in the baseline schema
+	FieldRemoved ChangeKind = "field removed"
+	// TypeChanged is a field, array item, map value or union branch whose type changed
+	TypeChanged ChangeKind = "type changed"
+	// EnumSymbolsChanged is an enum whose symbols changed
+	EnumSymbolsChanged ChangeKind = "enum symbols changed"
+)
+
+// Change holds a difference between the new and the baseline schema
+type Change struct {
+	Kind           ChangeKind
+	Path           string
+	Description    string
+	BreaksBackward bool
+	BreaksForward  bool
+}
+
+// IsIncompatible returns true if the change breaks the provided compatibility mode
+func (c *Change) IsIncompatible(mode string) bool {
+	switch mode {
+	case ModeBackward:
+		return c.BreaksBackward
+	case ModeForward:
+		return c.BreaksForward
+	default:
+		return c.BreaksBackward || c.BreaksForward
+	}
+}
+
+// String returns a human readable description of the change
+func (c *Change) String() string {
+	return fmt.Sprintf("%s: %s(%s)", c.Path, c.Kind, c.Description)
+}
+
+// Report holds all changes between the new and the baseline schema
+type Report struct {
+	Mode    string
+	Changes []*Change
+}
+
+// IncompatibleChanges returns the changes breaking the compatibility mode of the report
+func (r *Report) IncompatibleChanges() []*Change {
+	incompatibleChanges := make([]*Change, 0)
+	for _, change := range r.Changes {
+		if change.IsIncompatible(r.Mode) {
+			incompatibleChanges = append(incompatibleChanges, change)
+		}
+	}
+
+	return incompatibleChanges
+}
+
+// IsCompatible returns true if no change breaks the compatibility mode of the report
+func (r *Report) IsCompatible() bool {
+	return len(r.IncompatibleChanges()) == 0
+}
+
+// IsModeSupported returns true if the provided compatibility mode is supported
+func IsModeSupported(mode string) bool {
+	return mode == ModeBackward || mode == ModeForward || mode == ModeFull
+}
+
+// CheckCompatibility compares the new avro schema against the baseline one and reports all added fields, removed fields
+// and type changes, along with whether they break the provided compatibility mode, according to the avro schema
+// resolution rules:
+//  - a field added without default breaks backward compatibility, since it is missing from data written with the
+//    baseline schema
+//  - a field removed without default in the baseline schema breaks forward compatibility, since it is missing from
+//    data written with the new schema
+//  - a type change breaks backward compatibility if the new type can not read the baseline type(e.g. long to int)
+//    and forward compatibility if the baseline type can not read the new type(e.g. int to long)
+func CheckCompatibility(newSchemaDefinition string, baselineSchemaDefinition string, mode string) (*Report, error) {
+	if !IsModeSupported(mode) {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedMode, mode)
+	}
+
+	newSchema, err := parseSchema(newSchemaDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("new schema: %w", err)
+	}
+	baselineSchema, err := parseSchema(baselineSchemaDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("baseline schema: %w", err)
+	}
+
+	cmp := &schemaComparer{
+		compared: make(map[nodesPair]struct{}),
+	}
+	cmp.compare(newSchema, baselineSchema, getShortName(newSchema.fullName))
+
+	return &Report{
+		Mode:    mode,
+		Changes: cmp.changes,
+	}, nil
+}
+
+type schemaComparer struct {
+	compared map[nodesPair]struct{}
+	changes  []*Change
+}
+
+// compare walks the new and the baseline schema together, recording their differences. Named types referred in
+// multiple places are only compared once, at their first path
+func (sc *schemaComparer) compare(newNode *schemaNode, baselineNode *schemaNode, path string) {
+	pair := nodesPair{reader: newNode, writer: baselineNode}
+	if _, found := sc.compared[pair]; found {
+		return
+	}
+	sc.compared[pair] = struct{}{}
+
+	if newNode.kind != baselineNode.kind || !haveSameName(newNode, baselineNode) {
+		sc.addChange(TypeChanged, path, fmt.Sprintf("%s -> %s", describe(baselineNode), describe(newNode)), newNode, baselineNode)
+		return
+	}
+
+	switch newNode.kind {
+	case kindRecord:
+		sc.compareFields(newNode, baselineNode, path)
+	case kindEnum:
+		if !haveSameStrings(newNode.symbols, baselineNode.symbols) {
+			description := fmt.Sprintf("[%s] -> [%s]", strings.Join(baselineNode.symbols, ", "), strings.Join(newNode.symbols, ", "))
+			sc.addChange(EnumSymbolsChanged, path, description, newNode, baselineNode)
+		}
+	case kindFixed:
+		if newNode.size != baselineNode.size {
+			description := fmt.Sprintf("%s size %d -> %d", describe(newNode), baselineNode.size, newNode.size)
+			sc.addChange(TypeChanged, path, description, newNode, baselineNode)
+		}
+	case kindArray:
+		sc.compare(newNode.items, baselineNode.items, path+"[]")
+	case kindMap:
+		sc.compare(newNode.values, baselineNode.values, path+"{}")
+	case kindUnion:
+		sc.compareUnions(newNode, baselineNode, path)
+	}
+}
+
+func (sc *schemaComparer) compareFields(newNode *schemaNode, baselineNode *schemaNode, path string) {
+	for _, newField := range newNode.fields {
+		fieldPath := path + "." + newField.name
+		baselineField := findField(baselineNode.fields, newField)
+		if baselineField != nil {
+			sc.compare(newField.node, baselineField.node, fieldPath)
+			continue
+		}
+
+		description := "with default"
+		if !newField.hasDefault {
+			description = "without default"
+		}
+		sc.changes = append(sc.changes, &Change{
+			Kind:           FieldAdded,
+			Path:           fieldPath,
+			Description:    description,
+			BreaksBackward: !newField.hasDefault,
+		})
+	}
+
+	for _, baselineField := range baselineNode.fields {
+		if isFieldKept(newNode.fields, baselineField) {
+			continue
+		}
+
+		description := "baseline field has a default"
+		if !baselineField.hasDefault {
+			description = "baseline field has no default"
+		}
+		sc.changes = append(sc.changes, &Change{
+			Kind:          FieldRemoved,
+			Path:          path + "." + baselineField.name,
+			Description:   description,
+			BreaksForward: !baselineField.hasDefault,
+		})
+	}
+}
+
+// compareUnions records a type change if union branches were added or removed, then compares the branches found in
+// both unions
+func (sc *schemaComparer) compareUnions(newNode *schemaNode, baselineNode *schemaNode, path string) {
+	if describe(newNode) != describe(baselineNode) {
+		sc.addChange(TypeChanged, path, fmt.Sprintf("%s -> %s", describe(baselineNode), describe(newNode)), newNode, baselineNode)
+	}
+
+	for _, newBranch := range newNode.branches {
+		for _, baselineBranch := range baselineNode.branches {
+			if newBranch.kind == baselineBranch.kind && haveSameName(newBranch, baselineBranch) {
+				sc.compare(newBranch, baselineBranch, path)
+				break
+			}
+		}
+	}
+}
+
+func (sc *schemaComparer) addChange(kind ChangeKind, path string, description string, newNode *schemaNode, baselineNode *schemaNode) {
+	sc.changes = append(sc.changes, &Change{
+		Kind:           kind,
+		Path:           path,
+		Description:    description,
+		BreaksBackward: !canRead(newNode, baselineNode),
+		BreaksForward:  !canRead(baselineNode, newNode),
+	})
+}
+
+func isFieldKept(newFields []*schemaField, baselineField *schemaField) bool {
+	for _, newField := range newFields {
+		if findField([]*schemaField{baselineField}, newField) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// describe returns a short description of the type, holding the names of named types instead of their definitions
+func describe(node *schemaNode) string {
+	switch node.kind {
+	case kindRecord, kindEnum, kindFixed:
+		return getShortName(node.fullName)
+	case kindArray:
+		return "array<" + describe(node.items) + ">"
+	case kindMap:
+		return "map<" + describe(node.values) + ">"
+	case kindUnion:
+		branches := make([]string, 0, len(node.branches))
+		for _, branch := range node.branches {
+			branches = append(branches, describe(branch))
+		}
+
+		return "[" + strings.Join(branches, ", ") + "]"
+	default:
+		return node.kind
+	}
+}
+
+func haveSameStrings(first []string, second []string) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for idx := range first {
+		if first[idx] != second[idx] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,230 @@
+package compatibility_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-covalent-go/schema/compatibility"
+	"github.com/stretchr/testify/require"
+)
+
+const baselineSchema = `{
+  "type": "record",
+  "namespace": "com.covalenthq.block.schema",
+  "name": "HyperBlock",
+  "fields": [
+    {"name": "Hash", "type": {"name": "hash", "type": "fixed", "size": 32}},
+    {"name": "PrevBlockHash", "type": ["null", "hash"]},
+    {"name": "Nonce", "type": "long"},
+    {"name": "Epoch", "type": "int"},
+    {"name": "Status", "type": {"name": "status", "type": "enum", "symbols": ["on-chain", "final"]}},
+    {"name": "Transactions", "type": {"type": "array", "items": {
+      "name": "Transaction",
+      "type": "record",
+      "fields": [
+        {"name": "Hash", "type": "hash"},
+        {"name": "Value", "type": "bytes"},
+        {"name": "Memo", "type": "string", "default": ""}
+      ]
+    }}}
+  ]
+}`
+
+func checkChanges(t *testing.T, replacements []string, mode string) *compatibility.Report {
+	newSchema := strings.NewReplacer(replacements...).Replace(baselineSchema)
+	require.NotEqual(t, baselineSchema, newSchema)
+
+	report, err := compatibility.CheckCompatibility(newSchema, baselineSchema, mode)
+	require.Nil(t, err)
+
+	return report
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same schema, should not report changes", func(t *testing.T) {
+		t.Parallel()
+
+		report, err := compatibility.CheckCompatibility(baselineSchema, baselineSchema, compatibility.ModeFull)
+		require.Nil(t, err)
+		require.Empty(t, report.Changes)
+		require.True(t, report.IsCompatible())
+
+		report, err = compatibility.CheckCompatibility(schema.HyperBlockSchemaDefinition, schema.HyperBlockSchemaDefinition, compatibility.ModeFull)
+		require.Nil(t, err)
+		require.Empty(t, report.Changes)
+	})
+
+	t.Run("unsupported mode, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		report, err := compatibility.CheckCompatibility(baselineSchema, baselineSchema, "transitive")
+		require.Nil(t, report)
+		require.True(t, errors.Is(err, compatibility.ErrUnsupportedMode))
+	})
+
+	t.Run("invalid schema, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		report, err := compatibility.CheckCompatibility("{", baselineSchema, compatibility.ModeFull)
+		require.Nil(t, report)
+		require.True(t, errors.Is(err, compatibility.ErrInvalidSchema))
+
+		report, err = compatibility.CheckCompatibility(baselineSchema, `{"type": "record", "name": "HyperBlock", "fields": [{"name": "Hash", "type": "hash"}]}`, compatibility.ModeFull)
+		require.Nil(t, report)
+		require.True(t, errors.Is(err, compatibility.ErrUnknownType))
+	})
+
+	t.Run("field added without default, should break backward compatibility", func(t *testing.T) {
+		t.Parallel()
+
+		report := checkChanges(t, []string{`{"name": "Memo"`, `{"name": "Fee", "type": "bytes"},
+        {"name": "Memo"`}, compatibility.ModeBackward)
+		require.Equal(t, []*compatibility.Change{
+			{
+				Kind:           compatibility.FieldAdded,
+				Path:           "HyperBlock.Transactions[].Fee",
+				Description:    "without default",
+				BreaksBackward: true,
+			},
+		}, report.Changes)
+		require.False(t, report.IsCompatible())
+
+		report.Mode = compatibility.ModeForward
+		require.True(t, report.IsCompatible())
+	})
+
+	t.Run("field added with default, should be compatible", func(t *testing.T) {
+		t.Parallel()
+
+		report := checkChanges(t, []string{`{"name": "Epoch", "type": "int"}`, `{"name": "Epoch", "type": "int"},
+    {"name": "Round", "type": ["null", "long"], "default": null}`}, compatibility.ModeFull)
+		require.Len(t, report.Changes, 1)
+		require.Equal(t, compatibility.FieldAdded, report.Changes[0].Kind)
+		require.Equal(t, "HyperBlock.Round", report.Changes[0].Path)
+		require.True(t, report.IsCompatible())
+	})
+
+	t.Run("field removed, should break forward compatibility only without default", func(t *testing.T) {
+		t.Parallel()
+
+		report := checkChanges(t, []string{`{"name": "Value", "type": "bytes"},`, ``}, compatibility.ModeFull)
+		require.Equal(t, []*compatibility.Change{
+			{
+				Kind:          compatibility.FieldRemoved,
+				Path:          "HyperBlock.Transactions[].Value",
+				Description:   "baseline field has no default",
+				BreaksForward: true,
+			},
+		}, report.IncompatibleChanges())
+
+		report.Mode = compatibility.ModeBackward
+		require.True(t, report.IsCompatible())
+
+		report = checkChanges(t, []string{`,
+        {"name": "Memo", "type": "string", "default": ""}`, ``}, compatibility.ModeFull)
+		require.Len(t, report.Changes, 1)
+		require.Equal(t, compatibility.FieldRemoved, report.Changes[0].Kind)
+		require.True(t, report.IsCompatible())
+	})
+
+	t.Run("renamed field with alias, should be compatible", func(t *testing.T) {
+		t.Parallel()
+
+		report := checkChanges(t, []string{`{"name": "Memo", "type": "string", "default": ""}`,
+			`{"name": "Data", "aliases": ["Memo"], "type": "string", "default": ""}`}, compatibility.ModeFull)
+		require.Empty(t, report.Changes)
+	})
+
+	t.Run("type promotion, should only break forward compatibility", func(t *testing.T) {
+		t.Parallel()
+
+		report := checkChanges(t, []string{`{"name": "Epoch", "type": "int"}`, `{"name": "Epoch", "type": "long"}`}, compatibility.ModeFull)
+		require.Equal(t, []*compatibility.Change{
+			{
+				Kind:          compatibility.TypeChanged,
+				Path:          "HyperBlock.Epoch",
+				Description:   "int -> long",
+				BreaksForward: true,
+			},
+		}, report.Changes)
+
+		report = checkChanges(t, []string{`{"name": "Nonce", "type": "long"}`, `{"name": "Nonce", "type": "int"}`}, compatibility.ModeBackward)
+		require.Len(t, report.Changes, 1)
+		require.True(t, report.Changes[0].BreaksBackward)
+		require.False(t, report.Changes[0].BreaksForward)
+	})
+
+	t.Run("incompatible type change, should break both", func(t *testing.T) {
+		t.Parallel()
+
+		report := checkChanges(t, []string{`{"name": "Nonce", "type": "long"}`, `{"name": "Nonce", "type": "string"}`}, compatibility.ModeFull)
+		require.Len(t, report.Changes, 1)
+		require.Equal(t, "long -> string", report.Changes[0].Description)
+		require.True(t, report.Changes[0].BreaksBackward)
+		require.True(t, report.Changes[0].BreaksForward)
+	})
+
+	t.Run("fixed size change, should break both", func(t *testing.T) {
+		t.Parallel()
+
+		report := checkChanges(t, []string{`"size": 32`, `"size": 64`}, compatibility.ModeFull)
+		require.Equal(t, []*compatibility.Change{
+			{
+				Kind:           compatibility.TypeChanged,
+				Path:           "HyperBlock.Hash",
+				Description:    "hash size 32 -> 64",
+				BreaksBackward: true,
+				BreaksForward:  true,
+			},
+		}, report.Changes)
+	})
+
+	t.Run("made nullable, should only break forward compatibility", func(t *testing.T) {
+		t.Parallel()
+
+		report := checkChanges(t, []string{`{"name": "Value", "type": "bytes"}`, `{"name": "Value", "type": ["null", "bytes"]}`}, compatibility.ModeFull)
+		require.Len(t, report.Changes, 1)
+		require.Equal(t, "HyperBlock.Transactions[].Value", report.Changes[0].Path)
+		require.Equal(t, "bytes -> [null, bytes]", report.Changes[0].Description)
+		require.False(t, report.Changes[0].BreaksBackward)
+		require.True(t, report.Changes[0].BreaksForward)
+	})
+
+	t.Run("union branch added, should only break forward compatibility", func(t *testing.T) {
+		t.Parallel()
+
+		report := checkChanges(t, []string{`["null", "hash"]`, `["null", "hash", "string"]`}, compatibility.ModeFull)
+		require.Len(t, report.Changes, 1)
+		require.Equal(t, compatibility.TypeChanged, report.Changes[0].Kind)
+		require.False(t, report.Changes[0].BreaksBackward)
+		require.True(t, report.Changes[0].BreaksForward)
+	})
+
+	t.Run("enum symbol added, should only break forward compatibility", func(t *testing.T) {
+		t.Parallel()
+
+		report := checkChanges(t, []string{`["on-chain", "final"]`, `["on-chain", "final", "reverted"]`}, compatibility.ModeFull)
+		require.Equal(t, []*compatibility.Change{
+			{
+				Kind:          compatibility.EnumSymbolsChanged,
+				Path:          "HyperBlock.Status",
+				Description:   "[on-chain, final] -> [on-chain, final, reverted]",
+				BreaksForward: true,
+			},
+		}, report.Changes)
+	})
+
+	t.Run("record renamed, should break both", func(t *testing.T) {
+		t.Parallel()
+
+		report := checkChanges(t, []string{`"name": "Transaction"`, `"name": "Tx"`}, compatibility.ModeFull)
+		require.Len(t, report.Changes, 1)
+		require.Equal(t, "HyperBlock.Transactions[]", report.Changes[0].Path)
+		require.True(t, report.Changes[0].BreaksBackward)
+		require.True(t, report.Changes[0].BreaksForward)
+	})
+}
@@ -0,0 +1,9 @@
+package compatibility
+
+import "errors"
+
+var errInvalidSchema = errors.New("invalid avro schema")
+
+var errUnknownType = errors.New("unknown avro type")
+
+var errUnsupportedMode = errors.New("unsupported compatibility mode")
@@ -0,0 +1,10 @@
+package compatibility
+
+// ErrInvalidSchema -
+var ErrInvalidSchema = errInvalidSchema
+
+// ErrUnknownType -
+var ErrUnknownType = errUnknownType
+
+// ErrUnsupportedMode -
+var ErrUnsupportedMode = errUnsupportedMode
@@ -0,0 +1,287 @@
+package compatibility
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	kindNull    = "null"
+	kindBoolean = "boolean"
+	kindInt     = "int"
+	kindLong    = "long"
+	kindFloat   = "float"
+	kindDouble  = "double"
+	kindBytes   = "bytes"
+	kindString  = "string"
+	kindRecord  = "record"
+	kindError   = "error"
+	kindEnum    = "enum"
+	kindFixed   = "fixed"
+	kindArray   = "array"
+	kindMap     = "map"
+	kindUnion   = "union"
+)
+
+var primitiveKinds = map[string]struct{}{
+	kindNull:    {},
+	kindBoolean: {},
+	kindInt:     {},
+	kindLong:    {},
+	kindFloat:   {},
+	kindDouble:  {},
+	kindBytes:   {},
+	kindString:  {},
+}
+
+// schemaNode is a parsed avro schema, holding only what matters for schema resolution. Logical types are resolved
+// as their underlying types, while references to named types point to the node of their definition
+type schemaNode struct {
+	kind           string
+	fullName       string
+	fields         []*schemaField
+	symbols        []string
+	hasEnumDefault bool
+	size           int64
+	items          *schemaNode
+	values         *schemaNode
+	branches       []*schemaNode
+}
+
+type schemaField struct {
+	name       string
+	aliases    []string
+	node       *schemaNode
+	hasDefault bool
+}
+
+type schemaParser struct {
+	namedTypes map[string]*schemaNode
+}
+
+func parseSchema(schemaDefinition string) (*schemaNode, error) {
+	decoder := json.NewDecoder(strings.NewReader(schemaDefinition))
+	decoder.UseNumber()
+
+	var schema interface{}
+	err := decoder.Decode(&schema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidSchema, err.Error())
+	}
+
+	parser := &schemaParser{
+		namedTypes: make(map[string]*schemaNode),
+	}
+	return parser.parse(schema, "")
+}
+
+func (sp *schemaParser) parse(schema interface{}, namespace string) (*schemaNode, error) {
+	switch value := schema.(type) {
+	case string:
+		return sp.parseTypeName(value, namespace)
+	case []interface{}:
+		return sp.parseUnion(value, namespace)
+	case map[string]interface{}:
+		return sp.parseComplexType(value, namespace)
+	default:
+		return nil, fmt.Errorf("%w: unexpected type definition %v", errInvalidSchema, schema)
+	}
+}
+
+func (sp *schemaParser) parseTypeName(typeName string, namespace string) (*schemaNode, error) {
+	if _, isPrimitive := primitiveKinds[typeName]; isPrimitive {
+		return &schemaNode{kind: typeName}, nil
+	}
+
+	node, found := sp.namedTypes[getFullName(typeName, namespace)]
+	if found {
+		return node, nil
+	}
+	node, found = sp.namedTypes[typeName]
+	if found {
+		return node, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", errUnknownType, typeName)
+}
+
+func (sp *schemaParser) parseUnion(branches []interface{}, namespace string) (*schemaNode, error) {
+	node := &schemaNode{
+		kind:     kindUnion,
+		branches: make([]*schemaNode, 0, len(branches)),
+	}
+	for _, branch := range branches {
+		branchNode, err := sp.parse(branch, namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		node.branches = append(node.branches, branchNode)
+	}
+
+	return node, nil
+}
+
+func (sp *schemaParser) parseComplexType(definition map[string]interface{}, namespace string) (*schemaNode, error) {
+	typeName, isTypeName := definition["type"].(string)
+	if !isTypeName {
+		return sp.parse(definition["type"], namespace)
+	}
+
+	switch typeName {
+	case kindRecord, kindError:
+		return sp.parseRecord(definition, namespace)
+	case kindEnum:
+		return sp.parseEnum(definition, namespace)
+	case kindFixed:
+		return sp.parseFixed(definition, namespace)
+	case kindArray:
+		items, err := sp.parse(definition["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		return &schemaNode{kind: kindArray, items: items}, nil
+	case kindMap:
+		values, err := sp.parse(definition["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		return &schemaNode{kind: kindMap, values: values}, nil
+	default:
+		return sp.parseTypeName(typeName, namespace)
+	}
+}
+
+func (sp *schemaParser) parseRecord(definition map[string]interface{}, namespace string) (*schemaNode, error) {
+	node, namespace, err := sp.registerNamedType(kindRecord, definition, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, isList := definition["fields"].([]interface{})
+	if !isList {
+		return nil, fmt.Errorf("%w: record %s has no fields list", errInvalidSchema, node.fullName)
+	}
+
+	for _, field := range fields {
+		fieldDefinition, isObject := field.(map[string]interface{})
+		if !isObject {
+			return nil, fmt.Errorf("%w: unexpected field definition %v in record %s", errInvalidSchema, field, node.fullName)
+		}
+
+		fieldName, isName := fieldDefinition["name"].(string)
+		if !isName || len(fieldName) == 0 {
+			return nil, fmt.Errorf("%w: field without name in record %s", errInvalidSchema, node.fullName)
+		}
+
+		fieldNode, errField := sp.parse(fieldDefinition["type"], namespace)
+		if errField != nil {
+			return nil, errField
+		}
+
+		_, hasDefault := fieldDefinition["default"]
+		node.fields = append(node.fields, &schemaField{
+			name:       fieldName,
+			aliases:    getStrings(fieldDefinition["aliases"]),
+			node:       fieldNode,
+			hasDefault: hasDefault,
+		})
+	}
+
+	return node, nil
+}
+
+func (sp *schemaParser) parseEnum(definition map[string]interface{}, namespace string) (*schemaNode, error) {
+	node, _, err := sp.registerNamedType(kindEnum, definition, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	node.symbols = getStrings(definition["symbols"])
+	_, node.hasEnumDefault = definition["default"]
+
+	return node, nil
+}
+
+func (sp *schemaParser) parseFixed(definition map[string]interface{}, namespace string) (*schemaNode, error) {
+	node, _, err := sp.registerNamedType(kindFixed, definition, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	size, isNumber := definition["size"].(json.Number)
+	if !isNumber {
+		return nil, fmt.Errorf("%w: fixed %s has no size", errInvalidSchema, node.fullName)
+	}
+	node.size, err = size.Int64()
+	if err != nil {
+		return nil, fmt.Errorf("%w: fixed %s has invalid size %s", errInvalidSchema, node.fullName, size)
+	}
+
+	return node, nil
+}
+
+// registerNamedType registers a new named type node, before parsing its definition, so that the definition can refer
+// to it. It also returns the namespace of the named type, which is the default one for the types it defines
+func (sp *schemaParser) registerNamedType(
+	kind string,
+	definition map[string]interface{},
+	namespace string,
+) (*schemaNode, string, error) {
+	name, isName := definition["name"].(string)
+	if !isName || len(name) == 0 {
+		return nil, "", fmt.Errorf("%w: %s without name", errInvalidSchema, kind)
+	}
+	if definedNamespace, isNamespace := definition["namespace"].(string); isNamespace {
+		namespace = definedNamespace
+	}
+
+	fullName := getFullName(name, namespace)
+	if _, found := sp.namedTypes[fullName]; found {
+		return nil, "", fmt.Errorf("%w: %s is defined twice", errInvalidSchema, fullName)
+	}
+
+	node := &schemaNode{
+		kind:     kind,
+		fullName: fullName,
+	}
+	sp.namedTypes[fullName] = node
+
+	lastDotIndex := strings.LastIndex(fullName, ".")
+	if lastDotIndex < 0 {
+		return node, "", nil
+	}
+
+	return node, fullName[:lastDotIndex], nil
+}
+
+func getFullName(name string, namespace string) string {
+	if strings.Contains(name, ".") || len(namespace) == 0 {
+		return name
+	}
+
+	return namespace + "." + name
+}
+
+func getShortName(fullName string) string {
+	return fullName[strings.LastIndex(fullName, ".")+1:]
+}
+
+func getStrings(value interface{}) []string {
+	list, isList := value.([]interface{})
+	if !isList {
+		return nil
+	}
+
+	strs := make([]string, 0, len(list))
+	for _, item := range list {
+		if str, isString := item.(string); isString {
+			strs = append(strs, str)
+		}
+	}
+
+	return strs
+}
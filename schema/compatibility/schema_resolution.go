@@ -0,0 +1,150 @@
+package compatibility
+
+// promotions holds, for each writer primitive type, the reader primitive types it can be promoted to
+var promotions = map[string][]string{
+	kindInt:    {kindLong, kindFloat, kindDouble},
+	kindLong:   {kindFloat, kindDouble},
+	kindFloat:  {kindDouble},
+	kindString: {kindBytes},
+	kindBytes:  {kindString},
+}
+
+type nodesPair struct {
+	reader *schemaNode
+	writer *schemaNode
+}
+
+// schemaResolver checks whether data written with a writer schema can be read with a reader schema, following the
+// avro schema resolution rules
+type schemaResolver struct {
+	results map[nodesPair]bool
+}
+
+func canRead(reader *schemaNode, writer *schemaNode) bool {
+	resolver := &schemaResolver{
+		results: make(map[nodesPair]bool),
+	}
+
+	return resolver.canRead(reader, writer)
+}
+
+func (sr *schemaResolver) canRead(reader *schemaNode, writer *schemaNode) bool {
+	pair := nodesPair{reader: reader, writer: writer}
+	result, found := sr.results[pair]
+	if found {
+		return result
+	}
+
+	// recursive types are assumed to be readable, while being resolved
+	sr.results[pair] = true
+	result = sr.resolve(reader, writer)
+	sr.results[pair] = result
+
+	return result
+}
+
+func (sr *schemaResolver) resolve(reader *schemaNode, writer *schemaNode) bool {
+	if writer.kind == kindUnion {
+		for _, branch := range writer.branches {
+			if !sr.canRead(reader, branch) {
+				return false
+			}
+		}
+
+		return true
+	}
+	if reader.kind == kindUnion {
+		for _, branch := range reader.branches {
+			if sr.canRead(branch, writer) {
+				return true
+			}
+		}
+
+		return false
+	}
+	if reader.kind != writer.kind {
+		return isPromotable(writer.kind, reader.kind)
+	}
+
+	switch reader.kind {
+	case kindRecord:
+		return haveSameName(reader, writer) && sr.canReadFields(reader, writer)
+	case kindEnum:
+		return haveSameName(reader, writer) && (reader.hasEnumDefault || containsAll(reader.symbols, writer.symbols))
+	case kindFixed:
+		return haveSameName(reader, writer) && reader.size == writer.size
+	case kindArray:
+		return sr.canRead(reader.items, writer.items)
+	case kindMap:
+		return sr.canRead(reader.values, writer.values)
+	default:
+		return true
+	}
+}
+
+// canReadFields returns true if each reader field is either found in the writer record, with a readable type, or has a
+// default value. Writer fields missing from the reader record are skipped
+func (sr *schemaResolver) canReadFields(reader *schemaNode, writer *schemaNode) bool {
+	for _, readerField := range reader.fields {
+		writerField := findField(writer.fields, readerField)
+		if writerField == nil {
+			if !readerField.hasDefault {
+				return false
+			}
+
+			continue
+		}
+
+		if !sr.canRead(readerField.node, writerField.node) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// findField returns the field matching the name or one of the aliases of the provided field
+func findField(fields []*schemaField, field *schemaField) *schemaField {
+	for _, candidate := range fields {
+		if candidate.name == field.name {
+			return candidate
+		}
+	}
+	for _, alias := range field.aliases {
+		for _, candidate := range fields {
+			if candidate.name == alias {
+				return candidate
+			}
+		}
+	}
+
+	return nil
+}
+
+func isPromotable(writerKind string, readerKind string) bool {
+	for _, promotedKind := range promotions[writerKind] {
+		if promotedKind == readerKind {
+			return true
+		}
+	}
+
+	return false
+}
+
+func haveSameName(first *schemaNode, second *schemaNode) bool {
+	return getShortName(first.fullName) == getShortName(second.fullName)
+}
+
+func containsAll(strs []string, subset []string) bool {
+	set := make(map[string]struct{}, len(strs))
+	for _, str := range strs {
+		set[str] = struct{}{}
+	}
+	for _, str := range subset {
+		if _, found := set[str]; !found {
+			return false
+		}
+	}
+
+	return true
+}
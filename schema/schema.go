@@ -19,6 +19,7 @@ type HyperBlock struct {
 	ShardBlocks            []*ShardBlocks
 	Transactions           []*Transaction
 	Status                 string
+	EpochStartShardsData   []*EpochStartShardData
 }
 
 func NewHyperBlock() *HyperBlock {
@@ -279,6 +280,54 @@ func (o *Event) Schema() avro.Schema {
 	return _Event_schema
 }
 
+type EpochStartShardData struct {
+	ShardID                 int32
+	Epoch                   int32
+	Round                   int64
+	Nonce                   int64
+	HeaderHash              []byte
+	RootHash                []byte
+	ScheduledRootHash       []byte
+	FirstPendingMetaBlock   []byte
+	LastFinishedMetaBlock   []byte
+	PendingMiniBlockHeaders []*PendingMiniBlockHeader
+}
+
+func NewEpochStartShardData() *EpochStartShardData {
+	return &EpochStartShardData{}
+}
+
+func (o *EpochStartShardData) Schema() avro.Schema {
+	if _EpochStartShardData_schema_err != nil {
+		panic(_EpochStartShardData_schema_err)
+	}
+	return _EpochStartShardData_schema
+}
+
+type PendingMiniBlockHeader struct {
+	Hash                    []byte
+	Type                    string
+	ProcessingType          string
+	ConstructionState       string
+	SourceShard             int32
+	DestinationShard        int32
+	IndexOfFirstTxProcessed int32
+	IndexOfLastTxProcessed  int32
+}
+
+func NewPendingMiniBlockHeader() *PendingMiniBlockHeader {
+	return &PendingMiniBlockHeader{
+		Hash: make([]byte, 32),
+	}
+}
+
+func (o *PendingMiniBlockHeader) Schema() avro.Schema {
+	if _PendingMiniBlockHeader_schema_err != nil {
+		panic(_PendingMiniBlockHeader_schema_err)
+	}
+	return _PendingMiniBlockHeader_schema
+}
+
 // Generated by codegen. Please do not modify.
 var _HyperBlock_schema, _HyperBlock_schema_err = avro.ParseSchema(`{
     "type": "record",
@@ -957,6 +1006,150 @@ var _HyperBlock_schema, _HyperBlock_schema_err = avro.ParseSchema(`{
         {
             "name": "Status",
             "type": "string"
+        },
+        {
+            "name": "EpochStartShardsData",
+            "default": null,
+            "type": [
+                "null",
+                {
+                    "type": "array",
+                    "items": {
+                        "type": "record",
+                        "name": "EpochStartShardData",
+                        "fields": [
+                            {
+                                "name": "ShardID",
+                                "type": "int"
+                            },
+                            {
+                                "name": "Epoch",
+                                "type": "int"
+                            },
+                            {
+                                "name": "Round",
+                                "type": "long"
+                            },
+                            {
+                                "name": "Nonce",
+                                "type": "long"
+                            },
+                            {
+                                "name": "HeaderHash",
+                                "default": null,
+                                "type": [
+                                    "null",
+                                    {
+                                        "type": "fixed",
+                                        "size": 32,
+                                        "name": "hash"
+                                    }
+                                ]
+                            },
+                            {
+                                "name": "RootHash",
+                                "default": null,
+                                "type": [
+                                    "null",
+                                    {
+                                        "type": "fixed",
+                                        "size": 32,
+                                        "name": "hash"
+                                    }
+                                ]
+                            },
+                            {
+                                "name": "ScheduledRootHash",
+                                "default": null,
+                                "type": [
+                                    "null",
+                                    {
+                                        "type": "fixed",
+                                        "size": 32,
+                                        "name": "hash"
+                                    }
+                                ]
+                            },
+                            {
+                                "name": "FirstPendingMetaBlock",
+                                "default": null,
+                                "type": [
+                                    "null",
+                                    {
+                                        "type": "fixed",
+                                        "size": 32,
+                                        "name": "hash"
+                                    }
+                                ]
+                            },
+                            {
+                                "name": "LastFinishedMetaBlock",
+                                "default": null,
+                                "type": [
+                                    "null",
+                                    {
+                                        "type": "fixed",
+                                        "size": 32,
+                                        "name": "hash"
+                                    }
+                                ]
+                            },
+                            {
+                                "name": "PendingMiniBlockHeaders",
+                                "default": null,
+                                "type": [
+                                    "null",
+                                    {
+                                        "type": "array",
+                                        "items": {
+                                            "type": "record",
+                                            "name": "PendingMiniBlockHeader",
+                                            "fields": [
+                                                {
+                                                    "name": "Hash",
+                                                    "type": {
+                                                        "type": "fixed",
+                                                        "size": 32,
+                                                        "name": "hash"
+                                                    }
+                                                },
+                                                {
+                                                    "name": "Type",
+                                                    "type": "string"
+                                                },
+                                                {
+                                                    "name": "ProcessingType",
+                                                    "type": "string"
+                                                },
+                                                {
+                                                    "name": "ConstructionState",
+                                                    "type": "string"
+                                                },
+                                                {
+                                                    "name": "SourceShard",
+                                                    "type": "int"
+                                                },
+                                                {
+                                                    "name": "DestinationShard",
+                                                    "type": "int"
+                                                },
+                                                {
+                                                    "name": "IndexOfFirstTxProcessed",
+                                                    "type": "int"
+                                                },
+                                                {
+                                                    "name": "IndexOfLastTxProcessed",
+                                                    "type": "int"
+                                                }
+                                            ]
+                                        }
+                                    }
+                                ]
+                            }
+                        ]
+                    }
+                }
+            ]
         }
     ]
 }`)
@@ -1746,3 +1939,183 @@ var _Event_schema, _Event_schema_err = avro.ParseSchema(`{
         }
     ]
 }`)
+
+// Generated by codegen. Please do not modify.
+var _EpochStartShardData_schema, _EpochStartShardData_schema_err = avro.ParseSchema(`{
+    "type": "record",
+    "name": "EpochStartShardData",
+    "fields": [
+        {
+            "name": "ShardID",
+            "type": "int"
+        },
+        {
+            "name": "Epoch",
+            "type": "int"
+        },
+        {
+            "name": "Round",
+            "type": "long"
+        },
+        {
+            "name": "Nonce",
+            "type": "long"
+        },
+        {
+            "name": "HeaderHash",
+            "default": null,
+            "type": [
+                "null",
+                {
+                    "type": "fixed",
+                    "size": 32,
+                    "name": "hash"
+                }
+            ]
+        },
+        {
+            "name": "RootHash",
+            "default": null,
+            "type": [
+                "null",
+                {
+                    "type": "fixed",
+                    "size": 32,
+                    "name": "hash"
+                }
+            ]
+        },
+        {
+            "name": "ScheduledRootHash",
+            "default": null,
+            "type": [
+                "null",
+                {
+                    "type": "fixed",
+                    "size": 32,
+                    "name": "hash"
+                }
+            ]
+        },
+        {
+            "name": "FirstPendingMetaBlock",
+            "default": null,
+            "type": [
+                "null",
+                {
+                    "type": "fixed",
+                    "size": 32,
+                    "name": "hash"
+                }
+            ]
+        },
+        {
+            "name": "LastFinishedMetaBlock",
+            "default": null,
+            "type": [
+                "null",
+                {
+                    "type": "fixed",
+                    "size": 32,
+                    "name": "hash"
+                }
+            ]
+        },
+        {
+            "name": "PendingMiniBlockHeaders",
+            "default": null,
+            "type": [
+                "null",
+                {
+                    "type": "array",
+                    "items": {
+                        "type": "record",
+                        "name": "PendingMiniBlockHeader",
+                        "fields": [
+                            {
+                                "name": "Hash",
+                                "type": {
+                                    "type": "fixed",
+                                    "size": 32,
+                                    "name": "hash"
+                                }
+                            },
+                            {
+                                "name": "Type",
+                                "type": "string"
+                            },
+                            {
+                                "name": "ProcessingType",
+                                "type": "string"
+                            },
+                            {
+                                "name": "ConstructionState",
+                                "type": "string"
+                            },
+                            {
+                                "name": "SourceShard",
+                                "type": "int"
+                            },
+                            {
+                                "name": "DestinationShard",
+                                "type": "int"
+                            },
+                            {
+                                "name": "IndexOfFirstTxProcessed",
+                                "type": "int"
+                            },
+                            {
+                                "name": "IndexOfLastTxProcessed",
+                                "type": "int"
+                            }
+                        ]
+                    }
+                }
+            ]
+        }
+    ]
+}`)
+
+// Generated by codegen. Please do not modify.
+var _PendingMiniBlockHeader_schema, _PendingMiniBlockHeader_schema_err = avro.ParseSchema(`{
+    "type": "record",
+    "name": "PendingMiniBlockHeader",
+    "fields": [
+        {
+            "name": "Hash",
+            "type": {
+                "type": "fixed",
+                "size": 32,
+                "name": "hash"
+            }
+        },
+        {
+            "name": "Type",
+            "type": "string"
+        },
+        {
+            "name": "ProcessingType",
+            "type": "string"
+        },
+        {
+            "name": "ConstructionState",
+            "type": "string"
+        },
+        {
+            "name": "SourceShard",
+            "type": "int"
+        },
+        {
+            "name": "DestinationShard",
+            "type": "int"
+        },
+        {
+            "name": "IndexOfFirstTxProcessed",
+            "type": "int"
+        },
+        {
+            "name": "IndexOfLastTxProcessed",
+            "type": "int"
+        }
+    ]
+}`)
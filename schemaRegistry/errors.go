@@ -0,0 +1,11 @@
+package schemaRegistry
+
+import "errors"
+
+var errEmptyUrl = errors.New("empty schema registry url provided")
+
+var errInvalidRequestTimeout = errors.New("invalid request timeout")
+
+var errSchemaNotRegistered = errors.New("schema not registered")
+
+var errRegistryResponse = errors.New("schema registry error response")
@@ -0,0 +1,135 @@
+package schemaRegistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	contentType = "application/vnd.schemaregistry.v1+json"
+	// errorCodeSubjectNotFound and errorCodeSchemaNotFound are returned by the registry, along with 404 status code,
+	// when looking up a schema which is not registered under a subject
+	errorCodeSubjectNotFound = 40401
+	errorCodeSchemaNotFound  = 40403
+)
+
+var log = logger.GetOrCreate("schemaRegistry")
+
+// ArgsSchemaRegistryClient holds all input dependencies required by schema registry client
+type ArgsSchemaRegistryClient struct {
+	Url               string
+	AutoRegister      bool
+	RequestTimeOutSec uint64
+}
+
+type schemaRequest struct {
+	Schema string `json:"schema"`
+}
+
+type schemaIdResponse struct {
+	Id uint32 `json:"id"`
+}
+
+type errorResponse struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// schemaRegistryClient is a client of the confluent schema registry REST API
+type schemaRegistryClient struct {
+	url          string
+	autoRegister bool
+	httpClient   *http.Client
+}
+
+// NewSchemaRegistryClient creates a confluent schema registry client
+func NewSchemaRegistryClient(args ArgsSchemaRegistryClient) (*schemaRegistryClient, error) {
+	if len(args.Url) == 0 {
+		return nil, errEmptyUrl
+	}
+	if args.RequestTimeOutSec == 0 {
+		return nil, fmt.Errorf("%w; expected non zero value", errInvalidRequestTimeout)
+	}
+
+	return &schemaRegistryClient{
+		url:          strings.TrimSuffix(args.Url, "/"),
+		autoRegister: args.AutoRegister,
+		httpClient:   &http.Client{Timeout: time.Duration(args.RequestTimeOutSec) * time.Second},
+	}, nil
+}
+
+// GetSchemaId returns the id of the schema registered under the provided subject. If the schema is not registered and
+// auto registration is enabled, it is registered as a new version of the subject
+func (src *schemaRegistryClient) GetSchemaId(ctx context.Context, subject string, schemaDefinition string) (uint32, error) {
+	subjectPath := fmt.Sprintf("/subjects/%s", url.PathEscape(subject))
+	schemaId, err := src.postSchema(ctx, subjectPath, schemaDefinition)
+	if !errors.Is(err, errSchemaNotRegistered) || !src.autoRegister {
+		return schemaId, err
+	}
+
+	log.Info("registering schema", "subject", subject)
+	return src.postSchema(ctx, subjectPath+"/versions", schemaDefinition)
+}
+
+func (src *schemaRegistryClient) postSchema(ctx context.Context, path string, schemaDefinition string) (uint32, error) {
+	requestBody, err := json.Marshal(&schemaRequest{Schema: schemaDefinition})
+	if err != nil {
+		return 0, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, src.url+path, bytes.NewReader(requestBody))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set("Accept", contentType)
+
+	response, err := src.httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		log.LogIfError(response.Body.Close())
+	}()
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return 0, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return 0, createResponseError(response.StatusCode, responseBody)
+	}
+
+	schemaIdResp := &schemaIdResponse{}
+	err = json.Unmarshal(responseBody, schemaIdResp)
+	if err != nil {
+		return 0, err
+	}
+
+	return schemaIdResp.Id, nil
+}
+
+func createResponseError(statusCode int, responseBody []byte) error {
+	errorResp := &errorResponse{}
+	err := json.Unmarshal(responseBody, errorResp)
+	if err != nil {
+		return fmt.Errorf("%w: status code: %d, body: %s", errRegistryResponse, statusCode, string(responseBody))
+	}
+
+	isNotRegistered := errorResp.ErrorCode == errorCodeSubjectNotFound || errorResp.ErrorCode == errorCodeSchemaNotFound
+	if statusCode == http.StatusNotFound && isNotRegistered {
+		return fmt.Errorf("%w: %s", errSchemaNotRegistered, errorResp.Message)
+	}
+
+	return fmt.Errorf("%w: status code: %d, error code: %d, message: %s", errRegistryResponse, statusCode, errorResp.ErrorCode, errorResp.Message)
+}
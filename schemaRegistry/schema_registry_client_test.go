@@ -0,0 +1,227 @@
+package schemaRegistry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSubject = "hyperblocks-value"
+
+const testSchema = `{"type": "record", "name": "HyperBlock", "fields": [{"name": "nonce", "type": "long"}]}`
+
+// registryStub is a local http stub of the schema registry, holding the registered schemas of each subject
+type registryStub struct {
+	mutex           sync.Mutex
+	schemaIds       map[string]map[string]uint32
+	nextSchemaId    uint32
+	numRegistered   int
+	responseOnError int
+}
+
+func newRegistryStub() *registryStub {
+	return &registryStub{
+		schemaIds:    make(map[string]map[string]uint32),
+		nextSchemaId: 1,
+	}
+}
+
+func (rs *registryStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if r.Method != http.MethodPost || r.Header.Get("Content-Type") != contentType {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if rs.responseOnError != 0 {
+		writeJson(w, rs.responseOnError, &errorResponse{ErrorCode: 50001, Message: "error in the backend datastore"})
+		return
+	}
+
+	request := &schemaRequest{}
+	err := json.NewDecoder(r.Body).Decode(request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/subjects/")
+	isRegisterRequest := strings.HasSuffix(path, "/versions")
+	subject := strings.TrimSuffix(path, "/versions")
+	subjectSchemaIds, found := rs.schemaIds[subject]
+	if !found {
+		if !isRegisterRequest {
+			writeJson(w, http.StatusNotFound, &errorResponse{ErrorCode: errorCodeSubjectNotFound, Message: "Subject not found"})
+			return
+		}
+
+		subjectSchemaIds = make(map[string]uint32)
+		rs.schemaIds[subject] = subjectSchemaIds
+	}
+
+	schemaId, found := subjectSchemaIds[request.Schema]
+	if !found {
+		if !isRegisterRequest {
+			writeJson(w, http.StatusNotFound, &errorResponse{ErrorCode: errorCodeSchemaNotFound, Message: "Schema not found"})
+			return
+		}
+
+		schemaId = rs.nextSchemaId
+		rs.nextSchemaId++
+		rs.numRegistered++
+		subjectSchemaIds[request.Schema] = schemaId
+	}
+
+	writeJson(w, http.StatusOK, &schemaIdResponse{Id: schemaId})
+}
+
+func writeJson(w http.ResponseWriter, statusCode int, response interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func createMockArgsSchemaRegistryClient(url string) ArgsSchemaRegistryClient {
+	return ArgsSchemaRegistryClient{
+		Url:               url,
+		AutoRegister:      true,
+		RequestTimeOutSec: 5,
+	}
+}
+
+func TestNewSchemaRegistryClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewSchemaRegistryClient(createMockArgsSchemaRegistryClient("http://localhost:8081/"))
+		require.Nil(t, err)
+		require.Equal(t, "http://localhost:8081", client.url)
+	})
+
+	t.Run("empty url, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewSchemaRegistryClient(createMockArgsSchemaRegistryClient(""))
+		require.Nil(t, client)
+		require.Equal(t, errEmptyUrl, err)
+	})
+
+	t.Run("invalid request timeout, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSchemaRegistryClient("http://localhost:8081")
+		args.RequestTimeOutSec = 0
+		client, err := NewSchemaRegistryClient(args)
+		require.Nil(t, client)
+		require.True(t, errors.Is(err, errInvalidRequestTimeout))
+	})
+}
+
+func TestSchemaRegistryClient_GetSchemaId(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not registered schema, should register it once", func(t *testing.T) {
+		t.Parallel()
+
+		registry := newRegistryStub()
+		server := httptest.NewServer(registry)
+		defer server.Close()
+
+		client, _ := NewSchemaRegistryClient(createMockArgsSchemaRegistryClient(server.URL))
+		schemaId, err := client.GetSchemaId(context.Background(), testSubject, testSchema)
+		require.Nil(t, err)
+		require.Equal(t, uint32(1), schemaId)
+
+		schemaId, err = client.GetSchemaId(context.Background(), testSubject, testSchema)
+		require.Nil(t, err)
+		require.Equal(t, uint32(1), schemaId)
+
+		schemaId, err = client.GetSchemaId(context.Background(), testSubject, `{"type": "string"}`)
+		require.Nil(t, err)
+		require.Equal(t, uint32(2), schemaId)
+		require.Equal(t, 2, registry.numRegistered)
+	})
+
+	t.Run("not registered schema, auto register disabled, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := newRegistryStub()
+		server := httptest.NewServer(registry)
+		defer server.Close()
+
+		args := createMockArgsSchemaRegistryClient(server.URL)
+		args.AutoRegister = false
+		client, _ := NewSchemaRegistryClient(args)
+
+		_, err := client.GetSchemaId(context.Background(), testSubject, testSchema)
+		require.True(t, errors.Is(err, errSchemaNotRegistered))
+		require.Contains(t, err.Error(), "Subject not found")
+
+		registry.schemaIds[testSubject] = map[string]uint32{`{"type": "string"}`: 4}
+		_, err = client.GetSchemaId(context.Background(), testSubject, testSchema)
+		require.True(t, errors.Is(err, errSchemaNotRegistered))
+		require.Contains(t, err.Error(), "Schema not found")
+
+		registry.schemaIds[testSubject][testSchema] = 5
+		schemaId, err := client.GetSchemaId(context.Background(), testSubject, testSchema)
+		require.Nil(t, err)
+		require.Equal(t, uint32(5), schemaId)
+		require.Zero(t, registry.numRegistered)
+	})
+
+	t.Run("registry error response, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := newRegistryStub()
+		registry.responseOnError = http.StatusInternalServerError
+		server := httptest.NewServer(registry)
+		defer server.Close()
+
+		client, _ := NewSchemaRegistryClient(createMockArgsSchemaRegistryClient(server.URL))
+		_, err := client.GetSchemaId(context.Background(), testSubject, testSchema)
+		require.True(t, errors.Is(err, errRegistryResponse))
+		require.Contains(t, err.Error(), "error code: 50001")
+	})
+
+	t.Run("invalid response, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte("bad gateway"))
+		}))
+		defer server.Close()
+
+		client, _ := NewSchemaRegistryClient(createMockArgsSchemaRegistryClient(server.URL))
+		_, err := client.GetSchemaId(context.Background(), testSubject, testSchema)
+		require.True(t, errors.Is(err, errRegistryResponse))
+		require.Contains(t, err.Error(), "bad gateway")
+	})
+
+	t.Run("subject should be escaped", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPath := ""
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestedPath = r.URL.EscapedPath()
+			writeJson(w, http.StatusOK, &schemaIdResponse{Id: 3})
+		}))
+		defer server.Close()
+
+		client, _ := NewSchemaRegistryClient(createMockArgsSchemaRegistryClient(server.URL))
+		schemaId, err := client.GetSchemaId(context.Background(), "hyper blocks/value", testSchema)
+		require.Nil(t, err)
+		require.Equal(t, uint32(3), schemaId)
+		require.Equal(t, "/subjects/hyper%20blocks%2Fvalue", requestedPath)
+	})
+}
@@ -0,0 +1,17 @@
+package sink
+
+import "errors"
+
+var errNilHyperBlocksFacade = errors.New("nil hyper blocks facade provided")
+
+var errNilMessageProducer = errors.New("nil message producer provided")
+
+var errEmptyTopic = errors.New("empty topic provided")
+
+var errEmptyCheckpointFile = errors.New("empty checkpoint file provided")
+
+var errInvalidBatchSize = errors.New("invalid batch size")
+
+var errInvalidPollingInterval = errors.New("invalid polling interval")
+
+var errUnexpectedNumHyperBlocks = errors.New("unexpected number of hyper blocks received")
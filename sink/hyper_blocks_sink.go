@@ -0,0 +1,200 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/polling"
+	"github.com/multiversx/mx-chain-covalent-go/sink/kafka"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// HeaderSchemaId is the name of the message header holding the id of the avro schema of the hyper block. It is only
+// sent if a schema id is known
+const HeaderSchemaId = "schema.id"
+
+var log = logger.GetOrCreate("sink")
+
+// ArgsHyperBlocksSink holds all input dependencies required by hyper blocks sink
+type ArgsHyperBlocksSink struct {
+	Facade          HyperBlocksFacade
+	Producer        MessageProducer
+	Topic           string
+	SchemaId        uint32
+	QueryOptions    config.HyperBlocksQueryOptions
+	CheckpointFile  string
+	PollingInterval time.Duration
+}
+
+type hyperBlocksSink struct {
+	facade          HyperBlocksFacade
+	producer        MessageProducer
+	topic           string
+	headers         []kafka.Header
+	queryOptions    config.HyperBlocksQueryOptions
+	checkpointFile  string
+	pollingInterval time.Duration
+}
+
+// NewHyperBlocksSink will create a sink, which publishes each avro encoded hyper block as a message keyed by its
+// nonce, keeping a checkpoint of the published nonces
+func NewHyperBlocksSink(args ArgsHyperBlocksSink) (*hyperBlocksSink, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(args.CheckpointFile), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hyperBlocksSink{
+		facade:          args.Facade,
+		producer:        args.Producer,
+		topic:           args.Topic,
+		headers:         createHeaders(args.SchemaId),
+		queryOptions:    args.QueryOptions,
+		checkpointFile:  args.CheckpointFile,
+		pollingInterval: args.PollingInterval,
+	}, nil
+}
+
+// createHeaders returns the headers of each published message. A zero schema id is not a valid schema registry id,
+// so no schema id header is sent in this case
+func createHeaders(schemaId uint32) []kafka.Header {
+	if schemaId == 0 {
+		return nil
+	}
+
+	return []kafka.Header{
+		{Key: HeaderSchemaId, Value: []byte(strconv.FormatUint(uint64(schemaId), 10))},
+	}
+}
+
+func checkArgs(args ArgsHyperBlocksSink) error {
+	if args.Facade == nil {
+		return errNilHyperBlocksFacade
+	}
+	if args.Producer == nil {
+		return errNilMessageProducer
+	}
+	if len(args.Topic) == 0 {
+		return errEmptyTopic
+	}
+	if len(args.CheckpointFile) == 0 {
+		return errEmptyCheckpointFile
+	}
+	if args.QueryOptions.BatchSize == 0 {
+		return fmt.Errorf("%w; expected non zero value", errInvalidBatchSize)
+	}
+	if args.PollingInterval == 0 {
+		return fmt.Errorf("%w; expected non zero value", errInvalidPollingInterval)
+	}
+
+	return nil
+}
+
+// Run keeps publishing hyper blocks, starting from the provided nonce, or from the checkpoint, if it holds a higher
+// one. Only final hyper blocks are published, since published messages are never retracted if they are reverted:
+// once the highest final hyper block is published, it keeps waiting for new ones to become final, until the context
+// is done.
+// Delivery is at least once: the checkpoint is only saved after a batch of hyper blocks is acknowledged, so the
+// batch which was being published when the sink stopped is published again after a restart
+func (hbs *hyperBlocksSink) Run(ctx context.Context, startNonce uint64) error {
+	nonce, err := polling.GetResumeNonce(hbs.checkpointFile, startNonce)
+	if err != nil {
+		return err
+	}
+
+	for {
+		if polling.IsContextDone(ctx) {
+			log.Info("sink stopped", "next nonce", nonce)
+			return nil
+		}
+
+		highestFinalNonce, err := hbs.facade.GetHighestFinalHyperBlockNonce(ctx)
+		if err != nil {
+			if polling.IsContextDone(ctx) {
+				continue
+			}
+
+			log.Warn("could not get highest final hyper block nonce, retrying", "error", err)
+			polling.Wait(ctx, hbs.pollingInterval)
+			continue
+		}
+
+		if nonce > highestFinalNonce {
+			polling.Wait(ctx, hbs.pollingInterval)
+			continue
+		}
+
+		batchEndNonce := core.MinUint64(nonce+uint64(hbs.queryOptions.BatchSize)-1, highestFinalNonce)
+		hyperBlocks, err := hbs.getHyperBlocks(ctx, nonce, batchEndNonce)
+		if err != nil {
+			if polling.IsContextDone(ctx) {
+				continue
+			}
+
+			log.Warn("could not get hyper blocks, retrying", "start nonce", nonce, "end nonce", batchEndNonce, "error", err)
+			polling.Wait(ctx, hbs.pollingInterval)
+			continue
+		}
+
+		err = hbs.producer.Produce(ctx, hbs.topic, hbs.createMessages(nonce, hyperBlocks))
+		if err != nil {
+			if polling.IsContextDone(ctx) {
+				continue
+			}
+
+			log.Warn("could not publish hyper blocks, retrying", "start nonce", nonce, "end nonce", batchEndNonce, "error", err)
+			polling.Wait(ctx, hbs.pollingInterval)
+			continue
+		}
+
+		err = polling.SaveCheckpoint(hbs.checkpointFile, &polling.Checkpoint{NextNonce: batchEndNonce + 1})
+		if err != nil {
+			return err
+		}
+
+		log.Debug("published hyper blocks", "start nonce", nonce, "end nonce", batchEndNonce)
+		nonce = batchEndNonce + 1
+	}
+}
+
+func (hbs *hyperBlocksSink) getHyperBlocks(ctx context.Context, startNonce uint64, endNonce uint64) ([][]byte, error) {
+	hyperBlocks, err := hbs.facade.GetHyperBlocksByInterval(ctx, &api.Interval{
+		Start: startNonce,
+		End:   endNonce,
+	}, hbs.queryOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedNumHyperBlocks := endNonce - startNonce + 1
+	if uint64(len(hyperBlocks.Data)) != expectedNumHyperBlocks {
+		return nil, fmt.Errorf("%w: expected %d, got %d", errUnexpectedNumHyperBlocks, expectedNumHyperBlocks, len(hyperBlocks.Data))
+	}
+
+	return hyperBlocks.Data, nil
+}
+
+func (hbs *hyperBlocksSink) createMessages(startNonce uint64, hyperBlocks [][]byte) []*kafka.Message {
+	messages := make([]*kafka.Message, 0, len(hyperBlocks))
+	for idx, encodedHyperBlock := range hyperBlocks {
+		messages = append(messages, &kafka.Message{
+			Key:     []byte(strconv.FormatUint(startNonce+uint64(idx), 10)),
+			Value:   encodedHyperBlock,
+			Headers: hbs.headers,
+		})
+	}
+
+	return messages
+}
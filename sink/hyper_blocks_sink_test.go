@@ -0,0 +1,342 @@
+package sink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/polling"
+	"github.com/multiversx/mx-chain-covalent-go/sink/kafka"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock/apiMocks"
+	"github.com/stretchr/testify/require"
+)
+
+const testTopic = "hyperblocks"
+
+func createMockArgsHyperBlocksSink(t *testing.T) ArgsHyperBlocksSink {
+	return ArgsHyperBlocksSink{
+		Facade:   &apiMocks.HyperBlockFacadeStub{},
+		Producer: &mock.MessageProducerStub{},
+		Topic:    testTopic,
+		SchemaId: 7,
+		QueryOptions: config.HyperBlocksQueryOptions{
+			BatchSize: 3,
+		},
+		CheckpointFile:  filepath.Join(t.TempDir(), "sink", "checkpoint.json"),
+		PollingInterval: time.Millisecond,
+	}
+}
+
+func encodeHyperBlock(nonce uint64) []byte {
+	return []byte(fmt.Sprintf("hyperBlock%d", nonce))
+}
+
+// createRecordingProducerStub returns a producer stub recording the keys of the produced messages, which cancels the
+// context once the message with the provided last key is produced
+func createRecordingProducerStub(t *testing.T, producedKeys *[]string, lastKey string, cancel func()) *mock.MessageProducerStub {
+	return &mock.MessageProducerStub{
+		ProduceCalled: func(ctx context.Context, topic string, messages []*kafka.Message) error {
+			require.Equal(t, testTopic, topic)
+			for _, message := range messages {
+				*producedKeys = append(*producedKeys, string(message.Key))
+				if string(message.Key) == lastKey {
+					cancel()
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func TestNewHyperBlocksSink(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hbs, err := NewHyperBlocksSink(createMockArgsHyperBlocksSink(t))
+		require.Nil(t, err)
+		require.NotNil(t, hbs)
+	})
+
+	t.Run("nil facade, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksSink(t)
+		args.Facade = nil
+		hbs, err := NewHyperBlocksSink(args)
+		require.Nil(t, hbs)
+		require.Equal(t, errNilHyperBlocksFacade, err)
+	})
+
+	t.Run("nil producer, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksSink(t)
+		args.Producer = nil
+		hbs, err := NewHyperBlocksSink(args)
+		require.Nil(t, hbs)
+		require.Equal(t, errNilMessageProducer, err)
+	})
+
+	t.Run("empty topic, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksSink(t)
+		args.Topic = ""
+		hbs, err := NewHyperBlocksSink(args)
+		require.Nil(t, hbs)
+		require.Equal(t, errEmptyTopic, err)
+	})
+
+	t.Run("empty checkpoint file, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksSink(t)
+		args.CheckpointFile = ""
+		hbs, err := NewHyperBlocksSink(args)
+		require.Nil(t, hbs)
+		require.Equal(t, errEmptyCheckpointFile, err)
+	})
+
+	t.Run("invalid batch size, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksSink(t)
+		args.QueryOptions.BatchSize = 0
+		hbs, err := NewHyperBlocksSink(args)
+		require.Nil(t, hbs)
+		require.True(t, errors.Is(err, errInvalidBatchSize))
+	})
+
+	t.Run("invalid polling interval, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksSink(t)
+		args.PollingInterval = 0
+		hbs, err := NewHyperBlocksSink(args)
+		require.Nil(t, hbs)
+		require.True(t, errors.Is(err, errInvalidPollingInterval))
+	})
+}
+
+func TestHyperBlocksSink_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should publish final hyper blocks keyed by nonce and keep following the chain", func(t *testing.T) {
+		t.Parallel()
+
+		highestFinalNonce := uint64(6)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		producedMessages := make([]*kafka.Message, 0)
+		args := createMockArgsHyperBlocksSink(t)
+		args.Facade = apiMocks.CreateChainFacadeStub(t, func() uint64 {
+			return atomic.LoadUint64(&highestFinalNonce)
+		}, encodeHyperBlock)
+		args.Producer = &mock.MessageProducerStub{
+			ProduceCalled: func(ctx context.Context, topic string, messages []*kafka.Message) error {
+				require.Equal(t, testTopic, topic)
+				producedMessages = append(producedMessages, messages...)
+				if len(producedMessages) == 5 {
+					atomic.StoreUint64(&highestFinalNonce, 9)
+				}
+				if len(producedMessages) == 8 {
+					cancel()
+				}
+
+				return nil
+			},
+		}
+		hbs, _ := NewHyperBlocksSink(args)
+
+		err := hbs.Run(ctx, 2)
+		require.Nil(t, err)
+		require.Len(t, producedMessages, 8)
+		for idx, message := range producedMessages {
+			nonce := uint64(idx + 2)
+			require.Equal(t, &kafka.Message{
+				Key:   []byte(fmt.Sprintf("%d", nonce)),
+				Value: encodeHyperBlock(nonce),
+				Headers: []kafka.Header{
+					{Key: HeaderSchemaId, Value: []byte("7")},
+				},
+			}, message)
+		}
+
+		cp, found, err := polling.LoadCheckpoint(args.CheckpointFile)
+		require.Nil(t, err)
+		require.True(t, found)
+		require.Equal(t, uint64(10), cp.NextNonce)
+	})
+
+	t.Run("no schema id, should not send the schema id header", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		producedMessages := make([]*kafka.Message, 0)
+		args := createMockArgsHyperBlocksSink(t)
+		args.SchemaId = 0
+		args.Facade = apiMocks.CreateChainFacadeStub(t, func() uint64 {
+			return 3
+		}, encodeHyperBlock)
+		args.Producer = &mock.MessageProducerStub{
+			ProduceCalled: func(ctx context.Context, topic string, messages []*kafka.Message) error {
+				producedMessages = append(producedMessages, messages...)
+				cancel()
+				return nil
+			},
+		}
+		hbs, _ := NewHyperBlocksSink(args)
+
+		err := hbs.Run(ctx, 2)
+		require.Nil(t, err)
+		require.NotEmpty(t, producedMessages)
+		for _, message := range producedMessages {
+			require.Empty(t, message.Headers)
+		}
+	})
+
+	t.Run("should resume from checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		producedKeys := make([]string, 0)
+		args := createMockArgsHyperBlocksSink(t)
+		args.Facade = apiMocks.CreateChainFacadeStub(t, func() uint64 {
+			return 8
+		}, encodeHyperBlock)
+		args.Producer = createRecordingProducerStub(t, &producedKeys, "8", cancel)
+		hbs, _ := NewHyperBlocksSink(args)
+		require.Nil(t, polling.SaveCheckpoint(args.CheckpointFile, &polling.Checkpoint{NextNonce: 6}))
+
+		err := hbs.Run(ctx, 2)
+		require.Nil(t, err)
+		require.Equal(t, []string{"6", "7", "8"}, producedKeys)
+	})
+
+	t.Run("start nonce above checkpoint, should start from nonce", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		producedKeys := make([]string, 0)
+		args := createMockArgsHyperBlocksSink(t)
+		args.Facade = apiMocks.CreateChainFacadeStub(t, func() uint64 {
+			return 8
+		}, encodeHyperBlock)
+		args.Producer = createRecordingProducerStub(t, &producedKeys, "8", cancel)
+		hbs, _ := NewHyperBlocksSink(args)
+		require.Nil(t, polling.SaveCheckpoint(args.CheckpointFile, &polling.Checkpoint{NextNonce: 3}))
+
+		err := hbs.Run(ctx, 7)
+		require.Nil(t, err)
+		require.Equal(t, []string{"7", "8"}, producedKeys)
+	})
+
+	t.Run("produce error, should publish the same batch again without advancing the checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		numProduceCalls := 0
+		producedKeys := make([]string, 0)
+		args := createMockArgsHyperBlocksSink(t)
+		args.Facade = apiMocks.CreateChainFacadeStub(t, func() uint64 {
+			return 5
+		}, encodeHyperBlock)
+		args.Producer = &mock.MessageProducerStub{
+			ProduceCalled: func(ctx context.Context, topic string, messages []*kafka.Message) error {
+				numProduceCalls++
+				for _, message := range messages {
+					producedKeys = append(producedKeys, string(message.Key))
+				}
+				if numProduceCalls == 2 {
+					cp, found, err := polling.LoadCheckpoint(args.CheckpointFile)
+					require.Nil(t, err)
+					require.True(t, found)
+					require.Equal(t, uint64(3), cp.NextNonce)
+					return errors.New("local error")
+				}
+				if numProduceCalls == 3 {
+					cancel()
+				}
+
+				return nil
+			},
+		}
+		hbs, _ := NewHyperBlocksSink(args)
+
+		err := hbs.Run(ctx, 0)
+		require.Nil(t, err)
+		require.Equal(t, []string{"0", "1", "2", "3", "4", "5", "3", "4", "5"}, producedKeys)
+
+		cp, _, _ := polling.LoadCheckpoint(args.CheckpointFile)
+		require.Equal(t, uint64(6), cp.NextNonce)
+	})
+
+	t.Run("facade errors, should retry", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		numHighestFinalNonceCalls := uint32(0)
+		numIntervalCalls := uint32(0)
+		producedKeys := make([]string, 0)
+		chainFacade := apiMocks.CreateChainFacadeStub(t, func() uint64 {
+			return 4
+		}, encodeHyperBlock)
+		args := createMockArgsHyperBlocksSink(t)
+		args.Facade = &apiMocks.HyperBlockFacadeStub{
+			GetHighestFinalHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				if atomic.AddUint32(&numHighestFinalNonceCalls, 1) == 1 {
+					return 0, errors.New("local error")
+				}
+				return chainFacade.GetHighestFinalHyperBlockNonce(ctx)
+			},
+			GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+				switch atomic.AddUint32(&numIntervalCalls, 1) {
+				case 1:
+					return nil, errors.New("local error")
+				case 2:
+					return &api.CovalentHyperBlocksApiResponse{Data: [][]byte{encodeHyperBlock(noncesInterval.Start)}}, nil
+				}
+				return chainFacade.GetHyperBlocksByInterval(ctx, noncesInterval, options)
+			},
+		}
+		args.Producer = createRecordingProducerStub(t, &producedKeys, "4", cancel)
+		hbs, _ := NewHyperBlocksSink(args)
+
+		err := hbs.Run(ctx, 2)
+		require.Nil(t, err)
+		require.Equal(t, []string{"2", "3", "4"}, producedKeys)
+	})
+
+	t.Run("corrupted checkpoint, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlocksSink(t)
+		hbs, _ := NewHyperBlocksSink(args)
+		require.Nil(t, ioutil.WriteFile(args.CheckpointFile, []byte("{"), 0644))
+
+		err := hbs.Run(context.Background(), 2)
+		require.NotNil(t, err)
+	})
+}
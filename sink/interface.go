@@ -0,0 +1,26 @@
+package sink
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/sink/kafka"
+)
+
+// HyperBlocksFacade should fetch avro encoded hyper blocks from Multiversx proxy
+type HyperBlocksFacade interface {
+	GetHyperBlocksByInterval(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error)
+	GetHighestFinalHyperBlockNonce(ctx context.Context) (uint64, error)
+}
+
+// MessageProducer should write messages in a topic, returning only once all of them are durably stored
+type MessageProducer interface {
+	Produce(ctx context.Context, topic string, messages []*kafka.Message) error
+	Close() error
+}
+
+// HyperBlocksSink should keep publishing hyper blocks, starting from the provided nonce, until the context is done
+type HyperBlocksSink interface {
+	Run(ctx context.Context, startNonce uint64) error
+}
@@ -0,0 +1,21 @@
+package kafka
+
+import "errors"
+
+var errNoBrokers = errors.New("no kafka brokers provided")
+
+var errEmptyBrokerAddress = errors.New("empty kafka broker address")
+
+var errEmptyClientId = errors.New("empty client id provided")
+
+var errInvalidRequestTimeout = errors.New("invalid request timeout")
+
+var errInvalidMaxBatchBytes = errors.New("invalid max batch bytes")
+
+var errInvalidCompression = errors.New("invalid compression codec")
+
+var errInvalidSaslMechanism = errors.New("invalid sasl mechanism")
+
+var errInvalidTlsCaFile = errors.New("invalid tls ca file")
+
+var errProduceFailed = errors.New("could not produce messages")
@@ -0,0 +1,14 @@
+package kafka
+
+// Header is a key-value pair attached to a kafka message
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// Message is a kafka record to be produced. Messages with the same key are always written in the same partition
+type Message struct {
+	Key     []byte
+	Value   []byte
+	Headers []Header
+}
@@ -0,0 +1,205 @@
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+)
+
+// batchTimeout is the time the writer waits for more messages of a partition before sending them. Since messages are
+// produced synchronously, in batches of hyper blocks, there is no point in waiting for more of them
+const batchTimeout = 10 * time.Millisecond
+
+const (
+	saslMechanismPlain       = "plain"
+	saslMechanismScramSha256 = "scram-sha-256"
+	saslMechanismScramSha512 = "scram-sha-512"
+)
+
+// ArgsProducer holds all input dependencies required by kafka producer
+type ArgsProducer struct {
+	Brokers               []string
+	ClientId              string
+	RequestTimeoutMs      uint64
+	MaxBatchBytes         uint64
+	Compression           string
+	TlsEnabled            bool
+	TlsCaFile             string
+	TlsInsecureSkipVerify bool
+	SaslMechanism         string
+	SaslUsername          string
+	SaslPassword          string
+}
+
+// producer writes keyed messages in the partitions of a topic, using the same partitioning as the java client. Messages
+// are written synchronously and acknowledged by all in-sync replicas, such that a returned nil error guarantees they
+// are durably stored. Broker connections, retries on leader changes, compression, TLS and SASL are handled by the
+// kafka-go writer
+type producer struct {
+	writer messageWriter
+}
+
+// NewProducer creates a kafka producer. Connections to brokers are only opened once messages are produced
+func NewProducer(args ArgsProducer) (*producer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var compression kafkago.Compression
+	err = compression.UnmarshalText([]byte(args.Compression))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidCompression, err)
+	}
+
+	tlsConfig, err := createTlsConfig(args)
+	if err != nil {
+		return nil, err
+	}
+
+	saslMechanism, err := createSaslMechanism(args)
+	if err != nil {
+		return nil, err
+	}
+
+	requestTimeout := time.Duration(args.RequestTimeoutMs) * time.Millisecond
+	writer := &kafkago.Writer{
+		Addr:         kafkago.TCP(args.Brokers...),
+		Balancer:     &kafkago.Murmur2Balancer{},
+		BatchBytes:   int64(args.MaxBatchBytes),
+		BatchTimeout: batchTimeout,
+		ReadTimeout:  requestTimeout,
+		WriteTimeout: requestTimeout,
+		RequiredAcks: kafkago.RequireAll,
+		Compression:  compression,
+		Transport: &kafkago.Transport{
+			DialTimeout: requestTimeout,
+			ClientID:    args.ClientId,
+			TLS:         tlsConfig,
+			SASL:        saslMechanism,
+		},
+	}
+
+	return &producer{
+		writer: writer,
+	}, nil
+}
+
+func checkArgs(args ArgsProducer) error {
+	if len(args.Brokers) == 0 {
+		return errNoBrokers
+	}
+	for idx, broker := range args.Brokers {
+		if len(broker) == 0 {
+			return fmt.Errorf("%w at index %d", errEmptyBrokerAddress, idx)
+		}
+	}
+	if len(args.ClientId) == 0 {
+		return errEmptyClientId
+	}
+	if args.RequestTimeoutMs == 0 {
+		return fmt.Errorf("%w; expected non zero value", errInvalidRequestTimeout)
+	}
+	if args.MaxBatchBytes == 0 {
+		return fmt.Errorf("%w; expected non zero value", errInvalidMaxBatchBytes)
+	}
+
+	return nil
+}
+
+func createTlsConfig(args ArgsProducer) (*tls.Config, error) {
+	if !args.TlsEnabled {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: args.TlsInsecureSkipVerify,
+	}
+	if len(args.TlsCaFile) == 0 {
+		return tlsConfig, nil
+	}
+
+	caCertificates, err := ioutil.ReadFile(args.TlsCaFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidTlsCaFile, err)
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caCertificates) {
+		return nil, fmt.Errorf("%w: no PEM certificate found in %s", errInvalidTlsCaFile, args.TlsCaFile)
+	}
+	tlsConfig.RootCAs = rootCAs
+
+	return tlsConfig, nil
+}
+
+func createSaslMechanism(args ArgsProducer) (sasl.Mechanism, error) {
+	switch args.SaslMechanism {
+	case "":
+		return nil, nil
+	case saslMechanismPlain:
+		return plain.Mechanism{
+			Username: args.SaslUsername,
+			Password: args.SaslPassword,
+		}, nil
+	case saslMechanismScramSha256:
+		return scram.Mechanism(scram.SHA256, args.SaslUsername, args.SaslPassword)
+	case saslMechanismScramSha512:
+		return scram.Mechanism(scram.SHA512, args.SaslUsername, args.SaslPassword)
+	default:
+		return nil, fmt.Errorf("%w: %s, expected one of: %s, %s, %s", errInvalidSaslMechanism, args.SaslMechanism,
+			saslMechanismPlain, saslMechanismScramSha256, saslMechanismScramSha512)
+	}
+}
+
+// Produce writes the messages in the provided topic and returns once all of them are acknowledged. The partition of
+// each message is picked by its key and messages written in the same partition keep their order. If an error is
+// returned, some of the messages might have been written anyway, so they should be produced again
+func (p *producer) Produce(ctx context.Context, topic string, messages []*Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	err := p.writer.WriteMessages(ctx, toKafkaMessages(topic, messages)...)
+	if err != nil {
+		return fmt.Errorf("%w: topic: %s, num messages: %d, error: %v", errProduceFailed, topic, len(messages), err)
+	}
+
+	return nil
+}
+
+func toKafkaMessages(topic string, messages []*Message) []kafkago.Message {
+	kafkaMessages := make([]kafkago.Message, 0, len(messages))
+	for _, message := range messages {
+		headers := make([]kafkago.Header, 0, len(message.Headers))
+		for _, header := range message.Headers {
+			headers = append(headers, kafkago.Header{
+				Key:   header.Key,
+				Value: header.Value,
+			})
+		}
+
+		kafkaMessages = append(kafkaMessages, kafkago.Message{
+			Topic:   topic,
+			Key:     message.Key,
+			Value:   message.Value,
+			Headers: headers,
+		})
+	}
+
+	return kafkaMessages
+}
+
+// Close flushes the pending messages and closes all connections to brokers
+func (p *producer) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,282 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+)
+
+const testTopic = "hyperblocks"
+
+type messageWriterStub struct {
+	writeMessagesCalled func(ctx context.Context, messages ...kafkago.Message) error
+	closeCalled         func() error
+}
+
+func (mws *messageWriterStub) WriteMessages(ctx context.Context, messages ...kafkago.Message) error {
+	if mws.writeMessagesCalled != nil {
+		return mws.writeMessagesCalled(ctx, messages...)
+	}
+
+	return nil
+}
+
+func (mws *messageWriterStub) Close() error {
+	if mws.closeCalled != nil {
+		return mws.closeCalled()
+	}
+
+	return nil
+}
+
+func createMockArgsProducer(brokers ...string) ArgsProducer {
+	return ArgsProducer{
+		Brokers:          brokers,
+		ClientId:         "covalent-sink",
+		RequestTimeoutMs: 5000,
+		MaxBatchBytes:    1048576,
+		Compression:      "none",
+	}
+}
+
+func createMessages(startIdx int, numMessages int) []*Message {
+	messages := make([]*Message, 0, numMessages)
+	for i := startIdx; i < startIdx+numMessages; i++ {
+		messages = append(messages, &Message{
+			Key:   []byte(fmt.Sprintf("%d", i)),
+			Value: []byte(fmt.Sprintf("value%d", i)),
+			Headers: []Header{
+				{Key: "schema.id", Value: []byte("7")},
+			},
+		})
+	}
+
+	return messages
+}
+
+func TestNewProducer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := NewProducer(createMockArgsProducer("localhost:9092"))
+		require.Nil(t, err)
+		require.NotNil(t, p)
+		require.Nil(t, p.Close())
+	})
+
+	t.Run("no brokers, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := NewProducer(createMockArgsProducer())
+		require.Nil(t, p)
+		require.Equal(t, errNoBrokers, err)
+	})
+
+	t.Run("empty broker address, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := NewProducer(createMockArgsProducer("localhost:9092", ""))
+		require.Nil(t, p)
+		require.True(t, errors.Is(err, errEmptyBrokerAddress))
+	})
+
+	t.Run("empty client id, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProducer("localhost:9092")
+		args.ClientId = ""
+		p, err := NewProducer(args)
+		require.Nil(t, p)
+		require.Equal(t, errEmptyClientId, err)
+	})
+
+	t.Run("invalid request timeout, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProducer("localhost:9092")
+		args.RequestTimeoutMs = 0
+		p, err := NewProducer(args)
+		require.Nil(t, p)
+		require.True(t, errors.Is(err, errInvalidRequestTimeout))
+	})
+
+	t.Run("invalid max batch bytes, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProducer("localhost:9092")
+		args.MaxBatchBytes = 0
+		p, err := NewProducer(args)
+		require.Nil(t, p)
+		require.True(t, errors.Is(err, errInvalidMaxBatchBytes))
+	})
+
+	t.Run("supported compression codecs, should work", func(t *testing.T) {
+		t.Parallel()
+
+		for _, compression := range []string{"none", "gzip", "snappy", "lz4", "zstd"} {
+			args := createMockArgsProducer("localhost:9092")
+			args.Compression = compression
+			p, err := NewProducer(args)
+			require.Nil(t, err, compression)
+			require.NotNil(t, p, compression)
+		}
+	})
+
+	t.Run("invalid compression, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProducer("localhost:9092")
+		args.Compression = "brotli"
+		p, err := NewProducer(args)
+		require.Nil(t, p)
+		require.True(t, errors.Is(err, errInvalidCompression))
+	})
+
+	t.Run("supported sasl mechanisms, should work", func(t *testing.T) {
+		t.Parallel()
+
+		for _, mechanism := range []string{saslMechanismPlain, saslMechanismScramSha256, saslMechanismScramSha512} {
+			args := createMockArgsProducer("localhost:9092")
+			args.SaslMechanism = mechanism
+			args.SaslUsername = "user"
+			args.SaslPassword = "password"
+			p, err := NewProducer(args)
+			require.Nil(t, err, mechanism)
+			require.NotNil(t, p, mechanism)
+		}
+	})
+
+	t.Run("invalid sasl mechanism, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProducer("localhost:9092")
+		args.SaslMechanism = "gssapi"
+		p, err := NewProducer(args)
+		require.Nil(t, p)
+		require.True(t, errors.Is(err, errInvalidSaslMechanism))
+	})
+
+	t.Run("tls enabled without ca file, should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProducer("localhost:9092")
+		args.TlsEnabled = true
+		p, err := NewProducer(args)
+		require.Nil(t, err)
+		require.NotNil(t, p)
+	})
+
+	t.Run("missing tls ca file, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProducer("localhost:9092")
+		args.TlsEnabled = true
+		args.TlsCaFile = filepath.Join(t.TempDir(), "missing.pem")
+		p, err := NewProducer(args)
+		require.Nil(t, p)
+		require.True(t, errors.Is(err, errInvalidTlsCaFile))
+	})
+
+	t.Run("tls ca file without certificates, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.Nil(t, ioutil.WriteFile(caFile, []byte("not a certificate"), 0644))
+
+		args := createMockArgsProducer("localhost:9092")
+		args.TlsEnabled = true
+		args.TlsCaFile = caFile
+		p, err := NewProducer(args)
+		require.Nil(t, p)
+		require.True(t, errors.Is(err, errInvalidTlsCaFile))
+	})
+}
+
+func TestProducer_Produce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should write all messages in the topic, keeping their order", func(t *testing.T) {
+		t.Parallel()
+
+		writtenMessages := make([]kafkago.Message, 0)
+		p := &producer{
+			writer: &messageWriterStub{
+				writeMessagesCalled: func(ctx context.Context, messages ...kafkago.Message) error {
+					writtenMessages = append(writtenMessages, messages...)
+					return nil
+				},
+			},
+		}
+
+		messages := createMessages(0, 3)
+		require.Nil(t, p.Produce(context.Background(), testTopic, messages))
+		require.Len(t, writtenMessages, 3)
+		for idx, message := range writtenMessages {
+			require.Equal(t, kafkago.Message{
+				Topic: testTopic,
+				Key:   messages[idx].Key,
+				Value: messages[idx].Value,
+				Headers: []kafkago.Header{
+					{Key: "schema.id", Value: []byte("7")},
+				},
+			}, message)
+		}
+	})
+
+	t.Run("no messages, should not write anything", func(t *testing.T) {
+		t.Parallel()
+
+		p := &producer{
+			writer: &messageWriterStub{
+				writeMessagesCalled: func(ctx context.Context, messages ...kafkago.Message) error {
+					require.Fail(t, "should not write messages")
+					return nil
+				},
+			},
+		}
+
+		require.Nil(t, p.Produce(context.Background(), testTopic, nil))
+	})
+
+	t.Run("write error, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		errWrite := errors.New("leader not available")
+		p := &producer{
+			writer: &messageWriterStub{
+				writeMessagesCalled: func(ctx context.Context, messages ...kafkago.Message) error {
+					return errWrite
+				},
+			},
+		}
+
+		err := p.Produce(context.Background(), testTopic, createMessages(0, 2))
+		require.True(t, errors.Is(err, errProduceFailed))
+		require.Contains(t, err.Error(), errWrite.Error())
+	})
+}
+
+func TestProducer_Close(t *testing.T) {
+	t.Parallel()
+
+	closeCalled := false
+	p := &producer{
+		writer: &messageWriterStub{
+			closeCalled: func() error {
+				closeCalled = true
+				return nil
+			},
+		},
+	}
+
+	require.Nil(t, p.Close())
+	require.True(t, closeCalled)
+}
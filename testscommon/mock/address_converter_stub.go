@@ -0,0 +1,35 @@
+package mock
+
+// AddressConverterStub -
+type AddressConverterStub struct {
+	ConvertAddressCalled func(address string) ([]byte, error)
+	DecodeAddressCalled  func(address []byte) string
+	AddressLenCalled     func() int
+}
+
+// ConvertAddress -
+func (acs *AddressConverterStub) ConvertAddress(address string) ([]byte, error) {
+	if acs.ConvertAddressCalled != nil {
+		return acs.ConvertAddressCalled(address)
+	}
+
+	return nil, nil
+}
+
+// DecodeAddress -
+func (acs *AddressConverterStub) DecodeAddress(address []byte) string {
+	if acs.DecodeAddressCalled != nil {
+		return acs.DecodeAddressCalled(address)
+	}
+
+	return ""
+}
+
+// AddressLen -
+func (acs *AddressConverterStub) AddressLen() int {
+	if acs.AddressLenCalled != nil {
+		return acs.AddressLenCalled()
+	}
+
+	return 0
+}
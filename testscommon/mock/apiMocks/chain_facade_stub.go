@@ -0,0 +1,41 @@
+package apiMocks
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/stretchr/testify/require"
+)
+
+// CreateChainFacadeStub returns a facade stub for a chain whose highest final nonce is provided by getHighestFinalNonce.
+// Its latest nonce is always ahead, since only final hyper blocks should be requested. Each requested hyper block is
+// encoded by encodeHyperBlock
+func CreateChainFacadeStub(
+	t require.TestingT,
+	getHighestFinalNonce func() uint64,
+	encodeHyperBlock func(nonce uint64) []byte,
+) *HyperBlockFacadeStub {
+	return &HyperBlockFacadeStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			return getHighestFinalNonce() + 10, nil
+		},
+		GetHighestFinalHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			return getHighestFinalNonce(), nil
+		},
+		GetHyperBlocksByIntervalCalled: func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+			require.LessOrEqual(t, noncesInterval.End, getHighestFinalNonce())
+			require.LessOrEqual(t, noncesInterval.End-noncesInterval.Start+1, uint64(options.BatchSize))
+
+			encodedHyperBlocks := make([][]byte, 0)
+			for nonce := noncesInterval.Start; nonce <= noncesInterval.End; nonce++ {
+				encodedHyperBlocks = append(encodedHyperBlocks, encodeHyperBlock(nonce))
+			}
+
+			return &api.CovalentHyperBlocksApiResponse{
+				Data: encodedHyperBlocks,
+				Code: api.ReturnCodeSuccess,
+			}, nil
+		},
+	}
+}
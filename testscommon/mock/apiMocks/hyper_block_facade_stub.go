@@ -1,38 +1,110 @@
 package apiMocks
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-covalent-go/api"
 	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
 )
 
 // HyperBlockFacadeStub -
 type HyperBlockFacadeStub struct {
-	GetHyperBlockByNonceCalled     func(nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error)
-	GetHyperBlockByHashCalled      func(hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error)
-	GetHyperBlocksByIntervalCalled func(noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error)
+	GetHyperBlockByNonceCalled              func(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error)
+	GetHyperBlockByHashCalled               func(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error)
+	GetHyperBlocksByIntervalCalled          func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error)
+	GetHyperBlocksContainerByIntervalCalled func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions, codec string) ([]byte, error)
+	GetPartialHyperBlocksByIntervalCalled   func(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentPartialHyperBlocksApiResponse, error)
+	GetLatestHyperBlockNonceCalled          func(ctx context.Context) (uint64, error)
+	GetHighestFinalHyperBlockNonceCalled    func(ctx context.Context) (uint64, error)
+	GetHyperBlockNonceByTimestampCalled     func(ctx context.Context, timestamp int64) (uint64, error)
+	GetEpochNoncesIntervalCalled            func(ctx context.Context, epoch uint32) (*api.EpochNoncesInterval, error)
+	GetAccountBalanceUpdatesCalled          func(ctx context.Context, address string, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlockApiResponse, error)
 }
 
 // GetHyperBlockByNonce -
-func (hbf *HyperBlockFacadeStub) GetHyperBlockByNonce(nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+func (hbf *HyperBlockFacadeStub) GetHyperBlockByNonce(ctx context.Context, nonce uint64, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
 	if hbf.GetHyperBlockByNonceCalled != nil {
-		return hbf.GetHyperBlockByNonceCalled(nonce, options)
+		return hbf.GetHyperBlockByNonceCalled(ctx, nonce, options)
 	}
 
 	return nil, nil
 }
 
 // GetHyperBlockByHash -
-func (hbf *HyperBlockFacadeStub) GetHyperBlockByHash(hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+func (hbf *HyperBlockFacadeStub) GetHyperBlockByHash(ctx context.Context, hash string, options config.HyperBlockQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
 	if hbf.GetHyperBlockByHashCalled != nil {
-		return hbf.GetHyperBlockByHashCalled(hash, options)
+		return hbf.GetHyperBlockByHashCalled(ctx, hash, options)
 	}
 
 	return nil, nil
 }
 
-func (hbf *HyperBlockFacadeStub) GetHyperBlocksByInterval(noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
+func (hbf *HyperBlockFacadeStub) GetHyperBlocksByInterval(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlocksApiResponse, error) {
 	if hbf.GetHyperBlocksByIntervalCalled != nil {
-		return hbf.GetHyperBlocksByIntervalCalled(noncesInterval, options)
+		return hbf.GetHyperBlocksByIntervalCalled(ctx, noncesInterval, options)
+	}
+
+	return nil, nil
+}
+
+// GetHyperBlocksContainerByInterval -
+func (hbf *HyperBlockFacadeStub) GetHyperBlocksContainerByInterval(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions, codec string) ([]byte, error) {
+	if hbf.GetHyperBlocksContainerByIntervalCalled != nil {
+		return hbf.GetHyperBlocksContainerByIntervalCalled(ctx, noncesInterval, options, codec)
+	}
+
+	return nil, nil
+}
+
+// GetPartialHyperBlocksByInterval -
+func (hbf *HyperBlockFacadeStub) GetPartialHyperBlocksByInterval(ctx context.Context, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentPartialHyperBlocksApiResponse, error) {
+	if hbf.GetPartialHyperBlocksByIntervalCalled != nil {
+		return hbf.GetPartialHyperBlocksByIntervalCalled(ctx, noncesInterval, options)
+	}
+
+	return nil, nil
+}
+
+// GetLatestHyperBlockNonce -
+func (hbf *HyperBlockFacadeStub) GetLatestHyperBlockNonce(ctx context.Context) (uint64, error) {
+	if hbf.GetLatestHyperBlockNonceCalled != nil {
+		return hbf.GetLatestHyperBlockNonceCalled(ctx)
+	}
+
+	return 0, nil
+}
+
+// GetHighestFinalHyperBlockNonce -
+func (hbf *HyperBlockFacadeStub) GetHighestFinalHyperBlockNonce(ctx context.Context) (uint64, error) {
+	if hbf.GetHighestFinalHyperBlockNonceCalled != nil {
+		return hbf.GetHighestFinalHyperBlockNonceCalled(ctx)
+	}
+
+	return 0, nil
+}
+
+// GetHyperBlockNonceByTimestamp -
+func (hbf *HyperBlockFacadeStub) GetHyperBlockNonceByTimestamp(ctx context.Context, timestamp int64) (uint64, error) {
+	if hbf.GetHyperBlockNonceByTimestampCalled != nil {
+		return hbf.GetHyperBlockNonceByTimestampCalled(ctx, timestamp)
+	}
+
+	return 0, nil
+}
+
+// GetEpochNoncesInterval -
+func (hbf *HyperBlockFacadeStub) GetEpochNoncesInterval(ctx context.Context, epoch uint32) (*api.EpochNoncesInterval, error) {
+	if hbf.GetEpochNoncesIntervalCalled != nil {
+		return hbf.GetEpochNoncesIntervalCalled(ctx, epoch)
+	}
+
+	return nil, nil
+}
+
+// GetAccountBalanceUpdates -
+func (hbf *HyperBlockFacadeStub) GetAccountBalanceUpdates(ctx context.Context, address string, noncesInterval *api.Interval, options config.HyperBlocksQueryOptions) (*api.CovalentHyperBlockApiResponse, error) {
+	if hbf.GetAccountBalanceUpdatesCalled != nil {
+		return hbf.GetAccountBalanceUpdatesCalled(ctx, address, noncesInterval, options)
 	}
 
 	return nil, nil
@@ -1,17 +1,41 @@
 package apiMocks
 
-import "github.com/multiversx/mx-chain-covalent-go/api"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+)
 
 // MultiversxHyperBlockEndPointStub -
 type MultiversxHyperBlockEndPointStub struct {
-	GetHyperBlockCalled func(path string) (*api.MultiversxHyperBlockApiResponse, error)
+	GetHyperBlockCalled    func(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error)
+	GetNetworkStatusCalled func(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error)
+	GetTransactionCalled   func(ctx context.Context, path string) (*api.MultiversxTransactionApiResponse, error)
 }
 
 // GetHyperBlock -
-func (ehb *MultiversxHyperBlockEndPointStub) GetHyperBlock(path string) (*api.MultiversxHyperBlockApiResponse, error) {
+func (ehb *MultiversxHyperBlockEndPointStub) GetHyperBlock(ctx context.Context, path string) (*api.MultiversxHyperBlockApiResponse, error) {
 	if ehb.GetHyperBlockCalled != nil {
-		return ehb.GetHyperBlockCalled(path)
+		return ehb.GetHyperBlockCalled(ctx, path)
 	}
 
 	return &api.MultiversxHyperBlockApiResponse{}, nil
 }
+
+// GetNetworkStatus -
+func (ehb *MultiversxHyperBlockEndPointStub) GetNetworkStatus(ctx context.Context, path string) (*api.MultiversxNetworkStatusApiResponse, error) {
+	if ehb.GetNetworkStatusCalled != nil {
+		return ehb.GetNetworkStatusCalled(ctx, path)
+	}
+
+	return &api.MultiversxNetworkStatusApiResponse{}, nil
+}
+
+// GetTransaction -
+func (ehb *MultiversxHyperBlockEndPointStub) GetTransaction(ctx context.Context, path string) (*api.MultiversxTransactionApiResponse, error) {
+	if ehb.GetTransactionCalled != nil {
+		return ehb.GetTransactionCalled(ctx, path)
+	}
+
+	return &api.MultiversxTransactionApiResponse{}, nil
+}
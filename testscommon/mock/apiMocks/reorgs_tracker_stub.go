@@ -0,0 +1,45 @@
+package apiMocks
+
+import "github.com/multiversx/mx-chain-covalent-go/api"
+
+// ReorgsTrackerStub -
+type ReorgsTrackerStub struct {
+	TrackCalled         func(nonce uint64, hash string)
+	GetReorgsCalled     func(fromId uint64) []api.ReorgEvent
+	GetInstanceIdCalled func() string
+	IsEnabledCalled     func() bool
+}
+
+// Track -
+func (rts *ReorgsTrackerStub) Track(nonce uint64, hash string) {
+	if rts.TrackCalled != nil {
+		rts.TrackCalled(nonce, hash)
+	}
+}
+
+// GetReorgs -
+func (rts *ReorgsTrackerStub) GetReorgs(fromId uint64) []api.ReorgEvent {
+	if rts.GetReorgsCalled != nil {
+		return rts.GetReorgsCalled(fromId)
+	}
+
+	return nil
+}
+
+// GetInstanceId -
+func (rts *ReorgsTrackerStub) GetInstanceId() string {
+	if rts.GetInstanceIdCalled != nil {
+		return rts.GetInstanceIdCalled()
+	}
+
+	return ""
+}
+
+// IsEnabled -
+func (rts *ReorgsTrackerStub) IsEnabled() bool {
+	if rts.IsEnabledCalled != nil {
+		return rts.IsEnabledCalled()
+	}
+
+	return false
+}
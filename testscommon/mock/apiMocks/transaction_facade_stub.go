@@ -0,0 +1,21 @@
+package apiMocks
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-covalent-go/api"
+)
+
+// TransactionFacadeStub -
+type TransactionFacadeStub struct {
+	GetTransactionByHashCalled func(ctx context.Context, hash string) (*api.CovalentHyperBlockApiResponse, error)
+}
+
+// GetTransactionByHash -
+func (tfs *TransactionFacadeStub) GetTransactionByHash(ctx context.Context, hash string) (*api.CovalentHyperBlockApiResponse, error) {
+	if tfs.GetTransactionByHashCalled != nil {
+		return tfs.GetTransactionByHashCalled(ctx, hash)
+	}
+
+	return nil, nil
+}
@@ -4,7 +4,9 @@ import "github.com/elodina/go-avro"
 
 // AvroEncoderStub -
 type AvroEncoderStub struct {
-	EncodeCalled func(record avro.AvroRecord) ([]byte, error)
+	EncodeCalled          func(record avro.AvroRecord) ([]byte, error)
+	DecodeCalled          func(record avro.AvroRecord, buffer []byte) error
+	EncodeContainerCalled func(schemaDefinition string, codec string, encodedRecords [][]byte) ([]byte, error)
 }
 
 // Encode -
@@ -15,3 +17,21 @@ func (aes *AvroEncoderStub) Encode(record avro.AvroRecord) ([]byte, error) {
 
 	return nil, nil
 }
+
+// Decode -
+func (aes *AvroEncoderStub) Decode(record avro.AvroRecord, buffer []byte) error {
+	if aes.DecodeCalled != nil {
+		return aes.DecodeCalled(record, buffer)
+	}
+
+	return nil
+}
+
+// EncodeContainer -
+func (aes *AvroEncoderStub) EncodeContainer(schemaDefinition string, codec string, encodedRecords [][]byte) ([]byte, error) {
+	if aes.EncodeContainerCalled != nil {
+		return aes.EncodeContainerCalled(schemaDefinition, codec, encodedRecords)
+	}
+
+	return nil, nil
+}
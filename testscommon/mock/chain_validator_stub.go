@@ -0,0 +1,37 @@
+package mock
+
+import "github.com/multiversx/mx-chain-covalent-go/schema"
+
+// ChainValidatorStub -
+type ChainValidatorStub struct {
+	ValidateHyperBlockCalled func(hyperBlock *schema.HyperBlock) error
+	ValidateLinkCalled       func(previous *schema.HyperBlock, next *schema.HyperBlock) error
+	IsEnabledCalled          func() bool
+}
+
+// ValidateHyperBlock -
+func (cvs *ChainValidatorStub) ValidateHyperBlock(hyperBlock *schema.HyperBlock) error {
+	if cvs.ValidateHyperBlockCalled != nil {
+		return cvs.ValidateHyperBlockCalled(hyperBlock)
+	}
+
+	return nil
+}
+
+// ValidateLink -
+func (cvs *ChainValidatorStub) ValidateLink(previous *schema.HyperBlock, next *schema.HyperBlock) error {
+	if cvs.ValidateLinkCalled != nil {
+		return cvs.ValidateLinkCalled(previous, next)
+	}
+
+	return nil
+}
+
+// IsEnabled -
+func (cvs *ChainValidatorStub) IsEnabled() bool {
+	if cvs.IsEnabledCalled != nil {
+		return cvs.IsEnabledCalled()
+	}
+
+	return false
+}
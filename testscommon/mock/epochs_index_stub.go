@@ -0,0 +1,35 @@
+package mock
+
+// EpochsIndexStub -
+type EpochsIndexStub struct {
+	GetEpochStartNonceCalled func(epoch uint32) (uint64, bool)
+	PutEpochStartNonceCalled func(epoch uint32, nonce uint64) error
+	IsEnabledCalled          func() bool
+}
+
+// GetEpochStartNonce -
+func (eis *EpochsIndexStub) GetEpochStartNonce(epoch uint32) (uint64, bool) {
+	if eis.GetEpochStartNonceCalled != nil {
+		return eis.GetEpochStartNonceCalled(epoch)
+	}
+
+	return 0, false
+}
+
+// PutEpochStartNonce -
+func (eis *EpochsIndexStub) PutEpochStartNonce(epoch uint32, nonce uint64) error {
+	if eis.PutEpochStartNonceCalled != nil {
+		return eis.PutEpochStartNonceCalled(epoch, nonce)
+	}
+
+	return nil
+}
+
+// IsEnabled -
+func (eis *EpochsIndexStub) IsEnabled() bool {
+	if eis.IsEnabledCalled != nil {
+		return eis.IsEnabledCalled()
+	}
+
+	return false
+}
@@ -1,16 +1,19 @@
 package mock
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 // HTTPClientStub -
 type HTTPClientStub struct {
-	GetCalled func(url string) (resp *http.Response, err error)
+	GetCalled func(ctx context.Context, url string) (resp *http.Response, err error)
 }
 
 // Get -
-func (hcs *HTTPClientStub) Get(url string) (resp *http.Response, err error) {
+func (hcs *HTTPClientStub) Get(ctx context.Context, url string) (resp *http.Response, err error) {
 	if hcs.GetCalled != nil {
-		return hcs.GetCalled(url)
+		return hcs.GetCalled(ctx, url)
 	}
 
 	return nil, nil
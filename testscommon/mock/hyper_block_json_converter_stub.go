@@ -0,0 +1,27 @@
+package mock
+
+import "encoding/json"
+
+// HyperBlockJsonConverterStub -
+type HyperBlockJsonConverterStub struct {
+	ToAvroJsonCalled     func(encodedHyperBlock []byte) (json.RawMessage, error)
+	ToReadableJsonCalled func(encodedHyperBlock []byte) (json.RawMessage, error)
+}
+
+// ToAvroJson -
+func (hbjcs *HyperBlockJsonConverterStub) ToAvroJson(encodedHyperBlock []byte) (json.RawMessage, error) {
+	if hbjcs.ToAvroJsonCalled != nil {
+		return hbjcs.ToAvroJsonCalled(encodedHyperBlock)
+	}
+
+	return json.RawMessage("{}"), nil
+}
+
+// ToReadableJson -
+func (hbjcs *HyperBlockJsonConverterStub) ToReadableJson(encodedHyperBlock []byte) (json.RawMessage, error) {
+	if hbjcs.ToReadableJsonCalled != nil {
+		return hbjcs.ToReadableJsonCalled(encodedHyperBlock)
+	}
+
+	return json.RawMessage("{}"), nil
+}
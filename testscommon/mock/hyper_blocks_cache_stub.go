@@ -0,0 +1,45 @@
+package mock
+
+// HyperBlocksCacheStub -
+type HyperBlocksCacheStub struct {
+	GetByNonceCalled func(nonce uint64, optionsKey string) ([]byte, bool)
+	GetByHashCalled  func(hash string, optionsKey string) ([]byte, bool)
+	PutCalled        func(nonce uint64, hash string, optionsKey string, encodedHyperBlock []byte) error
+	IsEnabledCalled  func() bool
+}
+
+// GetByNonce -
+func (hbcs *HyperBlocksCacheStub) GetByNonce(nonce uint64, optionsKey string) ([]byte, bool) {
+	if hbcs.GetByNonceCalled != nil {
+		return hbcs.GetByNonceCalled(nonce, optionsKey)
+	}
+
+	return nil, false
+}
+
+// GetByHash -
+func (hbcs *HyperBlocksCacheStub) GetByHash(hash string, optionsKey string) ([]byte, bool) {
+	if hbcs.GetByHashCalled != nil {
+		return hbcs.GetByHashCalled(hash, optionsKey)
+	}
+
+	return nil, false
+}
+
+// Put -
+func (hbcs *HyperBlocksCacheStub) Put(nonce uint64, hash string, optionsKey string, encodedHyperBlock []byte) error {
+	if hbcs.PutCalled != nil {
+		return hbcs.PutCalled(nonce, hash, optionsKey, encodedHyperBlock)
+	}
+
+	return nil
+}
+
+// IsEnabled -
+func (hbcs *HyperBlocksCacheStub) IsEnabled() bool {
+	if hbcs.IsEnabledCalled != nil {
+		return hbcs.IsEnabledCalled()
+	}
+
+	return false
+}
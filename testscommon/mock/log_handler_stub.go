@@ -7,14 +7,14 @@ import (
 
 // LogHandlerStub -
 type LogHandlerStub struct {
-	ProcessLogCalled func(log *transaction.ApiLogs) *schema.Log
+	ProcessLogCalled func(log *transaction.ApiLogs) (*schema.Log, error)
 }
 
 // ProcessLog -
-func (lhs *LogHandlerStub) ProcessLog(log *transaction.ApiLogs) *schema.Log {
+func (lhs *LogHandlerStub) ProcessLog(log *transaction.ApiLogs) (*schema.Log, error) {
 	if lhs.ProcessLogCalled != nil {
 		return lhs.ProcessLogCalled(log)
 	}
 
-	return nil
+	return nil, nil
 }
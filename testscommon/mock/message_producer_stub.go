@@ -0,0 +1,31 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-covalent-go/sink/kafka"
+)
+
+// MessageProducerStub -
+type MessageProducerStub struct {
+	ProduceCalled func(ctx context.Context, topic string, messages []*kafka.Message) error
+	CloseCalled   func() error
+}
+
+// Produce -
+func (mps *MessageProducerStub) Produce(ctx context.Context, topic string, messages []*kafka.Message) error {
+	if mps.ProduceCalled != nil {
+		return mps.ProduceCalled(ctx, topic, messages)
+	}
+
+	return nil
+}
+
+// Close -
+func (mps *MessageProducerStub) Close() error {
+	if mps.CloseCalled != nil {
+		return mps.CloseCalled()
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package mock
+
+import "time"
+
+// MetricsHandlerStub -
+type MetricsHandlerStub struct {
+	ObserveUpstreamRequestCalled       func(endpoint string, duration time.Duration, err error)
+	ObserveEncodedHyperBlockSizeCalled func(size int)
+	IncRetriesCalled                   func(reason string)
+	IncFailuresCalled                  func(reason string)
+	IncTransactionRetriesCalled        func(reason string)
+	IncTransactionFailuresCalled       func(reason string)
+	IncInFlightBatchRequestsCalled     func()
+	DecInFlightBatchRequestsCalled     func()
+	ObserveUpstreamResponseCalled      func(upstream string, err error)
+	IncFallbackResponsesCalled         func(upstream string)
+}
+
+// ObserveUpstreamRequest -
+func (mhs *MetricsHandlerStub) ObserveUpstreamRequest(endpoint string, duration time.Duration, err error) {
+	if mhs.ObserveUpstreamRequestCalled != nil {
+		mhs.ObserveUpstreamRequestCalled(endpoint, duration, err)
+	}
+}
+
+// ObserveEncodedHyperBlockSize -
+func (mhs *MetricsHandlerStub) ObserveEncodedHyperBlockSize(size int) {
+	if mhs.ObserveEncodedHyperBlockSizeCalled != nil {
+		mhs.ObserveEncodedHyperBlockSizeCalled(size)
+	}
+}
+
+// IncRetries -
+func (mhs *MetricsHandlerStub) IncRetries(reason string) {
+	if mhs.IncRetriesCalled != nil {
+		mhs.IncRetriesCalled(reason)
+	}
+}
+
+// IncFailures -
+func (mhs *MetricsHandlerStub) IncFailures(reason string) {
+	if mhs.IncFailuresCalled != nil {
+		mhs.IncFailuresCalled(reason)
+	}
+}
+
+// IncTransactionRetries -
+func (mhs *MetricsHandlerStub) IncTransactionRetries(reason string) {
+	if mhs.IncTransactionRetriesCalled != nil {
+		mhs.IncTransactionRetriesCalled(reason)
+	}
+}
+
+// IncTransactionFailures -
+func (mhs *MetricsHandlerStub) IncTransactionFailures(reason string) {
+	if mhs.IncTransactionFailuresCalled != nil {
+		mhs.IncTransactionFailuresCalled(reason)
+	}
+}
+
+// IncInFlightBatchRequests -
+func (mhs *MetricsHandlerStub) IncInFlightBatchRequests() {
+	if mhs.IncInFlightBatchRequestsCalled != nil {
+		mhs.IncInFlightBatchRequestsCalled()
+	}
+}
+
+// DecInFlightBatchRequests -
+func (mhs *MetricsHandlerStub) DecInFlightBatchRequests() {
+	if mhs.DecInFlightBatchRequestsCalled != nil {
+		mhs.DecInFlightBatchRequestsCalled()
+	}
+}
+
+// ObserveUpstreamResponse -
+func (mhs *MetricsHandlerStub) ObserveUpstreamResponse(upstream string, err error) {
+	if mhs.ObserveUpstreamResponseCalled != nil {
+		mhs.ObserveUpstreamResponseCalled(upstream, err)
+	}
+}
+
+// IncFallbackResponses -
+func (mhs *MetricsHandlerStub) IncFallbackResponses(upstream string) {
+	if mhs.IncFallbackResponsesCalled != nil {
+		mhs.IncFallbackResponsesCalled(upstream)
+	}
+}
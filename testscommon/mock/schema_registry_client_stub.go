@@ -0,0 +1,17 @@
+package mock
+
+import "context"
+
+// SchemaRegistryClientStub -
+type SchemaRegistryClientStub struct {
+	GetSchemaIdCalled func(ctx context.Context, subject string, schemaDefinition string) (uint32, error)
+}
+
+// GetSchemaId -
+func (srcs *SchemaRegistryClientStub) GetSchemaId(ctx context.Context, subject string, schemaDefinition string) (uint32, error) {
+	if srcs.GetSchemaIdCalled != nil {
+		return srcs.GetSchemaIdCalled(ctx, subject, schemaDefinition)
+	}
+
+	return 0, nil
+}
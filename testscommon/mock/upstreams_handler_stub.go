@@ -0,0 +1,31 @@
+package mock
+
+// UpstreamsHandlerStub -
+type UpstreamsHandlerStub struct {
+	GetUpstreamsCalled  func() []string
+	ReportSuccessCalled func(upstream string)
+	ReportFailureCalled func(upstream string)
+}
+
+// GetUpstreams -
+func (uhs *UpstreamsHandlerStub) GetUpstreams() []string {
+	if uhs.GetUpstreamsCalled != nil {
+		return uhs.GetUpstreamsCalled()
+	}
+
+	return []string{""}
+}
+
+// ReportSuccess -
+func (uhs *UpstreamsHandlerStub) ReportSuccess(upstream string) {
+	if uhs.ReportSuccessCalled != nil {
+		uhs.ReportSuccessCalled(upstream)
+	}
+}
+
+// ReportFailure -
+func (uhs *UpstreamsHandlerStub) ReportFailure(upstream string) {
+	if uhs.ReportFailureCalled != nil {
+		uhs.ReportFailureCalled(upstream)
+	}
+}
@@ -0,0 +1,20 @@
+package processMocks
+
+import (
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+)
+
+// EpochStartShardsDataHandlerStub -
+type EpochStartShardsDataHandlerStub struct {
+	ProcessEpochStartShardsDataCalled func(apiShardsData []*api.EpochStartShardData) ([]*schema.EpochStartShardData, error)
+}
+
+// ProcessEpochStartShardsData -
+func (esd *EpochStartShardsDataHandlerStub) ProcessEpochStartShardsData(apiShardsData []*api.EpochStartShardData) ([]*schema.EpochStartShardData, error) {
+	if esd.ProcessEpochStartShardsDataCalled != nil {
+		return esd.ProcessEpochStartShardsDataCalled(apiShardsData)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,23 @@
+package upstreams
+
+import "errors"
+
+var errNilHttpClient = errors.New("nil http client provided")
+
+var errNoUpstreams = errors.New("no upstreams provided")
+
+var errEmptyUpstreamUrl = errors.New("empty upstream url provided")
+
+var errDuplicatedUpstreamUrl = errors.New("duplicated upstream url provided")
+
+var errInvalidUpstreamWeight = errors.New("invalid upstream weight")
+
+var errInvalidProbeInterval = errors.New("invalid health probe interval")
+
+var errEmptyProbePath = errors.New("empty health probe path provided")
+
+var errInvalidErrorRateWindowSize = errors.New("invalid error rate window size")
+
+var errInvalidMinNumRequests = errors.New("invalid min number of requests")
+
+var errInvalidMaxErrorRate = errors.New("invalid max error rate")
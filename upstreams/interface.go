@@ -0,0 +1,11 @@
+package upstreams
+
+import (
+	"context"
+	"net/http"
+)
+
+// HTTPClient defines what a client used to probe upstreams should do
+type HTTPClient interface {
+	Get(ctx context.Context, url string) (resp *http.Response, err error)
+}
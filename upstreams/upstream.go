@@ -0,0 +1,55 @@
+package upstreams
+
+// upstream holds the health state of an upstream Multiversx proxy. The outcomes of its latest requests are kept in
+// a fixed size rolling window, used to compute its error rate
+type upstream struct {
+	url         string
+	weight      uint32
+	isHealthy   bool
+	outcomes    []bool
+	nextIdx     int
+	numRequests int
+	numFailures int
+}
+
+func newUpstream(url string, weight uint32, windowSize uint32) *upstream {
+	return &upstream{
+		url:       url,
+		weight:    weight,
+		isHealthy: true,
+		outcomes:  make([]bool, windowSize),
+	}
+}
+
+func (u *upstream) recordOutcome(failed bool) {
+	if u.numRequests == len(u.outcomes) {
+		if u.outcomes[u.nextIdx] {
+			u.numFailures--
+		}
+	} else {
+		u.numRequests++
+	}
+
+	u.outcomes[u.nextIdx] = failed
+	if failed {
+		u.numFailures++
+	}
+	u.nextIdx = (u.nextIdx + 1) % len(u.outcomes)
+}
+
+func (u *upstream) errorRate() float64 {
+	if u.numRequests == 0 {
+		return 0
+	}
+
+	return float64(u.numFailures) / float64(u.numRequests)
+}
+
+func (u *upstream) resetOutcomes() {
+	for idx := range u.outcomes {
+		u.outcomes[idx] = false
+	}
+	u.nextIdx = 0
+	u.numRequests = 0
+	u.numFailures = 0
+}
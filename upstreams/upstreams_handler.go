@@ -0,0 +1,268 @@
+package upstreams
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("upstreams")
+
+// ArgsUpstreamsHandler holds all input dependencies required by upstreams handler
+type ArgsUpstreamsHandler struct {
+	Upstreams   []config.Upstream
+	HealthCheck config.UpstreamsHealthCheck
+	HttpClient  HTTPClient
+}
+
+// upstreamsHandler keeps track of the health of all upstream Multiversx proxies. An upstream is marked as unhealthy
+// either by a failed periodic health probe, or once the error rate of its latest requests exceeds the configured
+// threshold. Only a successful health probe marks it as healthy again.
+type upstreamsHandler struct {
+	mutex          sync.Mutex
+	upstreams      []*upstream
+	httpClient     HTTPClient
+	probePath      string
+	probeInterval  time.Duration
+	minNumRequests int
+	maxErrorRate   float64
+	randomizer     *rand.Rand
+	cancel         func()
+}
+
+// NewUpstreamsHandler creates an upstreams handler and starts probing the health of the provided upstreams
+func NewUpstreamsHandler(args ArgsUpstreamsHandler) (*upstreamsHandler, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	upstreams := make([]*upstream, 0, len(args.Upstreams))
+	for _, upstreamCfg := range args.Upstreams {
+		upstreams = append(upstreams, newUpstream(upstreamCfg.Url, upstreamCfg.Weight, args.HealthCheck.ErrorRateWindowSize))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	uh := &upstreamsHandler{
+		upstreams:      upstreams,
+		httpClient:     args.HttpClient,
+		probePath:      args.HealthCheck.ProbePath,
+		probeInterval:  time.Duration(args.HealthCheck.ProbeIntervalMs) * time.Millisecond,
+		minNumRequests: int(args.HealthCheck.MinNumRequests),
+		maxErrorRate:   args.HealthCheck.MaxErrorRate,
+		randomizer:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		cancel:         cancel,
+	}
+
+	go uh.probeUpstreams(ctx)
+
+	return uh, nil
+}
+
+func checkArgs(args ArgsUpstreamsHandler) error {
+	if args.HttpClient == nil {
+		return errNilHttpClient
+	}
+	if len(args.Upstreams) == 0 {
+		return errNoUpstreams
+	}
+
+	urls := make(map[string]struct{})
+	for idx, upstreamCfg := range args.Upstreams {
+		if len(upstreamCfg.Url) == 0 {
+			return fmt.Errorf("%w at index %d", errEmptyUpstreamUrl, idx)
+		}
+		if upstreamCfg.Weight == 0 {
+			return fmt.Errorf("%w for upstream %s; expected non zero value", errInvalidUpstreamWeight, upstreamCfg.Url)
+		}
+		if _, found := urls[upstreamCfg.Url]; found {
+			return fmt.Errorf("%w: %s", errDuplicatedUpstreamUrl, upstreamCfg.Url)
+		}
+
+		urls[upstreamCfg.Url] = struct{}{}
+	}
+
+	healthCheck := args.HealthCheck
+	if healthCheck.ProbeIntervalMs == 0 {
+		return fmt.Errorf("%w; expected non zero value", errInvalidProbeInterval)
+	}
+	if len(healthCheck.ProbePath) == 0 {
+		return errEmptyProbePath
+	}
+	if healthCheck.ErrorRateWindowSize == 0 {
+		return fmt.Errorf("%w; expected non zero value", errInvalidErrorRateWindowSize)
+	}
+	if healthCheck.MinNumRequests == 0 || healthCheck.MinNumRequests > healthCheck.ErrorRateWindowSize {
+		return fmt.Errorf("%w: %d; expected a value in range [1, %d]",
+			errInvalidMinNumRequests, healthCheck.MinNumRequests, healthCheck.ErrorRateWindowSize)
+	}
+	if healthCheck.MaxErrorRate <= 0 || healthCheck.MaxErrorRate > 1 {
+		return fmt.Errorf("%w: %v; expected a value in range (0, 1]", errInvalidMaxErrorRate, healthCheck.MaxErrorRate)
+	}
+
+	return nil
+}
+
+// GetUpstreams returns the urls of all upstreams, in the order in which they should be tried for a request. Healthy
+// upstreams come first, ordered randomly, proportionally to their weights. Unhealthy upstreams come last, in their
+// configured order, as a last resort.
+func (uh *upstreamsHandler) GetUpstreams() []string {
+	uh.mutex.Lock()
+	defer uh.mutex.Unlock()
+
+	healthyUpstreams := make([]*upstream, 0, len(uh.upstreams))
+	unhealthyUpstreams := make([]*upstream, 0)
+	for _, u := range uh.upstreams {
+		if u.isHealthy {
+			healthyUpstreams = append(healthyUpstreams, u)
+		} else {
+			unhealthyUpstreams = append(unhealthyUpstreams, u)
+		}
+	}
+
+	urls := uh.weightedRandomOrder(healthyUpstreams)
+	for _, u := range unhealthyUpstreams {
+		urls = append(urls, u.url)
+	}
+
+	return urls
+}
+
+func (uh *upstreamsHandler) weightedRandomOrder(upstreams []*upstream) []string {
+	totalWeight := uint64(0)
+	for _, u := range upstreams {
+		totalWeight += uint64(u.weight)
+	}
+
+	urls := make([]string, 0, len(uh.upstreams))
+	for len(upstreams) > 0 {
+		pick := uint64(uh.randomizer.Int63n(int64(totalWeight)))
+		idx := 0
+		for ; pick >= uint64(upstreams[idx].weight); idx++ {
+			pick -= uint64(upstreams[idx].weight)
+		}
+
+		urls = append(urls, upstreams[idx].url)
+		totalWeight -= uint64(upstreams[idx].weight)
+		upstreams = append(upstreams[:idx], upstreams[idx+1:]...)
+	}
+
+	return urls
+}
+
+// ReportSuccess records a successful request sent to the provided upstream
+func (uh *upstreamsHandler) ReportSuccess(url string) {
+	uh.recordOutcome(url, false)
+}
+
+// ReportFailure records a failed request sent to the provided upstream. If its error rate exceeds the configured
+// threshold, the upstream is marked as unhealthy
+func (uh *upstreamsHandler) ReportFailure(url string) {
+	uh.recordOutcome(url, true)
+}
+
+func (uh *upstreamsHandler) recordOutcome(url string, failed bool) {
+	uh.mutex.Lock()
+	defer uh.mutex.Unlock()
+
+	u := uh.getUpstream(url)
+	if u == nil || !u.isHealthy {
+		return
+	}
+
+	u.recordOutcome(failed)
+	if u.numRequests < uh.minNumRequests || u.errorRate() < uh.maxErrorRate {
+		return
+	}
+
+	log.Warn("upstream marked as unhealthy",
+		"upstream", u.url,
+		"error rate", u.errorRate(),
+		"num requests", u.numRequests,
+	)
+	u.isHealthy = false
+	u.resetOutcomes()
+}
+
+func (uh *upstreamsHandler) getUpstream(url string) *upstream {
+	for _, u := range uh.upstreams {
+		if u.url == url {
+			return u
+		}
+	}
+
+	return nil
+}
+
+func (uh *upstreamsHandler) probeUpstreams(ctx context.Context) {
+	ticker := time.NewTicker(uh.probeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("stopped probing upstreams")
+			return
+		case <-ticker.C:
+			for _, u := range uh.upstreams {
+				isHealthy := uh.probe(ctx, u.url)
+				uh.setHealth(u, isHealthy)
+			}
+		}
+	}
+}
+
+func (uh *upstreamsHandler) probe(ctx context.Context, url string) bool {
+	resp, err := uh.httpClient.Get(ctx, url+uh.probePath)
+	if err != nil {
+		log.Debug("upstream health probe failed", "upstream", url, "error", err)
+		return false
+	}
+	if resp == nil {
+		return false
+	}
+
+	if resp.Body != nil {
+		errNotCritical := resp.Body.Close()
+		if errNotCritical != nil {
+			log.Warn("close body", "error", errNotCritical.Error())
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		log.Debug("upstream health probe failed", "upstream", url, "status code", resp.StatusCode)
+		return false
+	}
+
+	return true
+}
+
+func (uh *upstreamsHandler) setHealth(u *upstream, isHealthy bool) {
+	uh.mutex.Lock()
+	defer uh.mutex.Unlock()
+
+	if u.isHealthy == isHealthy {
+		return
+	}
+
+	if isHealthy {
+		log.Info("upstream marked as healthy", "upstream", u.url)
+	} else {
+		log.Warn("upstream marked as unhealthy by health probe", "upstream", u.url)
+	}
+
+	u.isHealthy = isHealthy
+	u.resetOutcomes()
+}
+
+// Close stops probing the health of the upstreams
+func (uh *upstreamsHandler) Close() error {
+	uh.cancel()
+	return nil
+}
@@ -0,0 +1,342 @@
+package upstreams
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-covalent-go/cmd/proxy/config"
+	"github.com/multiversx/mx-chain-covalent-go/testscommon/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsUpstreamsHandler() ArgsUpstreamsHandler {
+	return ArgsUpstreamsHandler{
+		Upstreams: []config.Upstream{
+			{Url: "url1", Weight: 1},
+			{Url: "url2", Weight: 1},
+		},
+		HealthCheck: config.UpstreamsHealthCheck{
+			ProbeIntervalMs:     60000,
+			ProbePath:           "/probe",
+			ErrorRateWindowSize: 4,
+			MinNumRequests:      2,
+			MaxErrorRate:        0.5,
+		},
+		HttpClient: &mock.HTTPClientStub{},
+	}
+}
+
+func TestNewUpstreamsHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		uh, err := NewUpstreamsHandler(createMockArgsUpstreamsHandler())
+		require.Nil(t, err)
+		require.NotNil(t, uh)
+		require.Nil(t, uh.Close())
+	})
+
+	t.Run("nil http client, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.HttpClient = nil
+		uh, err := NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.Equal(t, errNilHttpClient, err)
+	})
+
+	t.Run("no upstreams, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.Upstreams = nil
+		uh, err := NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.Equal(t, errNoUpstreams, err)
+	})
+
+	t.Run("empty upstream url, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.Upstreams[1].Url = ""
+		uh, err := NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.True(t, errors.Is(err, errEmptyUpstreamUrl))
+	})
+
+	t.Run("invalid upstream weight, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.Upstreams[0].Weight = 0
+		uh, err := NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.True(t, errors.Is(err, errInvalidUpstreamWeight))
+	})
+
+	t.Run("duplicated upstream url, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.Upstreams[1].Url = args.Upstreams[0].Url
+		uh, err := NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.True(t, errors.Is(err, errDuplicatedUpstreamUrl))
+	})
+
+	t.Run("invalid probe interval, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.HealthCheck.ProbeIntervalMs = 0
+		uh, err := NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.True(t, errors.Is(err, errInvalidProbeInterval))
+	})
+
+	t.Run("empty probe path, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.HealthCheck.ProbePath = ""
+		uh, err := NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.Equal(t, errEmptyProbePath, err)
+	})
+
+	t.Run("invalid error rate window size, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.HealthCheck.ErrorRateWindowSize = 0
+		uh, err := NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.True(t, errors.Is(err, errInvalidErrorRateWindowSize))
+	})
+
+	t.Run("invalid min num requests, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.HealthCheck.MinNumRequests = 0
+		uh, err := NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.True(t, errors.Is(err, errInvalidMinNumRequests))
+
+		args.HealthCheck.MinNumRequests = args.HealthCheck.ErrorRateWindowSize + 1
+		uh, err = NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.True(t, errors.Is(err, errInvalidMinNumRequests))
+	})
+
+	t.Run("invalid max error rate, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.HealthCheck.MaxErrorRate = 0
+		uh, err := NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.True(t, errors.Is(err, errInvalidMaxErrorRate))
+
+		args.HealthCheck.MaxErrorRate = 1.1
+		uh, err = NewUpstreamsHandler(args)
+		require.Nil(t, uh)
+		require.True(t, errors.Is(err, errInvalidMaxErrorRate))
+	})
+}
+
+func TestUpstreamsHandler_GetUpstreams(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should order healthy upstreams proportionally to their weights", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.Upstreams = []config.Upstream{
+			{Url: "url1", Weight: 1},
+			{Url: "url2", Weight: 9},
+			{Url: "url3", Weight: 0x7FFFFFFF},
+		}
+		uh, _ := NewUpstreamsHandler(args)
+		defer func() {
+			_ = uh.Close()
+		}()
+
+		numFirst := make(map[string]int)
+		numSecond := make(map[string]int)
+		numCalls := 1000
+		for i := 0; i < numCalls; i++ {
+			urls := uh.GetUpstreams()
+			require.ElementsMatch(t, []string{"url1", "url2", "url3"}, urls)
+			numFirst[urls[0]]++
+			numSecond[urls[1]]++
+		}
+
+		require.Greater(t, numFirst["url3"], numCalls*99/100)
+		require.Greater(t, numSecond["url2"], numCalls*8/10)
+	})
+
+	t.Run("unhealthy upstreams should come last", func(t *testing.T) {
+		t.Parallel()
+
+		uh, _ := NewUpstreamsHandler(createMockArgsUpstreamsHandler())
+		defer func() {
+			_ = uh.Close()
+		}()
+
+		uh.ReportFailure("url1")
+		uh.ReportFailure("url1")
+		for i := 0; i < 100; i++ {
+			require.Equal(t, []string{"url2", "url1"}, uh.GetUpstreams())
+		}
+
+		uh.ReportFailure("url2")
+		uh.ReportFailure("url2")
+		require.Equal(t, []string{"url1", "url2"}, uh.GetUpstreams())
+	})
+}
+
+func TestUpstreamsHandler_ErrorRate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error rate below threshold, should remain healthy", func(t *testing.T) {
+		t.Parallel()
+
+		uh, _ := NewUpstreamsHandler(createMockArgsUpstreamsHandler())
+		defer func() {
+			_ = uh.Close()
+		}()
+
+		uh.ReportSuccess("url1")
+		uh.ReportSuccess("url1")
+		uh.ReportSuccess("url1")
+		uh.ReportFailure("url1")
+		require.True(t, uh.getUpstream("url1").isHealthy)
+	})
+
+	t.Run("less than min num requests, should remain healthy", func(t *testing.T) {
+		t.Parallel()
+
+		uh, _ := NewUpstreamsHandler(createMockArgsUpstreamsHandler())
+		defer func() {
+			_ = uh.Close()
+		}()
+
+		uh.ReportFailure("url1")
+		require.True(t, uh.getUpstream("url1").isHealthy)
+
+		uh.ReportFailure("url1")
+		require.False(t, uh.getUpstream("url1").isHealthy)
+		require.True(t, uh.getUpstream("url2").isHealthy)
+	})
+
+	t.Run("old outcomes should be evicted from the window", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsUpstreamsHandler()
+		args.HealthCheck.MinNumRequests = 4
+		args.HealthCheck.MaxErrorRate = 0.75
+		uh, _ := NewUpstreamsHandler(args)
+		defer func() {
+			_ = uh.Close()
+		}()
+
+		uh.ReportFailure("url1")
+		uh.ReportFailure("url1")
+		uh.ReportSuccess("url1")
+		uh.ReportSuccess("url1")
+		uh.ReportSuccess("url1")
+		uh.ReportSuccess("url1")
+		uh.ReportFailure("url1")
+		uh.ReportFailure("url1")
+		require.True(t, uh.getUpstream("url1").isHealthy)
+
+		uh.ReportFailure("url1")
+		require.False(t, uh.getUpstream("url1").isHealthy)
+	})
+
+	t.Run("unknown upstream, should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		uh, _ := NewUpstreamsHandler(createMockArgsUpstreamsHandler())
+		defer func() {
+			_ = uh.Close()
+		}()
+
+		require.NotPanics(t, func() {
+			uh.ReportSuccess("unknown")
+			uh.ReportFailure("unknown")
+		})
+	})
+}
+
+func TestUpstreamsHandler_HealthProbes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("failed probes should mark upstream as unhealthy, successful ones as healthy", func(t *testing.T) {
+		t.Parallel()
+
+		isUrl1Down := int32(1)
+		args := createMockArgsUpstreamsHandler()
+		args.HealthCheck.ProbeIntervalMs = 5
+		args.HttpClient = &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				if url == "url1/probe" && atomic.LoadInt32(&isUrl1Down) == 1 {
+					return &http.Response{StatusCode: http.StatusServiceUnavailable}, nil
+				}
+
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			},
+		}
+		uh, _ := NewUpstreamsHandler(args)
+		defer func() {
+			_ = uh.Close()
+		}()
+
+		require.Eventually(t, func() bool {
+			urls := uh.GetUpstreams()
+			return urls[0] == "url2" && urls[1] == "url1"
+		}, time.Second, time.Millisecond)
+
+		atomic.StoreInt32(&isUrl1Down, 0)
+		require.Eventually(t, func() bool {
+			uh.mutex.Lock()
+			defer uh.mutex.Unlock()
+
+			return uh.getUpstream("url1").isHealthy
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("close should stop probing", func(t *testing.T) {
+		t.Parallel()
+
+		numProbes := int32(0)
+		args := createMockArgsUpstreamsHandler()
+		args.HealthCheck.ProbeIntervalMs = 1
+		args.HttpClient = &mock.HTTPClientStub{
+			GetCalled: func(ctx context.Context, url string) (resp *http.Response, err error) {
+				atomic.AddInt32(&numProbes, 1)
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			},
+		}
+		uh, _ := NewUpstreamsHandler(args)
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&numProbes) > 0
+		}, time.Second, time.Millisecond)
+
+		require.Nil(t, uh.Close())
+		time.Sleep(20 * time.Millisecond)
+		numProbesAfterClose := atomic.LoadInt32(&numProbes)
+		time.Sleep(20 * time.Millisecond)
+		require.Equal(t, numProbesAfterClose, atomic.LoadInt32(&numProbes))
+	})
+}
@@ -0,0 +1,89 @@
+package validator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("validator")
+
+// ArgsChainValidator holds all input dependencies required by chain validator
+type ArgsChainValidator struct {
+	FailOnViolation bool
+}
+
+// chainValidator checks that processed hyper blocks are consistent on their own and that consecutive hyper blocks
+// belong to the same chain. Violations are either returned as errors or only logged as warnings, as configured
+type chainValidator struct {
+	failOnViolation bool
+}
+
+// NewChainValidator will create a chain validator
+func NewChainValidator(args ArgsChainValidator) *chainValidator {
+	return &chainValidator{
+		failOnViolation: args.FailOnViolation,
+	}
+}
+
+// ValidateHyperBlock checks that the number of transactions of the hyper block matches its transactions
+func (cv *chainValidator) ValidateHyperBlock(hyperBlock *schema.HyperBlock) error {
+	if hyperBlock == nil {
+		return errNilHyperBlock
+	}
+
+	if int(hyperBlock.NumTxs) != len(hyperBlock.Transactions) {
+		return cv.handleViolation(fmt.Errorf("%w: hyper block nonce: %d, hash: %x, num txs: %d, num transactions: %d",
+			errNumTxsMismatch,
+			hyperBlock.Nonce,
+			hyperBlock.Hash,
+			hyperBlock.NumTxs,
+			len(hyperBlock.Transactions),
+		))
+	}
+
+	return nil
+}
+
+// ValidateLink checks that the next hyper block directly follows the previous one: its nonce is the next one and its
+// previous block hash is the hash of the previous hyper block
+func (cv *chainValidator) ValidateLink(previous *schema.HyperBlock, next *schema.HyperBlock) error {
+	if previous == nil || next == nil {
+		return errNilHyperBlock
+	}
+
+	if next.Nonce != previous.Nonce+1 {
+		return cv.handleViolation(fmt.Errorf("%w: hyper block nonce: %d is followed by hyper block nonce: %d",
+			errNonConsecutiveNonces,
+			previous.Nonce,
+			next.Nonce,
+		))
+	}
+	if !bytes.Equal(next.PrevBlockHash, previous.Hash) {
+		return cv.handleViolation(fmt.Errorf("%w: hyper block nonce: %d has prev block hash: %x, while hyper block nonce: %d has hash: %x",
+			errBrokenHashLinkage,
+			next.Nonce,
+			next.PrevBlockHash,
+			previous.Nonce,
+			previous.Hash,
+		))
+	}
+
+	return nil
+}
+
+func (cv *chainValidator) handleViolation(err error) error {
+	if cv.failOnViolation {
+		return err
+	}
+
+	log.Warn("chain validation failed", "error", err)
+	return nil
+}
+
+// IsEnabled returns true, since hyper blocks are validated
+func (cv *chainValidator) IsEnabled() bool {
+	return true
+}
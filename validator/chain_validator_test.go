@@ -0,0 +1,129 @@
+package validator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-covalent-go/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainValidator_ValidateHyperBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("matching num txs, should work", func(t *testing.T) {
+		t.Parallel()
+
+		cv := NewChainValidator(ArgsChainValidator{FailOnViolation: true})
+		err := cv.ValidateHyperBlock(&schema.HyperBlock{
+			NumTxs:       2,
+			Transactions: []*schema.Transaction{{}, {}},
+		})
+		require.Nil(t, err)
+	})
+
+	t.Run("nil hyper block, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		cv := NewChainValidator(ArgsChainValidator{FailOnViolation: false})
+		require.Equal(t, errNilHyperBlock, cv.ValidateHyperBlock(nil))
+	})
+
+	t.Run("num txs mismatch, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		cv := NewChainValidator(ArgsChainValidator{FailOnViolation: true})
+		err := cv.ValidateHyperBlock(&schema.HyperBlock{
+			Nonce:        4,
+			NumTxs:       3,
+			Transactions: []*schema.Transaction{{}, {}},
+		})
+		require.True(t, errors.Is(err, errNumTxsMismatch))
+		require.True(t, strings.Contains(err.Error(), "nonce: 4"))
+		require.True(t, strings.Contains(err.Error(), "num txs: 3, num transactions: 2"))
+	})
+
+	t.Run("num txs mismatch without failing on violations, should only log", func(t *testing.T) {
+		t.Parallel()
+
+		cv := NewChainValidator(ArgsChainValidator{FailOnViolation: false})
+		err := cv.ValidateHyperBlock(&schema.HyperBlock{
+			NumTxs: 1,
+		})
+		require.Nil(t, err)
+	})
+}
+
+func TestChainValidator_ValidateLink(t *testing.T) {
+	t.Parallel()
+
+	previous := &schema.HyperBlock{
+		Nonce:         4,
+		Hash:          []byte("hash4"),
+		PrevBlockHash: []byte("hash3"),
+	}
+
+	t.Run("linked hyper blocks, should work", func(t *testing.T) {
+		t.Parallel()
+
+		cv := NewChainValidator(ArgsChainValidator{FailOnViolation: true})
+		err := cv.ValidateLink(previous, &schema.HyperBlock{
+			Nonce:         5,
+			Hash:          []byte("hash5"),
+			PrevBlockHash: []byte("hash4"),
+		})
+		require.Nil(t, err)
+	})
+
+	t.Run("nil hyper block, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		cv := NewChainValidator(ArgsChainValidator{FailOnViolation: true})
+		require.Equal(t, errNilHyperBlock, cv.ValidateLink(nil, previous))
+		require.Equal(t, errNilHyperBlock, cv.ValidateLink(previous, nil))
+	})
+
+	t.Run("non consecutive nonces, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		cv := NewChainValidator(ArgsChainValidator{FailOnViolation: true})
+		err := cv.ValidateLink(previous, &schema.HyperBlock{
+			Nonce:         6,
+			PrevBlockHash: []byte("hash4"),
+		})
+		require.True(t, errors.Is(err, errNonConsecutiveNonces))
+		require.True(t, strings.Contains(err.Error(), "nonce: 4 is followed by hyper block nonce: 6"))
+	})
+
+	t.Run("broken hash linkage, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		cv := NewChainValidator(ArgsChainValidator{FailOnViolation: true})
+		err := cv.ValidateLink(previous, &schema.HyperBlock{
+			Nonce:         5,
+			PrevBlockHash: []byte("fork"),
+		})
+		require.True(t, errors.Is(err, errBrokenHashLinkage))
+		require.True(t, strings.Contains(err.Error(), "nonce: 5 has prev block hash: 666f726b"))
+		require.True(t, strings.Contains(err.Error(), "nonce: 4 has hash: 6861736834"))
+	})
+
+	t.Run("broken hash linkage without failing on violations, should only log", func(t *testing.T) {
+		t.Parallel()
+
+		cv := NewChainValidator(ArgsChainValidator{FailOnViolation: false})
+		err := cv.ValidateLink(previous, &schema.HyperBlock{
+			Nonce:         5,
+			PrevBlockHash: []byte("fork"),
+		})
+		require.Nil(t, err)
+	})
+}
+
+func TestChainValidator_IsEnabled(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, NewChainValidator(ArgsChainValidator{}).IsEnabled())
+	require.False(t, NewDisabledChainValidator().IsEnabled())
+}
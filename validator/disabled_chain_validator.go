@@ -0,0 +1,26 @@
+package validator
+
+import "github.com/multiversx/mx-chain-covalent-go/schema"
+
+type disabledChainValidator struct {
+}
+
+// NewDisabledChainValidator will create a chain validator which does not check anything
+func NewDisabledChainValidator() *disabledChainValidator {
+	return &disabledChainValidator{}
+}
+
+// ValidateHyperBlock does nothing
+func (dcv *disabledChainValidator) ValidateHyperBlock(_ *schema.HyperBlock) error {
+	return nil
+}
+
+// ValidateLink does nothing
+func (dcv *disabledChainValidator) ValidateLink(_ *schema.HyperBlock, _ *schema.HyperBlock) error {
+	return nil
+}
+
+// IsEnabled returns false, since nothing is validated
+func (dcv *disabledChainValidator) IsEnabled() bool {
+	return false
+}
@@ -0,0 +1,11 @@
+package validator
+
+import "errors"
+
+var errNilHyperBlock = errors.New("nil hyper block provided")
+
+var errNumTxsMismatch = errors.New("number of transactions mismatch")
+
+var errNonConsecutiveNonces = errors.New("non consecutive hyper block nonces")
+
+var errBrokenHashLinkage = errors.New("broken hyper blocks hash linkage")